@@ -754,25 +754,30 @@ func testAccCheckProviderDefaultTags_Tags(providers *[]*schema.Provider, expecte
 	}
 }
 
+// providerClientConn returns the result of the conns.AWSClient service client method matching the endpoint configuration name.
+// The returned value is not valid if there is no such method.
+func providerClientConn(client *conns.AWSClient, key string) reflect.Value {
+	serviceUpper, err := conns.ServiceProviderNameUpper(key)
+
+	if err != nil {
+		return reflect.Value{}
+	}
+
+	method := reflect.ValueOf(client).MethodByName(fmt.Sprintf("%sConn", serviceUpper))
+
+	if !method.IsValid() {
+		return method
+	}
+
+	return method.Call(nil)[0]
+}
+
 func testAccCheckEndpoints(providers *[]*schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if providers == nil {
 			return fmt.Errorf("no providers initialized")
 		}
 
-		// Match conns.AWSClient struct field names to endpoint configuration names
-		endpointFieldNameF := func(key string) func(string) bool {
-			return func(name string) bool {
-				serviceUpper := ""
-				var err error
-				if serviceUpper, err = conns.ServiceProviderNameUpper(key); err != nil {
-					return false
-				}
-
-				return name == fmt.Sprintf("%sConn", serviceUpper)
-			}
-		}
-
 		for _, provo := range *providers {
 			if provo == nil || provo.Meta() == nil || provo.Meta().(*conns.AWSClient) == nil {
 				continue
@@ -781,10 +786,10 @@ func testAccCheckEndpoints(providers *[]*schema.Provider) resource.TestCheckFunc
 			providerClient := provo.Meta().(*conns.AWSClient)
 
 			for _, serviceKey := range conns.ServiceKeys() {
-				providerClientField := reflect.Indirect(reflect.ValueOf(providerClient)).FieldByNameFunc(endpointFieldNameF(serviceKey))
+				providerClientField := providerClientConn(providerClient, serviceKey)
 
 				if !providerClientField.IsValid() {
-					return fmt.Errorf("unable to match conns.AWSClient struct field name for endpoint name: %s", serviceKey)
+					return fmt.Errorf("unable to match conns.AWSClient method name for endpoint name: %s", serviceKey)
 				}
 
 				actualEndpoint := reflect.Indirect(reflect.Indirect(providerClientField).FieldByName("Config").FieldByName("Endpoint")).String()
@@ -806,24 +811,6 @@ func testAccCheckUnusualEndpoints(providers *[]*schema.Provider, unusual1, unusu
 			return fmt.Errorf("no providers initialized")
 		}
 
-		// Match conns.AWSClient struct field names to endpoint configuration names
-		endpointFieldNameF := func(key string) func(string) bool {
-			return func(name string) bool {
-				serviceUpper := ""
-				var err error
-				if serviceUpper, err = conns.ServiceProviderNameUpper(key); err != nil {
-					return false
-				}
-
-				// exception to dropping "service" because Config collides with various other "Config"s
-				if name == "ConfigServiceConn" && fmt.Sprintf("%sConn", serviceUpper) == "ConfigServiceConn" {
-					return true
-				}
-
-				return name == fmt.Sprintf("%sConn", serviceUpper)
-			}
-		}
-
 		for _, provo := range *providers {
 			if provo == nil || provo.Meta() == nil || provo.Meta().(*conns.AWSClient) == nil {
 				continue
@@ -831,10 +818,10 @@ func testAccCheckUnusualEndpoints(providers *[]*schema.Provider, unusual1, unusu
 
 			providerClient := provo.Meta().(*conns.AWSClient)
 
-			providerClientField := reflect.Indirect(reflect.ValueOf(providerClient)).FieldByNameFunc(endpointFieldNameF(unusual1[1]))
+			providerClientField := providerClientConn(providerClient, unusual1[1])
 
 			if !providerClientField.IsValid() {
-				return fmt.Errorf("unable to match conns.AWSClient struct field name for endpoint name: %s", unusual1[1])
+				return fmt.Errorf("unable to match conns.AWSClient method name for endpoint name: %s", unusual1[1])
 			}
 
 			actualEndpoint := reflect.Indirect(reflect.Indirect(providerClientField).FieldByName("Config").FieldByName("Endpoint")).String()
@@ -844,10 +831,10 @@ func testAccCheckUnusualEndpoints(providers *[]*schema.Provider, unusual1, unusu
 				return fmt.Errorf("expected endpoint (%s) value (%s), got: %s", unusual1[1], expectedEndpoint, actualEndpoint)
 			}
 
-			providerClientField = reflect.Indirect(reflect.ValueOf(providerClient)).FieldByNameFunc(endpointFieldNameF(unusual2[1]))
+			providerClientField = providerClientConn(providerClient, unusual2[1])
 
 			if !providerClientField.IsValid() {
-				return fmt.Errorf("unable to match conns.AWSClient struct field name for endpoint name: %s", unusual2[1])
+				return fmt.Errorf("unable to match conns.AWSClient method name for endpoint name: %s", unusual2[1])
 			}
 
 			actualEndpoint = reflect.Indirect(reflect.Indirect(providerClientField).FieldByName("Config").FieldByName("Endpoint")).String()
@@ -857,10 +844,10 @@ func testAccCheckUnusualEndpoints(providers *[]*schema.Provider, unusual1, unusu
 				return fmt.Errorf("expected endpoint (%s) value (%s), got: %s", unusual2[1], expectedEndpoint, actualEndpoint)
 			}
 
-			providerClientField = reflect.Indirect(reflect.ValueOf(providerClient)).FieldByNameFunc(endpointFieldNameF(unusual3[1]))
+			providerClientField = providerClientConn(providerClient, unusual3[1])
 
 			if !providerClientField.IsValid() {
-				return fmt.Errorf("unable to match conns.AWSClient struct field name for endpoint name: %s", unusual3[1])
+				return fmt.Errorf("unable to match conns.AWSClient method name for endpoint name: %s", unusual3[1])
 			}
 
 			actualEndpoint = reflect.Indirect(reflect.Indirect(providerClientField).FieldByName("Config").FieldByName("Endpoint")).String()
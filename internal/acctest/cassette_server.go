@@ -0,0 +1,257 @@
+package acctest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+)
+
+// cassetteServer is an in-process stand-in for all AWS API endpoints. When recording, it forwards
+// requests to AWS, re-signing them for the real endpoint, and records the interactions. When replaying,
+// it responds with the recorded interactions.
+type cassetteServer struct {
+	mode   string
+	server *httptest.Server
+
+	// Used only when recording.
+	client      *http.Client
+	credentials *credentials.Credentials
+	// upstream returns the URL of the AWS API endpoint for a service signing name and region.
+	upstream func(service, region string) (string, error)
+
+	mu       sync.Mutex
+	cassette *Cassette
+	// names maps the generated names in the cassette to those of the running test.
+	names map[string]string
+}
+
+func newCassetteServer(mode string, creds *credentials.Credentials) *cassetteServer {
+	s := &cassetteServer{
+		mode:        mode,
+		client:      &http.Client{},
+		credentials: creds,
+		upstream:    defaultCassetteUpstream,
+	}
+
+	s.server = httptest.NewServer(s)
+
+	return s
+}
+
+func defaultCassetteUpstream(service, region string) (string, error) {
+	endpoint, err := endpoints.DefaultResolver().EndpointFor(service, region)
+
+	if err != nil {
+		return "", err
+	}
+
+	return endpoint.URL, nil
+}
+
+// URL returns the endpoint URL of the stand-in.
+func (s *cassetteServer) URL() string {
+	return s.server.URL
+}
+
+func (s *cassetteServer) Close() {
+	s.server.Close()
+}
+
+// load sets the cassette to record to or replay from.
+func (s *cassetteServer) load(cassette *Cassette) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cassette = cassette
+	s.names = make(map[string]string)
+}
+
+func (s *cassetteServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	service, region := requestCredentialScope(r)
+	operation := requestOperation(r, body)
+	request := CassetteRequest{
+		Method: r.Method,
+		Path:   r.URL.EscapedPath(),
+		Query:  r.URL.RawQuery,
+		Body:   string(body),
+	}
+
+	var response CassetteResponse
+
+	switch s.mode {
+	case CassetteModeRecord:
+		response, err = s.forward(r, service, region, body)
+
+		if err != nil {
+			log.Printf("[ERROR] Recording %s %s request: %s", service, operation, err)
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		s.mu.Lock()
+		if s.cassette != nil {
+			s.cassette.Interactions = append(s.cassette.Interactions, &CassetteInteraction{
+				Service:   service,
+				Region:    region,
+				Operation: operation,
+				Request:   request,
+				Response:  response,
+			})
+		}
+		s.mu.Unlock()
+	case CassetteModeReplay:
+		var ok bool
+		response, ok = s.replay(service, operation, request)
+
+		if !ok {
+			log.Printf("[ERROR] No recorded interaction for %s %s request: %s %s", service, operation, r.Method, r.URL)
+			http.Error(w, fmt.Sprintf("no recorded interaction for %s %s request", service, operation), http.StatusBadRequest)
+			return
+		}
+	}
+
+	responseBody, err := response.body()
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	for name, values := range response.Header {
+		w.Header()[name] = values
+	}
+
+	w.WriteHeader(response.StatusCode)
+	w.Write(responseBody) //nolint:errcheck
+}
+
+// forward sends the request to the AWS API endpoint and returns the response.
+func (s *cassetteServer) forward(r *http.Request, service, region string, body []byte) (CassetteResponse, error) {
+	if service == "" {
+		return CassetteResponse{}, fmt.Errorf("request is not signed with Signature Version 4")
+	}
+
+	endpoint, err := s.upstream(service, region)
+
+	if err != nil {
+		return CassetteResponse{}, fmt.Errorf("error resolving endpoint: %w", err)
+	}
+
+	u, err := url.Parse(endpoint)
+
+	if err != nil {
+		return CassetteResponse{}, fmt.Errorf("error parsing endpoint (%s): %w", endpoint, err)
+	}
+
+	target := *r.URL
+	target.Scheme = u.Scheme
+	target.Host = u.Host
+
+	request, err := http.NewRequestWithContext(r.Context(), r.Method, target.String(), nil)
+
+	if err != nil {
+		return CassetteResponse{}, err
+	}
+
+	for name, values := range r.Header {
+		switch http.CanonicalHeaderKey(name) {
+		case "Authorization", "X-Amz-Date", "X-Amz-Security-Token":
+			continue
+		}
+
+		request.Header[name] = values
+	}
+
+	signer := v4.NewSigner(s.credentials, func(signer *v4.Signer) {
+		signer.DisableURIPathEscaping = service == "s3"
+	})
+
+	if _, err := signer.Sign(request, bytes.NewReader(body), service, region, time.Now()); err != nil {
+		return CassetteResponse{}, fmt.Errorf("error signing request: %w", err)
+	}
+
+	resp, err := s.client.Do(request)
+
+	if err != nil {
+		return CassetteResponse{}, err
+	}
+
+	defer resp.Body.Close()
+
+	responseBody, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return CassetteResponse{}, err
+	}
+
+	return newCassetteResponse(resp.StatusCode, resp.Header, responseBody), nil
+}
+
+// replay returns the recorded response to the request, with the generated names of the
+// recording replaced by those of the running test.
+func (s *cassetteServer) replay(service, operation string, request CassetteRequest) (CassetteResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cassette == nil {
+		return CassetteResponse{}, false
+	}
+
+	interaction := s.cassette.match(service, operation, request)
+
+	if interaction == nil {
+		return CassetteResponse{}, false
+	}
+
+	interaction.replayed = true
+
+	if recorded, names := interaction.Request.names(), request.names(); len(recorded) == len(names) {
+		for i, name := range recorded {
+			s.names[name] = names[i]
+		}
+	}
+
+	response := interaction.Response
+
+	if len(s.names) == 0 || response.BodyEncoding != "" {
+		return response, true
+	}
+
+	replace := func(v string) string {
+		return cassetteNameRegexp.ReplaceAllStringFunc(v, func(recorded string) string {
+			if name, ok := s.names[recorded]; ok {
+				return name
+			}
+
+			return recorded
+		})
+	}
+
+	response.Body = replace(response.Body)
+	response.Header = response.Header.Clone()
+
+	for name, values := range response.Header {
+		for i, value := range values {
+			response.Header[name][i] = replace(value)
+		}
+	}
+
+	return response, true
+}
@@ -0,0 +1,246 @@
+package acctest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+func TestCassetteMatch(t *testing.T) {
+	describe := func(body, status string) *CassetteInteraction {
+		return &CassetteInteraction{
+			Service:   "ec2",
+			Operation: "DescribeVpcs",
+			Request:   CassetteRequest{Method: http.MethodPost, Path: "/", Body: body},
+			Response:  CassetteResponse{StatusCode: http.StatusOK, Body: status},
+		}
+	}
+
+	cassette := &Cassette{
+		Interactions: []*CassetteInteraction{
+			describe("VpcId.1=vpc-1", "pending"),
+			describe("VpcId.1=vpc-1", "available"),
+			describe("Filter.1.Value.1=tf-acc-test-1234", "named"),
+			describe("VpcId.1=vpc-2", "other"),
+		},
+	}
+
+	testCases := []struct {
+		Name      string
+		Operation string
+		Body      string
+		Expected  string
+	}{
+		{Name: "first exact", Operation: "DescribeVpcs", Body: "VpcId.1=vpc-1", Expected: "pending"},
+		{Name: "generated name", Operation: "DescribeVpcs", Body: "Filter.1.Value.1=tf-acc-test-5678", Expected: "named"},
+		{Name: "second exact", Operation: "DescribeVpcs", Body: "VpcId.1=vpc-1", Expected: "available"},
+		{Name: "repeat last exact", Operation: "DescribeVpcs", Body: "VpcId.1=vpc-1", Expected: "available"},
+		{Name: "first unreplayed", Operation: "DescribeVpcs", Body: "VpcId.1=vpc-3", Expected: "other"},
+		{Name: "repeat last", Operation: "DescribeVpcs", Body: "VpcId.1=vpc-3", Expected: "other"},
+		{Name: "no match", Operation: "DescribeSubnets", Body: "VpcId.1=vpc-1"},
+	}
+
+	for _, testCase := range testCases {
+		interaction := cassette.match("ec2", testCase.Operation, CassetteRequest{Method: http.MethodPost, Path: "/", Body: testCase.Body})
+
+		if testCase.Expected == "" {
+			if interaction != nil {
+				t.Errorf("%s: expected no match, got %s", testCase.Name, interaction.Response.Body)
+			}
+
+			continue
+		}
+
+		if interaction == nil {
+			t.Errorf("%s: expected %s, got no match", testCase.Name, testCase.Expected)
+			continue
+		}
+
+		interaction.replayed = true
+
+		if got := interaction.Response.Body; got != testCase.Expected {
+			t.Errorf("%s: expected %s, got %s", testCase.Name, testCase.Expected, got)
+		}
+	}
+}
+
+func TestCassetteServerReplay(t *testing.T) {
+	server := newCassetteServer(CassetteModeReplay, nil)
+	defer server.Close()
+
+	server.load(&Cassette{
+		Interactions: []*CassetteInteraction{
+			{
+				Service:   "sqs",
+				Region:    "us-west-2", //lintignore:AWSAT003
+				Operation: "CreateQueue",
+				Request: CassetteRequest{
+					Method: http.MethodPost,
+					Path:   "/",
+					Body:   "Action=CreateQueue&QueueName=tf-acc-test-1234&Version=2012-11-05",
+				},
+				Response: CassetteResponse{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Content-Type": []string{"text/xml"}},
+					Body:       `<CreateQueueResponse><CreateQueueResult><QueueUrl>https://sqs.us-west-2.amazonaws.com/123456789012/tf-acc-test-1234</QueueUrl></CreateQueueResult></CreateQueueResponse>`, //lintignore:AWSAT003
+				},
+			},
+		},
+	})
+
+	conn := testCassetteSQSConn(t, server.URL())
+
+	output, err := conn.CreateQueue(&sqs.CreateQueueInput{QueueName: aws.String("tf-acc-test-5678")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(output.QueueUrl), "https://sqs.us-west-2.amazonaws.com/123456789012/tf-acc-test-5678"; got != expected { //lintignore:AWSAT003
+		t.Errorf("expected QueueUrl %s, got %s", expected, got)
+	}
+
+	if _, err := conn.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: output.QueueUrl}); err == nil {
+		t.Error("expected error for request without recorded interaction")
+	}
+}
+
+func TestCassetteServerRecord(t *testing.T) {
+	var authorization string
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte(`<CreateQueueResponse><CreateQueueResult><QueueUrl>https://sqs.us-west-2.amazonaws.com/123456789012/test</QueueUrl></CreateQueueResult></CreateQueueResponse>`)) //nolint:errcheck //lintignore:AWSAT003
+	}))
+	defer upstream.Close()
+
+	server := newCassetteServer(CassetteModeRecord, credentials.NewStaticCredentials("RECORDING", "SECRET", ""))
+	defer server.Close()
+
+	server.upstream = func(service, region string) (string, error) {
+		return upstream.URL, nil
+	}
+
+	cassette := &Cassette{}
+	server.load(cassette)
+
+	conn := testCassetteSQSConn(t, server.URL())
+
+	output, err := conn.CreateQueue(&sqs.CreateQueueInput{QueueName: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(output.QueueUrl), "https://sqs.us-west-2.amazonaws.com/123456789012/test"; got != expected { //lintignore:AWSAT003
+		t.Errorf("expected QueueUrl %s, got %s", expected, got)
+	}
+
+	if !strings.Contains(authorization, "Credential=RECORDING/") {
+		t.Errorf("expected request re-signed with recording credentials, got Authorization: %s", authorization)
+	}
+
+	if got, expected := len(cassette.Interactions), 1; got != expected {
+		t.Fatalf("expected %d interactions, got %d", expected, got)
+	}
+
+	interaction := cassette.Interactions[0]
+
+	if interaction.Service != "sqs" || interaction.Region != "us-west-2" || interaction.Operation != "CreateQueue" { //lintignore:AWSAT003
+		t.Errorf("unexpected interaction: %s %s %s", interaction.Service, interaction.Region, interaction.Operation)
+	}
+
+	if got := interaction.Response.Header.Get("Date"); got != "" {
+		t.Errorf("expected Date header not to be recorded, got %s", got)
+	}
+}
+
+func TestCassetteWriteRedacts(t *testing.T) {
+	cassette := &Cassette{
+		Interactions: []*CassetteInteraction{
+			{
+				Service:   "sts",
+				Operation: "GetCallerIdentity",
+				Request:   CassetteRequest{Method: http.MethodPost, Path: "/", Body: "Action=GetCallerIdentity&Version=2011-06-15"},
+				Response: CassetteResponse{
+					StatusCode: http.StatusOK,
+					Body:       "<GetCallerIdentityResult><Arn>arn:aws:iam::111122223333:user/test</Arn><Account>111122223333</Account></GetCallerIdentityResult>",
+				},
+			},
+			{
+				Service:   "s3",
+				Operation: "GET /tf-acc-test-1234/object",
+				Request: CassetteRequest{
+					Method: http.MethodGet,
+					Path:   "/tf-acc-test-1234/object",
+					Query:  "X-Amz-Credential=ASIAEXAMPLE%2F20220101&X-Amz-Security-Token=SECRETTOKEN&X-Amz-Signature=abcdef&versionId=1",
+				},
+				Response: CassetteResponse{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Authorization": {"AWS4-HMAC-SHA256 Credential=ASIAEXAMPLE"}, "X-Amz-Security-Token": {"SECRETTOKEN"}, "X-Amz-Expected-Bucket-Owner": {"111122223333"}},
+					Body:       `{"Url":"https://example.com/?X-Amz-Security-Token=SECRETTOKEN","Owner":"111122223333"}`,
+				},
+			},
+		},
+	}
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	if err := cassette.write(path); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	written, err := readCassette(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var values []string
+
+	for _, interaction := range written.Interactions {
+		values = append(values, interaction.Request.Path, interaction.Request.Query, interaction.Request.Body, interaction.Response.Body)
+
+		for name, v := range interaction.Response.Header {
+			values = append(values, name+": "+strings.Join(v, ","))
+		}
+	}
+
+	got := strings.Join(values, "\n")
+
+	for _, v := range []string{"111122223333", "ASIAEXAMPLE", "SECRETTOKEN", "abcdef", "Authorization"} {
+		if strings.Contains(got, v) {
+			t.Errorf("expected %s to be redacted, got:\n%s", v, got)
+		}
+	}
+
+	for _, v := range []string{"<Account>123456789012</Account>", "arn:aws:iam::123456789012:user/test", "X-Amz-Security-Token=REDACTED", "versionId=1", "tf-acc-test-1234"} {
+		if !strings.Contains(got, v) {
+			t.Errorf("expected %s to be kept, got:\n%s", v, got)
+		}
+	}
+}
+
+func testCassetteSQSConn(t *testing.T, endpoint string) *sqs.SQS {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(endpoint),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	return sqs.New(sess)
+}
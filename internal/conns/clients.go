@@ -0,0 +1,2220 @@
+package conns
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/alexaforbusiness"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/aws/aws-sdk-go/service/amplifybackend"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/aws/aws-sdk-go/service/appflow"
+	"github.com/aws/aws-sdk-go/service/appintegrationsservice"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/applicationcostprofiler"
+	"github.com/aws/aws-sdk-go/service/applicationdiscoveryservice"
+	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/aws/aws-sdk-go/service/augmentedairuntime"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscalingplans"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/braket"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	"github.com/aws/aws-sdk-go/service/clouddirectory"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/aws/aws-sdk-go/service/cloudsearchdomain"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/codeartifact"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/codeguruprofiler"
+	"github.com/aws/aws-sdk-go/service/codegurureviewer"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/codestar"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	"github.com/aws/aws-sdk-go/service/codestarnotifications"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/cognitosync"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	"github.com/aws/aws-sdk-go/service/comprehendmedical"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/aws/aws-sdk-go/service/connectcontactlens"
+	"github.com/aws/aws-sdk-go/service/connectparticipant"
+	"github.com/aws/aws-sdk-go/service/costandusagereportservice"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/dataexchange"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/aws/aws-sdk-go/service/devopsguru"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodbstreams"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2instanceconnect"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elasticinference"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/finspace"
+	"github.com/aws/aws-sdk-go/service/finspacedata"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fis"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/forecastqueryservice"
+	"github.com/aws/aws-sdk-go/service/forecastservice"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/gluedatabrew"
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/aws/aws-sdk-go/service/groundstation"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/health"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/aws/aws-sdk-go/service/honeycode"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/iot1clickdevicesservice"
+	"github.com/aws/aws-sdk-go/service/iot1clickprojects"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/aws/aws-sdk-go/service/iotdataplane"
+	"github.com/aws/aws-sdk-go/service/iotdeviceadvisor"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/aws/aws-sdk-go/service/ioteventsdata"
+	"github.com/aws/aws-sdk-go/service/iotfleethub"
+	"github.com/aws/aws-sdk-go/service/iotjobsdataplane"
+	"github.com/aws/aws-sdk-go/service/iotsecuretunneling"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/aws/aws-sdk-go/service/iotthingsgraph"
+	"github.com/aws/aws-sdk-go/service/iotwireless"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kafkaconnect"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/aws/aws-sdk-go/service/kinesisvideoarchivedmedia"
+	"github.com/aws/aws-sdk-go/service/kinesisvideomedia"
+	"github.com/aws/aws-sdk-go/service/kinesisvideosignalingchannels"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/aws/aws-sdk-go/service/lexruntimeservice"
+	"github.com/aws/aws-sdk-go/service/lexruntimev2"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/aws/aws-sdk-go/service/lookoutequipment"
+	"github.com/aws/aws-sdk-go/service/lookoutforvision"
+	"github.com/aws/aws-sdk-go/service/lookoutmetrics"
+	"github.com/aws/aws-sdk-go/service/machinelearning"
+	"github.com/aws/aws-sdk-go/service/macie"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/aws/aws-sdk-go/service/marketplacecatalog"
+	"github.com/aws/aws-sdk-go/service/marketplacecommerceanalytics"
+	"github.com/aws/aws-sdk-go/service/marketplaceentitlementservice"
+	"github.com/aws/aws-sdk-go/service/marketplacemetering"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/aws/aws-sdk-go/service/mediapackagevod"
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/mediastoredata"
+	"github.com/aws/aws-sdk-go/service/mediatailor"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/aws/aws-sdk-go/service/mgn"
+	"github.com/aws/aws-sdk-go/service/migrationhub"
+	"github.com/aws/aws-sdk-go/service/migrationhubconfig"
+	"github.com/aws/aws-sdk-go/service/mobile"
+	"github.com/aws/aws-sdk-go/service/mobileanalytics"
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/mturk"
+	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/aws/aws-sdk-go/service/nimblestudio"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/opsworkscm"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/aws/aws-sdk-go/service/personalizeevents"
+	"github.com/aws/aws-sdk-go/service/personalizeruntime"
+	"github.com/aws/aws-sdk-go/service/pi"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/aws/aws-sdk-go/service/pinpointemail"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoice"
+	"github.com/aws/aws-sdk-go/service/polly"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/aws/aws-sdk-go/service/qldbsession"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/rdsdataservice"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/redshiftdataapiservice"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/robomaker"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/s3outposts"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/sagemakeredgemanager"
+	"github.com/aws/aws-sdk-go/service/sagemakerfeaturestoreruntime"
+	"github.com/aws/aws-sdk-go/service/sagemakerruntime"
+	"github.com/aws/aws-sdk-go/service/savingsplans"
+	"github.com/aws/aws-sdk-go/service/schemas"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/aws/aws-sdk-go/service/signer"
+	"github.com/aws/aws-sdk-go/service/simpledb"
+	"github.com/aws/aws-sdk-go/service/sms"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/aws/aws-sdk-go/service/sso"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/aws/aws-sdk-go/service/ssooidc"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/support"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/aws/aws-sdk-go/service/textract"
+	"github.com/aws/aws-sdk-go/service/timestreamquery"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/aws/aws-sdk-go/service/transcribestreamingservice"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/wellarchitected"
+	"github.com/aws/aws-sdk-go/service/workdocs"
+	"github.com/aws/aws-sdk-go/service/worklink"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/aws/aws-sdk-go/service/workmailmessageflow"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+// serviceConnCache is a cache of service clients by name, shared by an AWSClient and its copies.
+type serviceConnCache struct {
+	conns map[string]interface{}
+	mutex sync.Mutex
+}
+
+// serviceConn returns the service client with the specified name, creating it on first use.
+// Creating the ~250 service clients only when a configuration uses them keeps provider start up fast.
+func (client *AWSClient) serviceConn(name string, newConn func() interface{}) interface{} {
+	cache := client.serviceConns
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if conn, ok := cache.conns[name]; ok {
+		return conn
+	}
+
+	conn := newConn()
+	cache.conns[name] = conn
+
+	return conn
+}
+
+// serviceSession returns a copy of the client's session with the endpoint and region of the service with the specified endpoint key.
+func (client *AWSClient) serviceSession(key string) *session.Session {
+	return client.session.Copy(client.config.serviceConfig(key, client.Partition))
+}
+
+func (client *AWSClient) AccessAnalyzerConn() *accessanalyzer.AccessAnalyzer {
+	return client.serviceConn("AccessAnalyzerConn", func() interface{} {
+		return accessanalyzer.New(client.serviceSession(AccessAnalyzer))
+	}).(*accessanalyzer.AccessAnalyzer)
+}
+
+func (client *AWSClient) AccountConn() *account.Account {
+	return client.serviceConn("AccountConn", func() interface{} {
+		return account.New(client.serviceSession(Account))
+	}).(*account.Account)
+}
+
+func (client *AWSClient) ACMConn() *acm.ACM {
+	return client.serviceConn("ACMConn", func() interface{} {
+		return acm.New(client.serviceSession(ACM))
+	}).(*acm.ACM)
+}
+
+func (client *AWSClient) ACMPCAConn() *acmpca.ACMPCA {
+	return client.serviceConn("ACMPCAConn", func() interface{} {
+		return acmpca.New(client.serviceSession(ACMPCA))
+	}).(*acmpca.ACMPCA)
+}
+
+func (client *AWSClient) AlexaForBusinessConn() *alexaforbusiness.AlexaForBusiness {
+	return client.serviceConn("AlexaForBusinessConn", func() interface{} {
+		return alexaforbusiness.New(client.serviceSession(AlexaForBusiness))
+	}).(*alexaforbusiness.AlexaForBusiness)
+}
+
+func (client *AWSClient) AMPConn() *prometheusservice.PrometheusService {
+	return client.serviceConn("AMPConn", func() interface{} {
+		return prometheusservice.New(client.serviceSession(AMP))
+	}).(*prometheusservice.PrometheusService)
+}
+
+func (client *AWSClient) AmplifyBackendConn() *amplifybackend.AmplifyBackend {
+	return client.serviceConn("AmplifyBackendConn", func() interface{} {
+		return amplifybackend.New(client.serviceSession(AmplifyBackend))
+	}).(*amplifybackend.AmplifyBackend)
+}
+
+func (client *AWSClient) AmplifyConn() *amplify.Amplify {
+	return client.serviceConn("AmplifyConn", func() interface{} {
+		return amplify.New(client.serviceSession(Amplify))
+	}).(*amplify.Amplify)
+}
+
+func (client *AWSClient) APIGatewayConn() *apigateway.APIGateway {
+	return client.serviceConn("APIGatewayConn", func() interface{} {
+		conn := apigateway.New(client.serviceSession(APIGateway))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Many operations can return an error such as:
+			//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
+			// Handle them all globally for the service client.
+			if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*apigateway.APIGateway)
+}
+
+func (client *AWSClient) APIGatewayV2Conn() *apigatewayv2.ApiGatewayV2 {
+	return client.serviceConn("APIGatewayV2Conn", func() interface{} {
+		return apigatewayv2.New(client.serviceSession(APIGatewayV2))
+	}).(*apigatewayv2.ApiGatewayV2)
+}
+
+func (client *AWSClient) AppAutoScalingConn() *applicationautoscaling.ApplicationAutoScaling {
+	return client.serviceConn("AppAutoScalingConn", func() interface{} {
+		conn := applicationautoscaling.New(client.serviceSession(AppAutoScaling))
+
+		// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
+				return
+			}
+			if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*applicationautoscaling.ApplicationAutoScaling)
+}
+
+func (client *AWSClient) AppConfigConn() *appconfig.AppConfig {
+	return client.serviceConn("AppConfigConn", func() interface{} {
+		conn := appconfig.New(client.serviceSession(AppConfig))
+
+		// StartDeployment operations can return a ConflictException
+		// if ongoing deployments are in-progress, thus we handle them
+		// here for the service client.
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "StartDeployment" {
+				if tfawserr.ErrCodeEquals(r.Error, appconfig.ErrCodeConflictException) {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*appconfig.AppConfig)
+}
+
+func (client *AWSClient) AppFlowConn() *appflow.Appflow {
+	return client.serviceConn("AppFlowConn", func() interface{} {
+		return appflow.New(client.serviceSession(AppFlow))
+	}).(*appflow.Appflow)
+}
+
+func (client *AWSClient) AppIntegrationsConn() *appintegrationsservice.AppIntegrationsService {
+	return client.serviceConn("AppIntegrationsConn", func() interface{} {
+		return appintegrationsservice.New(client.serviceSession(AppIntegrations))
+	}).(*appintegrationsservice.AppIntegrationsService)
+}
+
+func (client *AWSClient) ApplicationCostProfilerConn() *applicationcostprofiler.ApplicationCostProfiler {
+	return client.serviceConn("ApplicationCostProfilerConn", func() interface{} {
+		return applicationcostprofiler.New(client.serviceSession(ApplicationCostProfiler))
+	}).(*applicationcostprofiler.ApplicationCostProfiler)
+}
+
+func (client *AWSClient) ApplicationDiscoveryConn() *applicationdiscoveryservice.ApplicationDiscoveryService {
+	return client.serviceConn("ApplicationDiscoveryConn", func() interface{} {
+		return applicationdiscoveryservice.New(client.serviceSession(ApplicationDiscovery))
+	}).(*applicationdiscoveryservice.ApplicationDiscoveryService)
+}
+
+func (client *AWSClient) ApplicationInsightsConn() *applicationinsights.ApplicationInsights {
+	return client.serviceConn("ApplicationInsightsConn", func() interface{} {
+		return applicationinsights.New(client.serviceSession(ApplicationInsights))
+	}).(*applicationinsights.ApplicationInsights)
+}
+
+func (client *AWSClient) AppMeshConn() *appmesh.AppMesh {
+	return client.serviceConn("AppMeshConn", func() interface{} {
+		return appmesh.New(client.serviceSession(AppMesh))
+	}).(*appmesh.AppMesh)
+}
+
+func (client *AWSClient) AppRegistryConn() *appregistry.AppRegistry {
+	return client.serviceConn("AppRegistryConn", func() interface{} {
+		return appregistry.New(client.serviceSession(AppRegistry))
+	}).(*appregistry.AppRegistry)
+}
+
+func (client *AWSClient) AppRunnerConn() *apprunner.AppRunner {
+	return client.serviceConn("AppRunnerConn", func() interface{} {
+		return apprunner.New(client.serviceSession(AppRunner))
+	}).(*apprunner.AppRunner)
+}
+
+func (client *AWSClient) AppStreamConn() *appstream.AppStream {
+	return client.serviceConn("AppStreamConn", func() interface{} {
+		return appstream.New(client.serviceSession(AppStream))
+	}).(*appstream.AppStream)
+}
+
+func (client *AWSClient) AppSyncConn() *appsync.AppSync {
+	return client.serviceConn("AppSyncConn", func() interface{} {
+		conn := appsync.New(client.serviceSession(AppSync))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateGraphqlApi" {
+				if tfawserr.ErrMessageContains(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*appsync.AppSync)
+}
+
+func (client *AWSClient) AthenaConn() *athena.Athena {
+	return client.serviceConn("AthenaConn", func() interface{} {
+		return athena.New(client.serviceSession(Athena))
+	}).(*athena.Athena)
+}
+
+func (client *AWSClient) AuditManagerConn() *auditmanager.AuditManager {
+	return client.serviceConn("AuditManagerConn", func() interface{} {
+		return auditmanager.New(client.serviceSession(AuditManager))
+	}).(*auditmanager.AuditManager)
+}
+
+func (client *AWSClient) AugmentedAIRuntimeConn() *augmentedairuntime.AugmentedAIRuntime {
+	return client.serviceConn("AugmentedAIRuntimeConn", func() interface{} {
+		return augmentedairuntime.New(client.serviceSession(AugmentedAIRuntime))
+	}).(*augmentedairuntime.AugmentedAIRuntime)
+}
+
+func (client *AWSClient) AutoScalingConn() *autoscaling.AutoScaling {
+	return client.serviceConn("AutoScalingConn", func() interface{} {
+		return autoscaling.New(client.serviceSession(AutoScaling))
+	}).(*autoscaling.AutoScaling)
+}
+
+func (client *AWSClient) AutoScalingPlansConn() *autoscalingplans.AutoScalingPlans {
+	return client.serviceConn("AutoScalingPlansConn", func() interface{} {
+		return autoscalingplans.New(client.serviceSession(AutoScalingPlans))
+	}).(*autoscalingplans.AutoScalingPlans)
+}
+
+func (client *AWSClient) BackupConn() *backup.Backup {
+	return client.serviceConn("BackupConn", func() interface{} {
+		return backup.New(client.serviceSession(Backup))
+	}).(*backup.Backup)
+}
+
+func (client *AWSClient) BatchConn() *batch.Batch {
+	return client.serviceConn("BatchConn", func() interface{} {
+		return batch.New(client.serviceSession(Batch))
+	}).(*batch.Batch)
+}
+
+func (client *AWSClient) BraketConn() *braket.Braket {
+	return client.serviceConn("BraketConn", func() interface{} {
+		return braket.New(client.serviceSession(Braket))
+	}).(*braket.Braket)
+}
+
+func (client *AWSClient) BudgetsConn() *budgets.Budgets {
+	return client.serviceConn("BudgetsConn", func() interface{} {
+		return budgets.New(client.serviceSession(Budgets))
+	}).(*budgets.Budgets)
+}
+
+func (client *AWSClient) ChimeConn() *chime.Chime {
+	return client.serviceConn("ChimeConn", func() interface{} {
+		conn := chime.New(client.serviceSession(Chime))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// When calling CreateVoiceConnector across multiple resources,
+			// the API can randomly return a BadRequestException without explanation
+			if r.Operation.Name == "CreateVoiceConnector" {
+				if tfawserr.ErrMessageContains(r.Error, chime.ErrCodeBadRequestException, "Service received a bad request") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*chime.Chime)
+}
+
+func (client *AWSClient) Cloud9Conn() *cloud9.Cloud9 {
+	return client.serviceConn("Cloud9Conn", func() interface{} {
+		return cloud9.New(client.serviceSession(Cloud9))
+	}).(*cloud9.Cloud9)
+}
+
+func (client *AWSClient) CloudControlConn() *cloudcontrolapi.CloudControlApi {
+	return client.serviceConn("CloudControlConn", func() interface{} {
+		return cloudcontrolapi.New(client.serviceSession(CloudControl))
+	}).(*cloudcontrolapi.CloudControlApi)
+}
+
+func (client *AWSClient) CloudDirectoryConn() *clouddirectory.CloudDirectory {
+	return client.serviceConn("CloudDirectoryConn", func() interface{} {
+		return clouddirectory.New(client.serviceSession(CloudDirectory))
+	}).(*clouddirectory.CloudDirectory)
+}
+
+func (client *AWSClient) CloudFormationConn() *cloudformation.CloudFormation {
+	return client.serviceConn("CloudFormationConn", func() interface{} {
+		conn := cloudformation.New(client.serviceSession(CloudFormation))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, cloudformation.ErrCodeOperationInProgressException, "Another Operation on StackSet") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*cloudformation.CloudFormation)
+}
+
+func (client *AWSClient) CloudFrontConn() *cloudfront.CloudFront {
+	return client.serviceConn("CloudFrontConn", func() interface{} {
+		return cloudfront.New(client.serviceSession(CloudFront))
+	}).(*cloudfront.CloudFront)
+}
+
+func (client *AWSClient) CloudHSMV2Conn() *cloudhsmv2.CloudHSMV2 {
+	return client.serviceConn("CloudHSMV2Conn", func() interface{} {
+		conn := cloudhsmv2.New(client.serviceSession(CloudHSMV2))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*cloudhsmv2.CloudHSMV2)
+}
+
+func (client *AWSClient) CloudSearchConn() *cloudsearch.CloudSearch {
+	return client.serviceConn("CloudSearchConn", func() interface{} {
+		return cloudsearch.New(client.serviceSession(CloudSearch))
+	}).(*cloudsearch.CloudSearch)
+}
+
+func (client *AWSClient) CloudSearchDomainConn() *cloudsearchdomain.CloudSearchDomain {
+	return client.serviceConn("CloudSearchDomainConn", func() interface{} {
+		return cloudsearchdomain.New(client.serviceSession(CloudSearchDomain))
+	}).(*cloudsearchdomain.CloudSearchDomain)
+}
+
+func (client *AWSClient) CloudTrailConn() *cloudtrail.CloudTrail {
+	return client.serviceConn("CloudTrailConn", func() interface{} {
+		return cloudtrail.New(client.serviceSession(CloudTrail))
+	}).(*cloudtrail.CloudTrail)
+}
+
+func (client *AWSClient) CloudWatchConn() *cloudwatch.CloudWatch {
+	return client.serviceConn("CloudWatchConn", func() interface{} {
+		return cloudwatch.New(client.serviceSession(CloudWatch))
+	}).(*cloudwatch.CloudWatch)
+}
+
+func (client *AWSClient) CloudWatchLogsConn() *cloudwatchlogs.CloudWatchLogs {
+	return client.serviceConn("CloudWatchLogsConn", func() interface{} {
+		return cloudwatchlogs.New(client.serviceSession(CloudWatchLogs))
+	}).(*cloudwatchlogs.CloudWatchLogs)
+}
+
+func (client *AWSClient) CodeArtifactConn() *codeartifact.CodeArtifact {
+	return client.serviceConn("CodeArtifactConn", func() interface{} {
+		return codeartifact.New(client.serviceSession(CodeArtifact))
+	}).(*codeartifact.CodeArtifact)
+}
+
+func (client *AWSClient) CodeBuildConn() *codebuild.CodeBuild {
+	return client.serviceConn("CodeBuildConn", func() interface{} {
+		return codebuild.New(client.serviceSession(CodeBuild))
+	}).(*codebuild.CodeBuild)
+}
+
+func (client *AWSClient) CodeCommitConn() *codecommit.CodeCommit {
+	return client.serviceConn("CodeCommitConn", func() interface{} {
+		return codecommit.New(client.serviceSession(CodeCommit))
+	}).(*codecommit.CodeCommit)
+}
+
+func (client *AWSClient) CodeDeployConn() *codedeploy.CodeDeploy {
+	return client.serviceConn("CodeDeployConn", func() interface{} {
+		return codedeploy.New(client.serviceSession(CodeDeploy))
+	}).(*codedeploy.CodeDeploy)
+}
+
+func (client *AWSClient) CodeGuruProfilerConn() *codeguruprofiler.CodeGuruProfiler {
+	return client.serviceConn("CodeGuruProfilerConn", func() interface{} {
+		return codeguruprofiler.New(client.serviceSession(CodeGuruProfiler))
+	}).(*codeguruprofiler.CodeGuruProfiler)
+}
+
+func (client *AWSClient) CodeGuruReviewerConn() *codegurureviewer.CodeGuruReviewer {
+	return client.serviceConn("CodeGuruReviewerConn", func() interface{} {
+		return codegurureviewer.New(client.serviceSession(CodeGuruReviewer))
+	}).(*codegurureviewer.CodeGuruReviewer)
+}
+
+func (client *AWSClient) CodePipelineConn() *codepipeline.CodePipeline {
+	return client.serviceConn("CodePipelineConn", func() interface{} {
+		return codepipeline.New(client.serviceSession(CodePipeline))
+	}).(*codepipeline.CodePipeline)
+}
+
+func (client *AWSClient) CodeStarConn() *codestar.CodeStar {
+	return client.serviceConn("CodeStarConn", func() interface{} {
+		return codestar.New(client.serviceSession(CodeStar))
+	}).(*codestar.CodeStar)
+}
+
+func (client *AWSClient) CodeStarConnectionsConn() *codestarconnections.CodeStarConnections {
+	return client.serviceConn("CodeStarConnectionsConn", func() interface{} {
+		return codestarconnections.New(client.serviceSession(CodeStarConnections))
+	}).(*codestarconnections.CodeStarConnections)
+}
+
+func (client *AWSClient) CodeStarNotificationsConn() *codestarnotifications.CodeStarNotifications {
+	return client.serviceConn("CodeStarNotificationsConn", func() interface{} {
+		return codestarnotifications.New(client.serviceSession(CodeStarNotifications))
+	}).(*codestarnotifications.CodeStarNotifications)
+}
+
+func (client *AWSClient) CognitoIdentityConn() *cognitoidentity.CognitoIdentity {
+	return client.serviceConn("CognitoIdentityConn", func() interface{} {
+		return cognitoidentity.New(client.serviceSession(CognitoIdentity))
+	}).(*cognitoidentity.CognitoIdentity)
+}
+
+func (client *AWSClient) CognitoIDPConn() *cognitoidentityprovider.CognitoIdentityProvider {
+	return client.serviceConn("CognitoIDPConn", func() interface{} {
+		return cognitoidentityprovider.New(client.serviceSession(CognitoIDP))
+	}).(*cognitoidentityprovider.CognitoIdentityProvider)
+}
+
+func (client *AWSClient) CognitoSyncConn() *cognitosync.CognitoSync {
+	return client.serviceConn("CognitoSyncConn", func() interface{} {
+		return cognitosync.New(client.serviceSession(CognitoSync))
+	}).(*cognitosync.CognitoSync)
+}
+
+func (client *AWSClient) ComprehendConn() *comprehend.Comprehend {
+	return client.serviceConn("ComprehendConn", func() interface{} {
+		return comprehend.New(client.serviceSession(Comprehend))
+	}).(*comprehend.Comprehend)
+}
+
+func (client *AWSClient) ComprehendMedicalConn() *comprehendmedical.ComprehendMedical {
+	return client.serviceConn("ComprehendMedicalConn", func() interface{} {
+		return comprehendmedical.New(client.serviceSession(ComprehendMedical))
+	}).(*comprehendmedical.ComprehendMedical)
+}
+
+func (client *AWSClient) ConfigServiceConn() *configservice.ConfigService {
+	return client.serviceConn("ConfigServiceConn", func() interface{} {
+		conn := configservice.New(client.serviceSession(ConfigService))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// When calling Config Organization Rules API actions immediately
+			// after Organization creation, the API can randomly return the
+			// OrganizationAccessDeniedException error for a few minutes, even
+			// after succeeding a few requests.
+			switch r.Operation.Name {
+			case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
+				if !tfawserr.ErrMessageContains(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
+					return
+				}
+
+				// We only want to retry briefly as the default max retry count would
+				// excessively retry when the error could be legitimate.
+				// We currently depend on the DefaultRetryer exponential backoff here.
+				// ~10 retries gives a fair backoff of a few seconds.
+				if r.RetryCount < 9 {
+					r.Retryable = aws.Bool(true)
+				} else {
+					r.Retryable = aws.Bool(false)
+				}
+			case "DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack":
+				if !tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeOrganizationAccessDeniedException) {
+					if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeResourceInUseException) {
+						r.Retryable = aws.Bool(true)
+					}
+					return
+				}
+
+				// We only want to retry briefly as the default max retry count would
+				// excessively retry when the error could be legitimate.
+				// We currently depend on the DefaultRetryer exponential backoff here.
+				// ~10 retries gives a fair backoff of a few seconds.
+				if r.RetryCount < 9 {
+					r.Retryable = aws.Bool(true)
+				} else {
+					r.Retryable = aws.Bool(false)
+				}
+			}
+		})
+
+		return conn
+	}).(*configservice.ConfigService)
+}
+
+func (client *AWSClient) ConnectConn() *connect.Connect {
+	return client.serviceConn("ConnectConn", func() interface{} {
+		return connect.New(client.serviceSession(Connect))
+	}).(*connect.Connect)
+}
+
+func (client *AWSClient) ConnectContactLensConn() *connectcontactlens.ConnectContactLens {
+	return client.serviceConn("ConnectContactLensConn", func() interface{} {
+		return connectcontactlens.New(client.serviceSession(ConnectContactLens))
+	}).(*connectcontactlens.ConnectContactLens)
+}
+
+func (client *AWSClient) ConnectParticipantConn() *connectparticipant.ConnectParticipant {
+	return client.serviceConn("ConnectParticipantConn", func() interface{} {
+		return connectparticipant.New(client.serviceSession(ConnectParticipant))
+	}).(*connectparticipant.ConnectParticipant)
+}
+
+func (client *AWSClient) CostExplorerConn() *costexplorer.CostExplorer {
+	return client.serviceConn("CostExplorerConn", func() interface{} {
+		return costexplorer.New(client.serviceSession(CostExplorer))
+	}).(*costexplorer.CostExplorer)
+}
+
+func (client *AWSClient) CURConn() *costandusagereportservice.CostandUsageReportService {
+	return client.serviceConn("CURConn", func() interface{} {
+		return costandusagereportservice.New(client.serviceSession(CUR))
+	}).(*costandusagereportservice.CostandUsageReportService)
+}
+
+func (client *AWSClient) DataExchangeConn() *dataexchange.DataExchange {
+	return client.serviceConn("DataExchangeConn", func() interface{} {
+		return dataexchange.New(client.serviceSession(DataExchange))
+	}).(*dataexchange.DataExchange)
+}
+
+func (client *AWSClient) DataPipelineConn() *datapipeline.DataPipeline {
+	return client.serviceConn("DataPipelineConn", func() interface{} {
+		return datapipeline.New(client.serviceSession(DataPipeline))
+	}).(*datapipeline.DataPipeline)
+}
+
+func (client *AWSClient) DataSyncConn() *datasync.DataSync {
+	return client.serviceConn("DataSyncConn", func() interface{} {
+		return datasync.New(client.serviceSession(DataSync))
+	}).(*datasync.DataSync)
+}
+
+func (client *AWSClient) DAXConn() *dax.DAX {
+	return client.serviceConn("DAXConn", func() interface{} {
+		return dax.New(client.serviceSession(DAX))
+	}).(*dax.DAX)
+}
+
+func (client *AWSClient) DetectiveConn() *detective.Detective {
+	return client.serviceConn("DetectiveConn", func() interface{} {
+		return detective.New(client.serviceSession(Detective))
+	}).(*detective.Detective)
+}
+
+func (client *AWSClient) DeviceFarmConn() *devicefarm.DeviceFarm {
+	return client.serviceConn("DeviceFarmConn", func() interface{} {
+		return devicefarm.New(client.serviceSession(DeviceFarm))
+	}).(*devicefarm.DeviceFarm)
+}
+
+func (client *AWSClient) DevOpsGuruConn() *devopsguru.DevOpsGuru {
+	return client.serviceConn("DevOpsGuruConn", func() interface{} {
+		return devopsguru.New(client.serviceSession(DevOpsGuru))
+	}).(*devopsguru.DevOpsGuru)
+}
+
+func (client *AWSClient) DirectConnectConn() *directconnect.DirectConnect {
+	return client.serviceConn("DirectConnectConn", func() interface{} {
+		return directconnect.New(client.serviceSession(DirectConnect))
+	}).(*directconnect.DirectConnect)
+}
+
+func (client *AWSClient) DLMConn() *dlm.DLM {
+	return client.serviceConn("DLMConn", func() interface{} {
+		return dlm.New(client.serviceSession(DLM))
+	}).(*dlm.DLM)
+}
+
+func (client *AWSClient) DMSConn() *databasemigrationservice.DatabaseMigrationService {
+	return client.serviceConn("DMSConn", func() interface{} {
+		return databasemigrationservice.New(client.serviceSession(DMS))
+	}).(*databasemigrationservice.DatabaseMigrationService)
+}
+
+func (client *AWSClient) DocDBConn() *docdb.DocDB {
+	return client.serviceConn("DocDBConn", func() interface{} {
+		return docdb.New(client.serviceSession(DocDB))
+	}).(*docdb.DocDB)
+}
+
+func (client *AWSClient) DSConn() *directoryservice.DirectoryService {
+	return client.serviceConn("DSConn", func() interface{} {
+		return directoryservice.New(client.serviceSession(DS))
+	}).(*directoryservice.DirectoryService)
+}
+
+func (client *AWSClient) DynamoDBConn() *dynamodb.DynamoDB {
+	return client.serviceConn("DynamoDBConn", func() interface{} {
+		conn := dynamodb.New(client.serviceSession(DynamoDB))
+
+		// See https://github.com/aws/aws-sdk-go/pull/1276
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
+				return
+			}
+			if tfawserr.ErrMessageContains(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*dynamodb.DynamoDB)
+}
+
+func (client *AWSClient) DynamoDBStreamsConn() *dynamodbstreams.DynamoDBStreams {
+	return client.serviceConn("DynamoDBStreamsConn", func() interface{} {
+		return dynamodbstreams.New(client.serviceSession(DynamoDBStreams))
+	}).(*dynamodbstreams.DynamoDBStreams)
+}
+
+func (client *AWSClient) EC2Conn() *ec2.EC2 {
+	return client.serviceConn("EC2Conn", func() interface{} {
+		conn := ec2.New(client.serviceSession(EC2))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			switch err := r.Error; r.Operation.Name {
+			case "AttachVpnGateway", "DetachVpnGateway":
+				if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
+					r.Retryable = aws.Bool(true)
+				}
+
+			case "CreateClientVpnEndpoint":
+				if tfawserr.ErrMessageContains(err, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
+					r.Retryable = aws.Bool(true)
+				}
+
+			case "CreateClientVpnRoute", "DeleteClientVpnRoute":
+				if tfawserr.ErrMessageContains(err, "ConcurrentMutationLimitExceeded", "Cannot initiate another change for this endpoint at this time") {
+					r.Retryable = aws.Bool(true)
+				}
+
+			case "CreateVpnConnection":
+				if tfawserr.ErrMessageContains(err, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
+					r.Retryable = aws.Bool(true)
+				}
+
+			case "CreateVpnGateway":
+				if tfawserr.ErrMessageContains(err, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*ec2.EC2)
+}
+
+func (client *AWSClient) EC2InstanceConnectConn() *ec2instanceconnect.EC2InstanceConnect {
+	return client.serviceConn("EC2InstanceConnectConn", func() interface{} {
+		return ec2instanceconnect.New(client.serviceSession(EC2InstanceConnect))
+	}).(*ec2instanceconnect.EC2InstanceConnect)
+}
+
+func (client *AWSClient) ECRConn() *ecr.ECR {
+	return client.serviceConn("ECRConn", func() interface{} {
+		return ecr.New(client.serviceSession(ECR))
+	}).(*ecr.ECR)
+}
+
+func (client *AWSClient) ECRPublicConn() *ecrpublic.ECRPublic {
+	return client.serviceConn("ECRPublicConn", func() interface{} {
+		return ecrpublic.New(client.serviceSession(ECRPublic))
+	}).(*ecrpublic.ECRPublic)
+}
+
+func (client *AWSClient) ECSConn() *ecs.ECS {
+	return client.serviceConn("ECSConn", func() interface{} {
+		return ecs.New(client.serviceSession(ECS))
+	}).(*ecs.ECS)
+}
+
+func (client *AWSClient) EFSConn() *efs.EFS {
+	return client.serviceConn("EFSConn", func() interface{} {
+		return efs.New(client.serviceSession(EFS))
+	}).(*efs.EFS)
+}
+
+func (client *AWSClient) EKSConn() *eks.EKS {
+	return client.serviceConn("EKSConn", func() interface{} {
+		return eks.New(client.serviceSession(EKS))
+	}).(*eks.EKS)
+}
+
+func (client *AWSClient) ElastiCacheConn() *elasticache.ElastiCache {
+	return client.serviceConn("ElastiCacheConn", func() interface{} {
+		return elasticache.New(client.serviceSession(ElastiCache))
+	}).(*elasticache.ElastiCache)
+}
+
+func (client *AWSClient) ElasticBeanstalkConn() *elasticbeanstalk.ElasticBeanstalk {
+	return client.serviceConn("ElasticBeanstalkConn", func() interface{} {
+		return elasticbeanstalk.New(client.serviceSession(ElasticBeanstalk))
+	}).(*elasticbeanstalk.ElasticBeanstalk)
+}
+
+func (client *AWSClient) ElasticInferenceConn() *elasticinference.ElasticInference {
+	return client.serviceConn("ElasticInferenceConn", func() interface{} {
+		return elasticinference.New(client.serviceSession(ElasticInference))
+	}).(*elasticinference.ElasticInference)
+}
+
+func (client *AWSClient) ElasticsearchConn() *elasticsearch.ElasticsearchService {
+	return client.serviceConn("ElasticsearchConn", func() interface{} {
+		return elasticsearch.New(client.serviceSession(Elasticsearch))
+	}).(*elasticsearch.ElasticsearchService)
+}
+
+func (client *AWSClient) ElasticTranscoderConn() *elastictranscoder.ElasticTranscoder {
+	return client.serviceConn("ElasticTranscoderConn", func() interface{} {
+		return elastictranscoder.New(client.serviceSession(ElasticTranscoder))
+	}).(*elastictranscoder.ElasticTranscoder)
+}
+
+func (client *AWSClient) ELBConn() *elb.ELB {
+	return client.serviceConn("ELBConn", func() interface{} {
+		return elb.New(client.serviceSession(ELB))
+	}).(*elb.ELB)
+}
+
+func (client *AWSClient) ELBV2Conn() *elbv2.ELBV2 {
+	return client.serviceConn("ELBV2Conn", func() interface{} {
+		return elbv2.New(client.serviceSession(ELBV2))
+	}).(*elbv2.ELBV2)
+}
+
+func (client *AWSClient) EMRConn() *emr.EMR {
+	return client.serviceConn("EMRConn", func() interface{} {
+		return emr.New(client.serviceSession(EMR))
+	}).(*emr.EMR)
+}
+
+func (client *AWSClient) EMRContainersConn() *emrcontainers.EMRContainers {
+	return client.serviceConn("EMRContainersConn", func() interface{} {
+		return emrcontainers.New(client.serviceSession(EMRContainers))
+	}).(*emrcontainers.EMRContainers)
+}
+
+func (client *AWSClient) EventsConn() *eventbridge.EventBridge {
+	return client.serviceConn("EventsConn", func() interface{} {
+		return eventbridge.New(client.serviceSession(Events))
+	}).(*eventbridge.EventBridge)
+}
+
+func (client *AWSClient) FinSpaceConn() *finspace.Finspace {
+	return client.serviceConn("FinSpaceConn", func() interface{} {
+		return finspace.New(client.serviceSession(FinSpace))
+	}).(*finspace.Finspace)
+}
+
+func (client *AWSClient) FinSpaceDataConn() *finspacedata.FinSpaceData {
+	return client.serviceConn("FinSpaceDataConn", func() interface{} {
+		return finspacedata.New(client.serviceSession(FinSpaceData))
+	}).(*finspacedata.FinSpaceData)
+}
+
+func (client *AWSClient) FirehoseConn() *firehose.Firehose {
+	return client.serviceConn("FirehoseConn", func() interface{} {
+		return firehose.New(client.serviceSession(Firehose))
+	}).(*firehose.Firehose)
+}
+
+func (client *AWSClient) FISConn() *fis.FIS {
+	return client.serviceConn("FISConn", func() interface{} {
+		return fis.New(client.serviceSession(FIS))
+	}).(*fis.FIS)
+}
+
+func (client *AWSClient) FMSConn() *fms.FMS {
+	return client.serviceConn("FMSConn", func() interface{} {
+		conn := fms.New(client.serviceSession(FMS))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Acceptance testing creates and deletes resources in quick succession.
+			// The FMS onboarding process into Organizations is opaque to consumers.
+			// Since we cannot reasonably check this status before receiving the error,
+			// set the operation as retryable.
+			switch r.Operation.Name {
+			case "AssociateAdminAccount":
+				if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.") {
+					r.Retryable = aws.Bool(true)
+				}
+			case "DisassociateAdminAccount":
+				if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*fms.FMS)
+}
+
+func (client *AWSClient) ForecastConn() *forecastservice.ForecastService {
+	return client.serviceConn("ForecastConn", func() interface{} {
+		return forecastservice.New(client.serviceSession(Forecast))
+	}).(*forecastservice.ForecastService)
+}
+
+func (client *AWSClient) ForecastQueryConn() *forecastqueryservice.ForecastQueryService {
+	return client.serviceConn("ForecastQueryConn", func() interface{} {
+		return forecastqueryservice.New(client.serviceSession(ForecastQuery))
+	}).(*forecastqueryservice.ForecastQueryService)
+}
+
+func (client *AWSClient) FraudDetectorConn() *frauddetector.FraudDetector {
+	return client.serviceConn("FraudDetectorConn", func() interface{} {
+		return frauddetector.New(client.serviceSession(FraudDetector))
+	}).(*frauddetector.FraudDetector)
+}
+
+func (client *AWSClient) FSxConn() *fsx.FSx {
+	return client.serviceConn("FSxConn", func() interface{} {
+		return fsx.New(client.serviceSession(FSx))
+	}).(*fsx.FSx)
+}
+
+func (client *AWSClient) GameLiftConn() *gamelift.GameLift {
+	return client.serviceConn("GameLiftConn", func() interface{} {
+		return gamelift.New(client.serviceSession(GameLift))
+	}).(*gamelift.GameLift)
+}
+
+func (client *AWSClient) GlacierConn() *glacier.Glacier {
+	return client.serviceConn("GlacierConn", func() interface{} {
+		return glacier.New(client.serviceSession(Glacier))
+	}).(*glacier.Glacier)
+}
+
+func (client *AWSClient) GlobalAcceleratorConn() *globalaccelerator.GlobalAccelerator {
+	return client.serviceConn("GlobalAcceleratorConn", func() interface{} {
+		return globalaccelerator.New(client.serviceSession(GlobalAccelerator))
+	}).(*globalaccelerator.GlobalAccelerator)
+}
+
+func (client *AWSClient) GlueConn() *glue.Glue {
+	return client.serviceConn("GlueConn", func() interface{} {
+		return glue.New(client.serviceSession(Glue))
+	}).(*glue.Glue)
+}
+
+func (client *AWSClient) GlueDataBrewConn() *gluedatabrew.GlueDataBrew {
+	return client.serviceConn("GlueDataBrewConn", func() interface{} {
+		return gluedatabrew.New(client.serviceSession(GlueDataBrew))
+	}).(*gluedatabrew.GlueDataBrew)
+}
+
+func (client *AWSClient) GrafanaConn() *managedgrafana.ManagedGrafana {
+	return client.serviceConn("GrafanaConn", func() interface{} {
+		return managedgrafana.New(client.serviceSession(Grafana))
+	}).(*managedgrafana.ManagedGrafana)
+}
+
+func (client *AWSClient) GreengrassConn() *greengrass.Greengrass {
+	return client.serviceConn("GreengrassConn", func() interface{} {
+		return greengrass.New(client.serviceSession(Greengrass))
+	}).(*greengrass.Greengrass)
+}
+
+func (client *AWSClient) GreengrassV2Conn() *greengrassv2.GreengrassV2 {
+	return client.serviceConn("GreengrassV2Conn", func() interface{} {
+		return greengrassv2.New(client.serviceSession(GreengrassV2))
+	}).(*greengrassv2.GreengrassV2)
+}
+
+func (client *AWSClient) GroundStationConn() *groundstation.GroundStation {
+	return client.serviceConn("GroundStationConn", func() interface{} {
+		return groundstation.New(client.serviceSession(GroundStation))
+	}).(*groundstation.GroundStation)
+}
+
+func (client *AWSClient) GuardDutyConn() *guardduty.GuardDuty {
+	return client.serviceConn("GuardDutyConn", func() interface{} {
+		return guardduty.New(client.serviceSession(GuardDuty))
+	}).(*guardduty.GuardDuty)
+}
+
+func (client *AWSClient) HealthConn() *health.Health {
+	return client.serviceConn("HealthConn", func() interface{} {
+		return health.New(client.serviceSession(Health))
+	}).(*health.Health)
+}
+
+func (client *AWSClient) HealthLakeConn() *healthlake.HealthLake {
+	return client.serviceConn("HealthLakeConn", func() interface{} {
+		return healthlake.New(client.serviceSession(HealthLake))
+	}).(*healthlake.HealthLake)
+}
+
+func (client *AWSClient) HoneycodeConn() *honeycode.Honeycode {
+	return client.serviceConn("HoneycodeConn", func() interface{} {
+		return honeycode.New(client.serviceSession(Honeycode))
+	}).(*honeycode.Honeycode)
+}
+
+func (client *AWSClient) IAMConn() *iam.IAM {
+	return client.serviceConn("IAMConn", func() interface{} {
+		return iam.New(client.serviceSession(IAM))
+	}).(*iam.IAM)
+}
+
+func (client *AWSClient) IdentityStoreConn() *identitystore.IdentityStore {
+	return client.serviceConn("IdentityStoreConn", func() interface{} {
+		return identitystore.New(client.serviceSession(IdentityStore))
+	}).(*identitystore.IdentityStore)
+}
+
+func (client *AWSClient) ImageBuilderConn() *imagebuilder.Imagebuilder {
+	return client.serviceConn("ImageBuilderConn", func() interface{} {
+		return imagebuilder.New(client.serviceSession(ImageBuilder))
+	}).(*imagebuilder.Imagebuilder)
+}
+
+func (client *AWSClient) InspectorConn() *inspector.Inspector {
+	return client.serviceConn("InspectorConn", func() interface{} {
+		return inspector.New(client.serviceSession(Inspector))
+	}).(*inspector.Inspector)
+}
+
+func (client *AWSClient) IoT1ClickDevicesConn() *iot1clickdevicesservice.IoT1ClickDevicesService {
+	return client.serviceConn("IoT1ClickDevicesConn", func() interface{} {
+		return iot1clickdevicesservice.New(client.serviceSession(IoT1ClickDevices))
+	}).(*iot1clickdevicesservice.IoT1ClickDevicesService)
+}
+
+func (client *AWSClient) IoT1ClickProjectsConn() *iot1clickprojects.IoT1ClickProjects {
+	return client.serviceConn("IoT1ClickProjectsConn", func() interface{} {
+		return iot1clickprojects.New(client.serviceSession(IoT1ClickProjects))
+	}).(*iot1clickprojects.IoT1ClickProjects)
+}
+
+func (client *AWSClient) IoTAnalyticsConn() *iotanalytics.IoTAnalytics {
+	return client.serviceConn("IoTAnalyticsConn", func() interface{} {
+		return iotanalytics.New(client.serviceSession(IoTAnalytics))
+	}).(*iotanalytics.IoTAnalytics)
+}
+
+func (client *AWSClient) IoTConn() *iot.IoT {
+	return client.serviceConn("IoTConn", func() interface{} {
+		return iot.New(client.serviceSession(IoT))
+	}).(*iot.IoT)
+}
+
+func (client *AWSClient) IoTDataPlaneConn() *iotdataplane.IoTDataPlane {
+	return client.serviceConn("IoTDataPlaneConn", func() interface{} {
+		return iotdataplane.New(client.serviceSession(IoTDataPlane))
+	}).(*iotdataplane.IoTDataPlane)
+}
+
+func (client *AWSClient) IoTDeviceAdvisorConn() *iotdeviceadvisor.IoTDeviceAdvisor {
+	return client.serviceConn("IoTDeviceAdvisorConn", func() interface{} {
+		return iotdeviceadvisor.New(client.serviceSession(IoTDeviceAdvisor))
+	}).(*iotdeviceadvisor.IoTDeviceAdvisor)
+}
+
+func (client *AWSClient) IoTEventsConn() *iotevents.IoTEvents {
+	return client.serviceConn("IoTEventsConn", func() interface{} {
+		return iotevents.New(client.serviceSession(IoTEvents))
+	}).(*iotevents.IoTEvents)
+}
+
+func (client *AWSClient) IoTEventsDataConn() *ioteventsdata.IoTEventsData {
+	return client.serviceConn("IoTEventsDataConn", func() interface{} {
+		return ioteventsdata.New(client.serviceSession(IoTEventsData))
+	}).(*ioteventsdata.IoTEventsData)
+}
+
+func (client *AWSClient) IoTFleetHubConn() *iotfleethub.IoTFleetHub {
+	return client.serviceConn("IoTFleetHubConn", func() interface{} {
+		return iotfleethub.New(client.serviceSession(IoTFleetHub))
+	}).(*iotfleethub.IoTFleetHub)
+}
+
+func (client *AWSClient) IoTJobsDataPlaneConn() *iotjobsdataplane.IoTJobsDataPlane {
+	return client.serviceConn("IoTJobsDataPlaneConn", func() interface{} {
+		return iotjobsdataplane.New(client.serviceSession(IoTJobsDataPlane))
+	}).(*iotjobsdataplane.IoTJobsDataPlane)
+}
+
+func (client *AWSClient) IoTSecureTunnelingConn() *iotsecuretunneling.IoTSecureTunneling {
+	return client.serviceConn("IoTSecureTunnelingConn", func() interface{} {
+		return iotsecuretunneling.New(client.serviceSession(IoTSecureTunneling))
+	}).(*iotsecuretunneling.IoTSecureTunneling)
+}
+
+func (client *AWSClient) IoTSiteWiseConn() *iotsitewise.IoTSiteWise {
+	return client.serviceConn("IoTSiteWiseConn", func() interface{} {
+		return iotsitewise.New(client.serviceSession(IoTSiteWise))
+	}).(*iotsitewise.IoTSiteWise)
+}
+
+func (client *AWSClient) IoTThingsGraphConn() *iotthingsgraph.IoTThingsGraph {
+	return client.serviceConn("IoTThingsGraphConn", func() interface{} {
+		return iotthingsgraph.New(client.serviceSession(IoTThingsGraph))
+	}).(*iotthingsgraph.IoTThingsGraph)
+}
+
+func (client *AWSClient) IoTWirelessConn() *iotwireless.IoTWireless {
+	return client.serviceConn("IoTWirelessConn", func() interface{} {
+		return iotwireless.New(client.serviceSession(IoTWireless))
+	}).(*iotwireless.IoTWireless)
+}
+
+func (client *AWSClient) KafkaConn() *kafka.Kafka {
+	return client.serviceConn("KafkaConn", func() interface{} {
+		conn := kafka.New(client.serviceSession(Kafka))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*kafka.Kafka)
+}
+
+func (client *AWSClient) KafkaConnectConn() *kafkaconnect.KafkaConnect {
+	return client.serviceConn("KafkaConnectConn", func() interface{} {
+		return kafkaconnect.New(client.serviceSession(KafkaConnect))
+	}).(*kafkaconnect.KafkaConnect)
+}
+
+func (client *AWSClient) KendraConn() *kendra.Kendra {
+	return client.serviceConn("KendraConn", func() interface{} {
+		return kendra.New(client.serviceSession(Kendra))
+	}).(*kendra.Kendra)
+}
+
+func (client *AWSClient) KinesisAnalyticsConn() *kinesisanalytics.KinesisAnalytics {
+	return client.serviceConn("KinesisAnalyticsConn", func() interface{} {
+		return kinesisanalytics.New(client.serviceSession(KinesisAnalytics))
+	}).(*kinesisanalytics.KinesisAnalytics)
+}
+
+func (client *AWSClient) KinesisAnalyticsV2Conn() *kinesisanalyticsv2.KinesisAnalyticsV2 {
+	return client.serviceConn("KinesisAnalyticsV2Conn", func() interface{} {
+		return kinesisanalyticsv2.New(client.serviceSession(KinesisAnalyticsV2))
+	}).(*kinesisanalyticsv2.KinesisAnalyticsV2)
+}
+
+func (client *AWSClient) KinesisConn() *kinesis.Kinesis {
+	return client.serviceConn("KinesisConn", func() interface{} {
+		conn := kinesis.New(client.serviceSession(Kinesis))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateStream" {
+				if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+			if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
+				if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*kinesis.Kinesis)
+}
+
+func (client *AWSClient) KinesisVideoArchivedMediaConn() *kinesisvideoarchivedmedia.KinesisVideoArchivedMedia {
+	return client.serviceConn("KinesisVideoArchivedMediaConn", func() interface{} {
+		return kinesisvideoarchivedmedia.New(client.serviceSession(KinesisVideoArchivedMedia))
+	}).(*kinesisvideoarchivedmedia.KinesisVideoArchivedMedia)
+}
+
+func (client *AWSClient) KinesisVideoConn() *kinesisvideo.KinesisVideo {
+	return client.serviceConn("KinesisVideoConn", func() interface{} {
+		return kinesisvideo.New(client.serviceSession(KinesisVideo))
+	}).(*kinesisvideo.KinesisVideo)
+}
+
+func (client *AWSClient) KinesisVideoMediaConn() *kinesisvideomedia.KinesisVideoMedia {
+	return client.serviceConn("KinesisVideoMediaConn", func() interface{} {
+		return kinesisvideomedia.New(client.serviceSession(KinesisVideoMedia))
+	}).(*kinesisvideomedia.KinesisVideoMedia)
+}
+
+func (client *AWSClient) KinesisVideoSignalingChannelsConn() *kinesisvideosignalingchannels.KinesisVideoSignalingChannels {
+	return client.serviceConn("KinesisVideoSignalingChannelsConn", func() interface{} {
+		return kinesisvideosignalingchannels.New(client.serviceSession(KinesisVideoSignalingChannels))
+	}).(*kinesisvideosignalingchannels.KinesisVideoSignalingChannels)
+}
+
+func (client *AWSClient) KMSConn() *kms.KMS {
+	return client.serviceConn("KMSConn", func() interface{} {
+		return kms.New(client.serviceSession(KMS))
+	}).(*kms.KMS)
+}
+
+func (client *AWSClient) LakeFormationConn() *lakeformation.LakeFormation {
+	return client.serviceConn("LakeFormationConn", func() interface{} {
+		return lakeformation.New(client.serviceSession(LakeFormation))
+	}).(*lakeformation.LakeFormation)
+}
+
+func (client *AWSClient) LambdaConn() *lambda.Lambda {
+	return client.serviceConn("LambdaConn", func() interface{} {
+		return lambda.New(client.serviceSession(Lambda))
+	}).(*lambda.Lambda)
+}
+
+func (client *AWSClient) LexModelsConn() *lexmodelbuildingservice.LexModelBuildingService {
+	return client.serviceConn("LexModelsConn", func() interface{} {
+		return lexmodelbuildingservice.New(client.serviceSession(LexModels))
+	}).(*lexmodelbuildingservice.LexModelBuildingService)
+}
+
+func (client *AWSClient) LexModelsV2Conn() *lexmodelsv2.LexModelsV2 {
+	return client.serviceConn("LexModelsV2Conn", func() interface{} {
+		return lexmodelsv2.New(client.serviceSession(LexModelsV2))
+	}).(*lexmodelsv2.LexModelsV2)
+}
+
+func (client *AWSClient) LexRuntimeConn() *lexruntimeservice.LexRuntimeService {
+	return client.serviceConn("LexRuntimeConn", func() interface{} {
+		return lexruntimeservice.New(client.serviceSession(LexRuntime))
+	}).(*lexruntimeservice.LexRuntimeService)
+}
+
+func (client *AWSClient) LexRuntimeV2Conn() *lexruntimev2.LexRuntimeV2 {
+	return client.serviceConn("LexRuntimeV2Conn", func() interface{} {
+		return lexruntimev2.New(client.serviceSession(LexRuntimeV2))
+	}).(*lexruntimev2.LexRuntimeV2)
+}
+
+func (client *AWSClient) LicenseManagerConn() *licensemanager.LicenseManager {
+	return client.serviceConn("LicenseManagerConn", func() interface{} {
+		return licensemanager.New(client.serviceSession(LicenseManager))
+	}).(*licensemanager.LicenseManager)
+}
+
+func (client *AWSClient) LightsailConn() *lightsail.Lightsail {
+	return client.serviceConn("LightsailConn", func() interface{} {
+		return lightsail.New(client.serviceSession(Lightsail))
+	}).(*lightsail.Lightsail)
+}
+
+func (client *AWSClient) LocationConn() *locationservice.LocationService {
+	return client.serviceConn("LocationConn", func() interface{} {
+		return locationservice.New(client.serviceSession(Location))
+	}).(*locationservice.LocationService)
+}
+
+func (client *AWSClient) LookoutEquipmentConn() *lookoutequipment.LookoutEquipment {
+	return client.serviceConn("LookoutEquipmentConn", func() interface{} {
+		return lookoutequipment.New(client.serviceSession(LookoutEquipment))
+	}).(*lookoutequipment.LookoutEquipment)
+}
+
+func (client *AWSClient) LookoutForVisionConn() *lookoutforvision.LookoutForVision {
+	return client.serviceConn("LookoutForVisionConn", func() interface{} {
+		return lookoutforvision.New(client.serviceSession(LookoutForVision))
+	}).(*lookoutforvision.LookoutForVision)
+}
+
+func (client *AWSClient) LookoutMetricsConn() *lookoutmetrics.LookoutMetrics {
+	return client.serviceConn("LookoutMetricsConn", func() interface{} {
+		return lookoutmetrics.New(client.serviceSession(LookoutMetrics))
+	}).(*lookoutmetrics.LookoutMetrics)
+}
+
+func (client *AWSClient) MachineLearningConn() *machinelearning.MachineLearning {
+	return client.serviceConn("MachineLearningConn", func() interface{} {
+		return machinelearning.New(client.serviceSession(MachineLearning))
+	}).(*machinelearning.MachineLearning)
+}
+
+func (client *AWSClient) Macie2Conn() *macie2.Macie2 {
+	return client.serviceConn("Macie2Conn", func() interface{} {
+		return macie2.New(client.serviceSession(Macie2))
+	}).(*macie2.Macie2)
+}
+
+func (client *AWSClient) MacieConn() *macie.Macie {
+	return client.serviceConn("MacieConn", func() interface{} {
+		return macie.New(client.serviceSession(Macie))
+	}).(*macie.Macie)
+}
+
+func (client *AWSClient) ManagedBlockchainConn() *managedblockchain.ManagedBlockchain {
+	return client.serviceConn("ManagedBlockchainConn", func() interface{} {
+		return managedblockchain.New(client.serviceSession(ManagedBlockchain))
+	}).(*managedblockchain.ManagedBlockchain)
+}
+
+func (client *AWSClient) MarketplaceCatalogConn() *marketplacecatalog.MarketplaceCatalog {
+	return client.serviceConn("MarketplaceCatalogConn", func() interface{} {
+		return marketplacecatalog.New(client.serviceSession(MarketplaceCatalog))
+	}).(*marketplacecatalog.MarketplaceCatalog)
+}
+
+func (client *AWSClient) MarketplaceCommerceAnalyticsConn() *marketplacecommerceanalytics.MarketplaceCommerceAnalytics {
+	return client.serviceConn("MarketplaceCommerceAnalyticsConn", func() interface{} {
+		return marketplacecommerceanalytics.New(client.serviceSession(MarketplaceCommerceAnalytics))
+	}).(*marketplacecommerceanalytics.MarketplaceCommerceAnalytics)
+}
+
+func (client *AWSClient) MarketplaceEntitlementConn() *marketplaceentitlementservice.MarketplaceEntitlementService {
+	return client.serviceConn("MarketplaceEntitlementConn", func() interface{} {
+		return marketplaceentitlementservice.New(client.serviceSession(MarketplaceEntitlement))
+	}).(*marketplaceentitlementservice.MarketplaceEntitlementService)
+}
+
+func (client *AWSClient) MarketplaceMeteringConn() *marketplacemetering.MarketplaceMetering {
+	return client.serviceConn("MarketplaceMeteringConn", func() interface{} {
+		return marketplacemetering.New(client.serviceSession(MarketplaceMetering))
+	}).(*marketplacemetering.MarketplaceMetering)
+}
+
+func (client *AWSClient) MediaConnectConn() *mediaconnect.MediaConnect {
+	return client.serviceConn("MediaConnectConn", func() interface{} {
+		return mediaconnect.New(client.serviceSession(MediaConnect))
+	}).(*mediaconnect.MediaConnect)
+}
+
+func (client *AWSClient) MediaConvertConn() *mediaconvert.MediaConvert {
+	return client.serviceConn("MediaConvertConn", func() interface{} {
+		return mediaconvert.New(client.serviceSession(MediaConvert))
+	}).(*mediaconvert.MediaConvert)
+}
+
+func (client *AWSClient) MediaLiveConn() *medialive.MediaLive {
+	return client.serviceConn("MediaLiveConn", func() interface{} {
+		return medialive.New(client.serviceSession(MediaLive))
+	}).(*medialive.MediaLive)
+}
+
+func (client *AWSClient) MediaPackageConn() *mediapackage.MediaPackage {
+	return client.serviceConn("MediaPackageConn", func() interface{} {
+		return mediapackage.New(client.serviceSession(MediaPackage))
+	}).(*mediapackage.MediaPackage)
+}
+
+func (client *AWSClient) MediaPackageVODConn() *mediapackagevod.MediaPackageVod {
+	return client.serviceConn("MediaPackageVODConn", func() interface{} {
+		return mediapackagevod.New(client.serviceSession(MediaPackageVOD))
+	}).(*mediapackagevod.MediaPackageVod)
+}
+
+func (client *AWSClient) MediaStoreConn() *mediastore.MediaStore {
+	return client.serviceConn("MediaStoreConn", func() interface{} {
+		return mediastore.New(client.serviceSession(MediaStore))
+	}).(*mediastore.MediaStore)
+}
+
+func (client *AWSClient) MediaStoreDataConn() *mediastoredata.MediaStoreData {
+	return client.serviceConn("MediaStoreDataConn", func() interface{} {
+		return mediastoredata.New(client.serviceSession(MediaStoreData))
+	}).(*mediastoredata.MediaStoreData)
+}
+
+func (client *AWSClient) MediaTailorConn() *mediatailor.MediaTailor {
+	return client.serviceConn("MediaTailorConn", func() interface{} {
+		return mediatailor.New(client.serviceSession(MediaTailor))
+	}).(*mediatailor.MediaTailor)
+}
+
+func (client *AWSClient) MemoryDBConn() *memorydb.MemoryDB {
+	return client.serviceConn("MemoryDBConn", func() interface{} {
+		return memorydb.New(client.serviceSession(MemoryDB))
+	}).(*memorydb.MemoryDB)
+}
+
+func (client *AWSClient) MgnConn() *mgn.Mgn {
+	return client.serviceConn("MgnConn", func() interface{} {
+		return mgn.New(client.serviceSession(Mgn))
+	}).(*mgn.Mgn)
+}
+
+func (client *AWSClient) MigrationHubConfigConn() *migrationhubconfig.MigrationHubConfig {
+	return client.serviceConn("MigrationHubConfigConn", func() interface{} {
+		return migrationhubconfig.New(client.serviceSession(MigrationHubConfig))
+	}).(*migrationhubconfig.MigrationHubConfig)
+}
+
+func (client *AWSClient) MigrationHubConn() *migrationhub.MigrationHub {
+	return client.serviceConn("MigrationHubConn", func() interface{} {
+		return migrationhub.New(client.serviceSession(MigrationHub))
+	}).(*migrationhub.MigrationHub)
+}
+
+func (client *AWSClient) MobileAnalyticsConn() *mobileanalytics.MobileAnalytics {
+	return client.serviceConn("MobileAnalyticsConn", func() interface{} {
+		return mobileanalytics.New(client.serviceSession(MobileAnalytics))
+	}).(*mobileanalytics.MobileAnalytics)
+}
+
+func (client *AWSClient) MobileConn() *mobile.Mobile {
+	return client.serviceConn("MobileConn", func() interface{} {
+		return mobile.New(client.serviceSession(Mobile))
+	}).(*mobile.Mobile)
+}
+
+func (client *AWSClient) MQConn() *mq.MQ {
+	return client.serviceConn("MQConn", func() interface{} {
+		return mq.New(client.serviceSession(MQ))
+	}).(*mq.MQ)
+}
+
+func (client *AWSClient) MTurkConn() *mturk.MTurk {
+	return client.serviceConn("MTurkConn", func() interface{} {
+		return mturk.New(client.serviceSession(MTurk))
+	}).(*mturk.MTurk)
+}
+
+func (client *AWSClient) MWAAConn() *mwaa.MWAA {
+	return client.serviceConn("MWAAConn", func() interface{} {
+		return mwaa.New(client.serviceSession(MWAA))
+	}).(*mwaa.MWAA)
+}
+
+func (client *AWSClient) NeptuneConn() *neptune.Neptune {
+	return client.serviceConn("NeptuneConn", func() interface{} {
+		return neptune.New(client.serviceSession(Neptune))
+	}).(*neptune.Neptune)
+}
+
+func (client *AWSClient) NetworkFirewallConn() *networkfirewall.NetworkFirewall {
+	return client.serviceConn("NetworkFirewallConn", func() interface{} {
+		return networkfirewall.New(client.serviceSession(NetworkFirewall))
+	}).(*networkfirewall.NetworkFirewall)
+}
+
+func (client *AWSClient) NetworkManagerConn() *networkmanager.NetworkManager {
+	return client.serviceConn("NetworkManagerConn", func() interface{} {
+		return networkmanager.New(client.serviceSession(NetworkManager))
+	}).(*networkmanager.NetworkManager)
+}
+
+func (client *AWSClient) NimbleStudioConn() *nimblestudio.NimbleStudio {
+	return client.serviceConn("NimbleStudioConn", func() interface{} {
+		return nimblestudio.New(client.serviceSession(NimbleStudio))
+	}).(*nimblestudio.NimbleStudio)
+}
+
+func (client *AWSClient) OpsWorksCMConn() *opsworkscm.OpsWorksCM {
+	return client.serviceConn("OpsWorksCMConn", func() interface{} {
+		return opsworkscm.New(client.serviceSession(OpsWorksCM))
+	}).(*opsworkscm.OpsWorksCM)
+}
+
+func (client *AWSClient) OpsWorksConn() *opsworks.OpsWorks {
+	return client.serviceConn("OpsWorksConn", func() interface{} {
+		return opsworks.New(client.serviceSession(OpsWorks))
+	}).(*opsworks.OpsWorks)
+}
+
+func (client *AWSClient) OrganizationsConn() *organizations.Organizations {
+	return client.serviceConn("OrganizationsConn", func() interface{} {
+		conn := organizations.New(client.serviceSession(Organizations))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Retry on the following error:
+			// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+			if tfawserr.ErrMessageContains(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*organizations.Organizations)
+}
+
+func (client *AWSClient) OutpostsConn() *outposts.Outposts {
+	return client.serviceConn("OutpostsConn", func() interface{} {
+		return outposts.New(client.serviceSession(Outposts))
+	}).(*outposts.Outposts)
+}
+
+func (client *AWSClient) PersonalizeConn() *personalize.Personalize {
+	return client.serviceConn("PersonalizeConn", func() interface{} {
+		return personalize.New(client.serviceSession(Personalize))
+	}).(*personalize.Personalize)
+}
+
+func (client *AWSClient) PersonalizeEventsConn() *personalizeevents.PersonalizeEvents {
+	return client.serviceConn("PersonalizeEventsConn", func() interface{} {
+		return personalizeevents.New(client.serviceSession(PersonalizeEvents))
+	}).(*personalizeevents.PersonalizeEvents)
+}
+
+func (client *AWSClient) PersonalizeRuntimeConn() *personalizeruntime.PersonalizeRuntime {
+	return client.serviceConn("PersonalizeRuntimeConn", func() interface{} {
+		return personalizeruntime.New(client.serviceSession(PersonalizeRuntime))
+	}).(*personalizeruntime.PersonalizeRuntime)
+}
+
+func (client *AWSClient) PIConn() *pi.PI {
+	return client.serviceConn("PIConn", func() interface{} {
+		return pi.New(client.serviceSession(PI))
+	}).(*pi.PI)
+}
+
+func (client *AWSClient) PinpointConn() *pinpoint.Pinpoint {
+	return client.serviceConn("PinpointConn", func() interface{} {
+		return pinpoint.New(client.serviceSession(Pinpoint))
+	}).(*pinpoint.Pinpoint)
+}
+
+func (client *AWSClient) PinpointEmailConn() *pinpointemail.PinpointEmail {
+	return client.serviceConn("PinpointEmailConn", func() interface{} {
+		return pinpointemail.New(client.serviceSession(PinpointEmail))
+	}).(*pinpointemail.PinpointEmail)
+}
+
+func (client *AWSClient) PinpointSMSVoiceConn() *pinpointsmsvoice.PinpointSMSVoice {
+	return client.serviceConn("PinpointSMSVoiceConn", func() interface{} {
+		return pinpointsmsvoice.New(client.serviceSession(PinpointSMSVoice))
+	}).(*pinpointsmsvoice.PinpointSMSVoice)
+}
+
+func (client *AWSClient) PollyConn() *polly.Polly {
+	return client.serviceConn("PollyConn", func() interface{} {
+		return polly.New(client.serviceSession(Polly))
+	}).(*polly.Polly)
+}
+
+func (client *AWSClient) PricingConn() *pricing.Pricing {
+	return client.serviceConn("PricingConn", func() interface{} {
+		return pricing.New(client.serviceSession(Pricing))
+	}).(*pricing.Pricing)
+}
+
+func (client *AWSClient) ProtonConn() *proton.Proton {
+	return client.serviceConn("ProtonConn", func() interface{} {
+		return proton.New(client.serviceSession(Proton))
+	}).(*proton.Proton)
+}
+
+func (client *AWSClient) QLDBConn() *qldb.QLDB {
+	return client.serviceConn("QLDBConn", func() interface{} {
+		return qldb.New(client.serviceSession(QLDB))
+	}).(*qldb.QLDB)
+}
+
+func (client *AWSClient) QLDBSessionConn() *qldbsession.QLDBSession {
+	return client.serviceConn("QLDBSessionConn", func() interface{} {
+		return qldbsession.New(client.serviceSession(QLDBSession))
+	}).(*qldbsession.QLDBSession)
+}
+
+func (client *AWSClient) QuickSightConn() *quicksight.QuickSight {
+	return client.serviceConn("QuickSightConn", func() interface{} {
+		return quicksight.New(client.serviceSession(QuickSight))
+	}).(*quicksight.QuickSight)
+}
+
+func (client *AWSClient) RAMConn() *ram.RAM {
+	return client.serviceConn("RAMConn", func() interface{} {
+		return ram.New(client.serviceSession(RAM))
+	}).(*ram.RAM)
+}
+
+func (client *AWSClient) RDSConn() *rds.RDS {
+	return client.serviceConn("RDSConn", func() interface{} {
+		return rds.New(client.serviceSession(RDS))
+	}).(*rds.RDS)
+}
+
+func (client *AWSClient) RDSDataConn() *rdsdataservice.RDSDataService {
+	return client.serviceConn("RDSDataConn", func() interface{} {
+		return rdsdataservice.New(client.serviceSession(RDSData))
+	}).(*rdsdataservice.RDSDataService)
+}
+
+func (client *AWSClient) RedshiftConn() *redshift.Redshift {
+	return client.serviceConn("RedshiftConn", func() interface{} {
+		return redshift.New(client.serviceSession(Redshift))
+	}).(*redshift.Redshift)
+}
+
+func (client *AWSClient) RedshiftDataConn() *redshiftdataapiservice.RedshiftDataAPIService {
+	return client.serviceConn("RedshiftDataConn", func() interface{} {
+		return redshiftdataapiservice.New(client.serviceSession(RedshiftData))
+	}).(*redshiftdataapiservice.RedshiftDataAPIService)
+}
+
+func (client *AWSClient) RekognitionConn() *rekognition.Rekognition {
+	return client.serviceConn("RekognitionConn", func() interface{} {
+		return rekognition.New(client.serviceSession(Rekognition))
+	}).(*rekognition.Rekognition)
+}
+
+func (client *AWSClient) ResourceGroupsConn() *resourcegroups.ResourceGroups {
+	return client.serviceConn("ResourceGroupsConn", func() interface{} {
+		return resourcegroups.New(client.serviceSession(ResourceGroups))
+	}).(*resourcegroups.ResourceGroups)
+}
+
+func (client *AWSClient) ResourceGroupsTaggingAPIConn() *resourcegroupstaggingapi.ResourceGroupsTaggingAPI {
+	return client.serviceConn("ResourceGroupsTaggingAPIConn", func() interface{} {
+		return resourcegroupstaggingapi.New(client.serviceSession(ResourceGroupsTaggingAPI))
+	}).(*resourcegroupstaggingapi.ResourceGroupsTaggingAPI)
+}
+
+func (client *AWSClient) RoboMakerConn() *robomaker.RoboMaker {
+	return client.serviceConn("RoboMakerConn", func() interface{} {
+		return robomaker.New(client.serviceSession(RoboMaker))
+	}).(*robomaker.RoboMaker)
+}
+
+func (client *AWSClient) Route53Conn() *route53.Route53 {
+	return client.serviceConn("Route53Conn", func() interface{} {
+		return route53.New(client.serviceSession(Route53))
+	}).(*route53.Route53)
+}
+
+func (client *AWSClient) Route53DomainsConn() *route53domains.Route53Domains {
+	return client.serviceConn("Route53DomainsConn", func() interface{} {
+		return route53domains.New(client.serviceSession(Route53Domains))
+	}).(*route53domains.Route53Domains)
+}
+
+func (client *AWSClient) Route53RecoveryControlConfigConn() *route53recoverycontrolconfig.Route53RecoveryControlConfig {
+	return client.serviceConn("Route53RecoveryControlConfigConn", func() interface{} {
+		return route53recoverycontrolconfig.New(client.serviceSession(Route53RecoveryControlConfig))
+	}).(*route53recoverycontrolconfig.Route53RecoveryControlConfig)
+}
+
+func (client *AWSClient) Route53RecoveryReadinessConn() *route53recoveryreadiness.Route53RecoveryReadiness {
+	return client.serviceConn("Route53RecoveryReadinessConn", func() interface{} {
+		return route53recoveryreadiness.New(client.serviceSession(Route53RecoveryReadiness))
+	}).(*route53recoveryreadiness.Route53RecoveryReadiness)
+}
+
+func (client *AWSClient) Route53ResolverConn() *route53resolver.Route53Resolver {
+	return client.serviceConn("Route53ResolverConn", func() interface{} {
+		return route53resolver.New(client.serviceSession(Route53Resolver))
+	}).(*route53resolver.Route53Resolver)
+}
+
+func (client *AWSClient) S3Conn() *s3.S3 {
+	return client.serviceConn("S3Conn", func() interface{} {
+		conn := s3.New(client.serviceSession(S3))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, "OperationAborted", "A conflicting conditional operation is currently in progress against this resource. Please try again.") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) S3ConnURICleaningDisabled() *s3.S3 {
+	return client.serviceConn("S3ConnURICleaningDisabled", func() interface{} {
+		config := client.config.serviceConfig(S3, client.Partition)
+		config.DisableRestProtocolURICleaning = aws.Bool(true)
+
+		return s3.New(client.session.Copy(config))
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) S3ControlConn() *s3control.S3Control {
+	return client.serviceConn("S3ControlConn", func() interface{} {
+		return s3control.New(client.serviceSession(S3Control))
+	}).(*s3control.S3Control)
+}
+
+func (client *AWSClient) S3OutpostsConn() *s3outposts.S3Outposts {
+	return client.serviceConn("S3OutpostsConn", func() interface{} {
+		return s3outposts.New(client.serviceSession(S3Outposts))
+	}).(*s3outposts.S3Outposts)
+}
+
+func (client *AWSClient) SageMakerConn() *sagemaker.SageMaker {
+	return client.serviceConn("SageMakerConn", func() interface{} {
+		return sagemaker.New(client.serviceSession(SageMaker))
+	}).(*sagemaker.SageMaker)
+}
+
+func (client *AWSClient) SageMakerEdgeManagerConn() *sagemakeredgemanager.SagemakerEdgeManager {
+	return client.serviceConn("SageMakerEdgeManagerConn", func() interface{} {
+		return sagemakeredgemanager.New(client.serviceSession(SageMakerEdgeManager))
+	}).(*sagemakeredgemanager.SagemakerEdgeManager)
+}
+
+func (client *AWSClient) SageMakerFeatureStoreRuntimeConn() *sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime {
+	return client.serviceConn("SageMakerFeatureStoreRuntimeConn", func() interface{} {
+		return sagemakerfeaturestoreruntime.New(client.serviceSession(SageMakerFeatureStoreRuntime))
+	}).(*sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime)
+}
+
+func (client *AWSClient) SageMakerRuntimeConn() *sagemakerruntime.SageMakerRuntime {
+	return client.serviceConn("SageMakerRuntimeConn", func() interface{} {
+		return sagemakerruntime.New(client.serviceSession(SageMakerRuntime))
+	}).(*sagemakerruntime.SageMakerRuntime)
+}
+
+func (client *AWSClient) SavingsPlansConn() *savingsplans.SavingsPlans {
+	return client.serviceConn("SavingsPlansConn", func() interface{} {
+		return savingsplans.New(client.serviceSession(SavingsPlans))
+	}).(*savingsplans.SavingsPlans)
+}
+
+func (client *AWSClient) SchemasConn() *schemas.Schemas {
+	return client.serviceConn("SchemasConn", func() interface{} {
+		return schemas.New(client.serviceSession(Schemas))
+	}).(*schemas.Schemas)
+}
+
+func (client *AWSClient) SecretsManagerConn() *secretsmanager.SecretsManager {
+	return client.serviceConn("SecretsManagerConn", func() interface{} {
+		return secretsmanager.New(client.serviceSession(SecretsManager))
+	}).(*secretsmanager.SecretsManager)
+}
+
+func (client *AWSClient) SecurityHubConn() *securityhub.SecurityHub {
+	return client.serviceConn("SecurityHubConn", func() interface{} {
+		conn := securityhub.New(client.serviceSession(SecurityHub))
+
+		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			switch r.Operation.Name {
+			case "EnableOrganizationAdminAccount":
+				if tfawserr.ErrCodeEquals(r.Error, securityhub.ErrCodeResourceConflictException) {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*securityhub.SecurityHub)
+}
+
+func (client *AWSClient) ServerlessRepoConn() *serverlessapplicationrepository.ServerlessApplicationRepository {
+	return client.serviceConn("ServerlessRepoConn", func() interface{} {
+		return serverlessapplicationrepository.New(client.serviceSession(ServerlessRepo))
+	}).(*serverlessapplicationrepository.ServerlessApplicationRepository)
+}
+
+func (client *AWSClient) ServiceCatalogConn() *servicecatalog.ServiceCatalog {
+	return client.serviceConn("ServiceCatalogConn", func() interface{} {
+		return servicecatalog.New(client.serviceSession(ServiceCatalog))
+	}).(*servicecatalog.ServiceCatalog)
+}
+
+func (client *AWSClient) ServiceDiscoveryConn() *servicediscovery.ServiceDiscovery {
+	return client.serviceConn("ServiceDiscoveryConn", func() interface{} {
+		return servicediscovery.New(client.serviceSession(ServiceDiscovery))
+	}).(*servicediscovery.ServiceDiscovery)
+}
+
+func (client *AWSClient) ServiceQuotasConn() *servicequotas.ServiceQuotas {
+	return client.serviceConn("ServiceQuotasConn", func() interface{} {
+		return servicequotas.New(client.serviceSession(ServiceQuotas))
+	}).(*servicequotas.ServiceQuotas)
+}
+
+func (client *AWSClient) SESConn() *ses.SES {
+	return client.serviceConn("SESConn", func() interface{} {
+		return ses.New(client.serviceSession(SES))
+	}).(*ses.SES)
+}
+
+func (client *AWSClient) SESV2Conn() *sesv2.SESV2 {
+	return client.serviceConn("SESV2Conn", func() interface{} {
+		return sesv2.New(client.serviceSession(SESV2))
+	}).(*sesv2.SESV2)
+}
+
+func (client *AWSClient) SFNConn() *sfn.SFN {
+	return client.serviceConn("SFNConn", func() interface{} {
+		return sfn.New(client.serviceSession(SFN))
+	}).(*sfn.SFN)
+}
+
+func (client *AWSClient) ShieldConn() *shield.Shield {
+	return client.serviceConn("ShieldConn", func() interface{} {
+		return shield.New(client.serviceSession(Shield))
+	}).(*shield.Shield)
+}
+
+func (client *AWSClient) SignerConn() *signer.Signer {
+	return client.serviceConn("SignerConn", func() interface{} {
+		return signer.New(client.serviceSession(Signer))
+	}).(*signer.Signer)
+}
+
+func (client *AWSClient) SimpleDBConn() *simpledb.SimpleDB {
+	return client.serviceConn("SimpleDBConn", func() interface{} {
+		return simpledb.New(client.serviceSession(SimpleDB))
+	}).(*simpledb.SimpleDB)
+}
+
+func (client *AWSClient) SMSConn() *sms.SMS {
+	return client.serviceConn("SMSConn", func() interface{} {
+		return sms.New(client.serviceSession(SMS))
+	}).(*sms.SMS)
+}
+
+func (client *AWSClient) SnowballConn() *snowball.Snowball {
+	return client.serviceConn("SnowballConn", func() interface{} {
+		return snowball.New(client.serviceSession(Snowball))
+	}).(*snowball.Snowball)
+}
+
+func (client *AWSClient) SNSConn() *sns.SNS {
+	return client.serviceConn("SNSConn", func() interface{} {
+		return sns.New(client.serviceSession(SNS))
+	}).(*sns.SNS)
+}
+
+func (client *AWSClient) SQSConn() *sqs.SQS {
+	return client.serviceConn("SQSConn", func() interface{} {
+		return sqs.New(client.serviceSession(SQS))
+	}).(*sqs.SQS)
+}
+
+func (client *AWSClient) SSMConn() *ssm.SSM {
+	return client.serviceConn("SSMConn", func() interface{} {
+		return ssm.New(client.serviceSession(SSM))
+	}).(*ssm.SSM)
+}
+
+func (client *AWSClient) SSMContactsConn() *ssmcontacts.SSMContacts {
+	return client.serviceConn("SSMContactsConn", func() interface{} {
+		return ssmcontacts.New(client.serviceSession(SSMContacts))
+	}).(*ssmcontacts.SSMContacts)
+}
+
+func (client *AWSClient) SSMIncidentsConn() *ssmincidents.SSMIncidents {
+	return client.serviceConn("SSMIncidentsConn", func() interface{} {
+		return ssmincidents.New(client.serviceSession(SSMIncidents))
+	}).(*ssmincidents.SSMIncidents)
+}
+
+func (client *AWSClient) SSOAdminConn() *ssoadmin.SSOAdmin {
+	return client.serviceConn("SSOAdminConn", func() interface{} {
+		conn := ssoadmin.New(client.serviceSession(SSOAdmin))
+
+		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "AttachManagedPolicyToPermissionSet" || r.Operation.Name == "DetachManagedPolicyFromPermissionSet" {
+				if tfawserr.ErrCodeEquals(r.Error, ssoadmin.ErrCodeConflictException) {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*ssoadmin.SSOAdmin)
+}
+
+func (client *AWSClient) SSOConn() *sso.SSO {
+	return client.serviceConn("SSOConn", func() interface{} {
+		return sso.New(client.serviceSession(SSO))
+	}).(*sso.SSO)
+}
+
+func (client *AWSClient) SSOOIDCConn() *ssooidc.SSOOIDC {
+	return client.serviceConn("SSOOIDCConn", func() interface{} {
+		return ssooidc.New(client.serviceSession(SSOOIDC))
+	}).(*ssooidc.SSOOIDC)
+}
+
+func (client *AWSClient) StorageGatewayConn() *storagegateway.StorageGateway {
+	return client.serviceConn("StorageGatewayConn", func() interface{} {
+		conn := storagegateway.New(client.serviceSession(StorageGateway))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+			if tfawserr.ErrMessageContains(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*storagegateway.StorageGateway)
+}
+
+func (client *AWSClient) STSConn() *sts.STS {
+	return client.serviceConn("STSConn", func() interface{} {
+		return sts.New(client.serviceSession(STS))
+	}).(*sts.STS)
+}
+
+func (client *AWSClient) SupportConn() *support.Support {
+	return client.serviceConn("SupportConn", func() interface{} {
+		return support.New(client.serviceSession(Support))
+	}).(*support.Support)
+}
+
+func (client *AWSClient) SWFConn() *swf.SWF {
+	return client.serviceConn("SWFConn", func() interface{} {
+		return swf.New(client.serviceSession(SWF))
+	}).(*swf.SWF)
+}
+
+func (client *AWSClient) SyntheticsConn() *synthetics.Synthetics {
+	return client.serviceConn("SyntheticsConn", func() interface{} {
+		return synthetics.New(client.serviceSession(Synthetics))
+	}).(*synthetics.Synthetics)
+}
+
+func (client *AWSClient) TextractConn() *textract.Textract {
+	return client.serviceConn("TextractConn", func() interface{} {
+		return textract.New(client.serviceSession(Textract))
+	}).(*textract.Textract)
+}
+
+func (client *AWSClient) TimestreamQueryConn() *timestreamquery.TimestreamQuery {
+	return client.serviceConn("TimestreamQueryConn", func() interface{} {
+		return timestreamquery.New(client.serviceSession(TimestreamQuery))
+	}).(*timestreamquery.TimestreamQuery)
+}
+
+func (client *AWSClient) TimestreamWriteConn() *timestreamwrite.TimestreamWrite {
+	return client.serviceConn("TimestreamWriteConn", func() interface{} {
+		return timestreamwrite.New(client.serviceSession(TimestreamWrite))
+	}).(*timestreamwrite.TimestreamWrite)
+}
+
+func (client *AWSClient) TranscribeConn() *transcribeservice.TranscribeService {
+	return client.serviceConn("TranscribeConn", func() interface{} {
+		return transcribeservice.New(client.serviceSession(Transcribe))
+	}).(*transcribeservice.TranscribeService)
+}
+
+func (client *AWSClient) TranscribeStreamingConn() *transcribestreamingservice.TranscribeStreamingService {
+	return client.serviceConn("TranscribeStreamingConn", func() interface{} {
+		return transcribestreamingservice.New(client.serviceSession(TranscribeStreaming))
+	}).(*transcribestreamingservice.TranscribeStreamingService)
+}
+
+func (client *AWSClient) TransferConn() *transfer.Transfer {
+	return client.serviceConn("TransferConn", func() interface{} {
+		return transfer.New(client.serviceSession(Transfer))
+	}).(*transfer.Transfer)
+}
+
+func (client *AWSClient) TranslateConn() *translate.Translate {
+	return client.serviceConn("TranslateConn", func() interface{} {
+		return translate.New(client.serviceSession(Translate))
+	}).(*translate.Translate)
+}
+
+func (client *AWSClient) WAFConn() *waf.WAF {
+	return client.serviceConn("WAFConn", func() interface{} {
+		return waf.New(client.serviceSession(WAF))
+	}).(*waf.WAF)
+}
+
+func (client *AWSClient) WAFRegionalConn() *wafregional.WAFRegional {
+	return client.serviceConn("WAFRegionalConn", func() interface{} {
+		return wafregional.New(client.serviceSession(WAFRegional))
+	}).(*wafregional.WAFRegional)
+}
+
+func (client *AWSClient) WAFV2Conn() *wafv2.WAFV2 {
+	return client.serviceConn("WAFV2Conn", func() interface{} {
+		conn := wafv2.New(client.serviceSession(WAFV2))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
+				r.Retryable = aws.Bool(true)
+			}
+
+			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
+				r.Retryable = aws.Bool(true)
+			}
+
+			if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
+				r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
+				// WAFv2 supports tag on create which can result in the below error codes according to the documentation
+				if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
+					r.Retryable = aws.Bool(true)
+				}
+				if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*wafv2.WAFV2)
+}
+
+func (client *AWSClient) WellArchitectedConn() *wellarchitected.WellArchitected {
+	return client.serviceConn("WellArchitectedConn", func() interface{} {
+		return wellarchitected.New(client.serviceSession(WellArchitected))
+	}).(*wellarchitected.WellArchitected)
+}
+
+func (client *AWSClient) WorkDocsConn() *workdocs.WorkDocs {
+	return client.serviceConn("WorkDocsConn", func() interface{} {
+		return workdocs.New(client.serviceSession(WorkDocs))
+	}).(*workdocs.WorkDocs)
+}
+
+func (client *AWSClient) WorkLinkConn() *worklink.WorkLink {
+	return client.serviceConn("WorkLinkConn", func() interface{} {
+		return worklink.New(client.serviceSession(WorkLink))
+	}).(*worklink.WorkLink)
+}
+
+func (client *AWSClient) WorkMailConn() *workmail.WorkMail {
+	return client.serviceConn("WorkMailConn", func() interface{} {
+		return workmail.New(client.serviceSession(WorkMail))
+	}).(*workmail.WorkMail)
+}
+
+func (client *AWSClient) WorkMailMessageFlowConn() *workmailmessageflow.WorkMailMessageFlow {
+	return client.serviceConn("WorkMailMessageFlowConn", func() interface{} {
+		return workmailmessageflow.New(client.serviceSession(WorkMailMessageFlow))
+	}).(*workmailmessageflow.WorkMailMessageFlow)
+}
+
+func (client *AWSClient) WorkSpacesConn() *workspaces.WorkSpaces {
+	return client.serviceConn("WorkSpacesConn", func() interface{} {
+		return workspaces.New(client.serviceSession(WorkSpaces))
+	}).(*workspaces.WorkSpaces)
+}
+
+func (client *AWSClient) XRayConn() *xray.XRay {
+	return client.serviceConn("XRayConn", func() interface{} {
+		return xray.New(client.serviceSession(XRay))
+	}).(*xray.XRay)
+}
@@ -0,0 +1,73 @@
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestAWSClientServiceConn(t *testing.T) {
+	config := &Config{
+		Endpoints: map[string]string{
+			EC2: "http://ec2.example.com",
+		},
+		Region:              "us-west-2", //lintignore:AWSAT003
+		S3ForcePathStyle:    true,
+		SkipGetEC2Platforms: true,
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String(config.Region),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	client := config.newClient(sess, "123456789012", endpoints.AwsPartitionID)
+
+	if got := len(client.serviceConns.conns); got != 0 {
+		t.Fatalf("got %d service clients before use, expected none", got)
+	}
+
+	conn := client.EC2Conn()
+
+	if got, expected := aws.StringValue(conn.Config.Endpoint), config.Endpoints[EC2]; got != expected {
+		t.Errorf("got EC2 endpoint %s, expected %s", got, expected)
+	}
+
+	if client.EC2Conn() != conn {
+		t.Error("expected cached EC2 client")
+	}
+
+	// Copies of the client, e.g. with scoped default tags, share the service clients.
+	clientCopy := *client
+
+	if clientCopy.EC2Conn() != conn {
+		t.Error("expected client copy to share EC2 client")
+	}
+
+	if got, expected := aws.StringValue(client.Route53Conn().Config.Region), endpoints.UsEast1RegionID; got != expected {
+		t.Errorf("got Route 53 region %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValue(client.SQSConn().Config.Region), config.Region; got != expected {
+		t.Errorf("got SQS region %s, expected %s", got, expected)
+	}
+
+	if !aws.BoolValue(client.S3Conn().Config.S3ForcePathStyle) {
+		t.Error("expected S3 client to force path style")
+	}
+
+	if s3Conn := client.S3ConnURICleaningDisabled(); !aws.BoolValue(s3Conn.Config.DisableRestProtocolURICleaning) || !aws.BoolValue(s3Conn.Config.S3ForcePathStyle) {
+		t.Error("expected S3 client with URI cleaning disabled to force path style")
+	}
+
+	if got, expected := len(client.serviceConns.conns), 5; got != expected {
+		t.Errorf("got %d service clients, expected %d", got, expected)
+	}
+}
@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
-	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/account"
@@ -283,7 +282,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/xray"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -854,7 +852,12 @@ func init() {
 
 type Config struct {
 	AccessKey                      string
+	APIMetricsFile                 string
 	AllowedAccountIds              []string
+	AllowedOrganizationIds         []string
+	AllowedOrganizationalUnits     []string
+	AllowedPartitions              []string
+	AllowedRegions                 []string
 	AssumeRole                     *awsbase.AssumeRole
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEndpoint     string
@@ -866,7 +869,12 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	Profile                        string
+	RateLimits                     map[string]*RateLimit
+	ReadOnly                       bool
 	Region                         string
+	RequestTraceFile               string
+	RequestTraceRedactedFields     []string
+	RetryPolicy                    *RetryPolicy
 	S3ForcePathStyle               bool
 	SecretKey                      string
 	SharedConfigFile               string
@@ -877,6 +885,7 @@ type Config struct {
 	SkipRegionValidation           bool
 	SkipRequestingAccountId        bool
 	STSRegion                      string
+	TagPolicy                      *tftags.Policy
 	TerraformVersion               string
 	Token                          string
 	UseDualStackEndpoint           bool
@@ -884,288 +893,22 @@ type Config struct {
 }
 
 type AWSClient struct {
-	AccessAnalyzerConn                *accessanalyzer.AccessAnalyzer
-	AccountConn                       *account.Account
-	AccountID                         string
-	ACMConn                           *acm.ACM
-	ACMPCAConn                        *acmpca.ACMPCA
-	AlexaForBusinessConn              *alexaforbusiness.AlexaForBusiness
-	AMPConn                           *prometheusservice.PrometheusService
-	AmplifyBackendConn                *amplifybackend.AmplifyBackend
-	AmplifyConn                       *amplify.Amplify
-	APIGatewayConn                    *apigateway.APIGateway
-	APIGatewayV2Conn                  *apigatewayv2.ApiGatewayV2
-	AppAutoScalingConn                *applicationautoscaling.ApplicationAutoScaling
-	AppConfigConn                     *appconfig.AppConfig
-	AppFlowConn                       *appflow.Appflow
-	AppIntegrationsConn               *appintegrationsservice.AppIntegrationsService
-	ApplicationCostProfilerConn       *applicationcostprofiler.ApplicationCostProfiler
-	ApplicationDiscoveryConn          *applicationdiscoveryservice.ApplicationDiscoveryService
-	ApplicationInsightsConn           *applicationinsights.ApplicationInsights
-	AppMeshConn                       *appmesh.AppMesh
-	AppRegistryConn                   *appregistry.AppRegistry
-	AppRunnerConn                     *apprunner.AppRunner
-	AppStreamConn                     *appstream.AppStream
-	AppSyncConn                       *appsync.AppSync
-	AthenaConn                        *athena.Athena
-	AuditManagerConn                  *auditmanager.AuditManager
-	AugmentedAIRuntimeConn            *augmentedairuntime.AugmentedAIRuntime
-	AutoScalingConn                   *autoscaling.AutoScaling
-	AutoScalingPlansConn              *autoscalingplans.AutoScalingPlans
-	BackupConn                        *backup.Backup
-	BatchConn                         *batch.Batch
-	BraketConn                        *braket.Braket
-	BudgetsConn                       *budgets.Budgets
-	ChimeConn                         *chime.Chime
-	Cloud9Conn                        *cloud9.Cloud9
-	CloudControlConn                  *cloudcontrolapi.CloudControlApi
-	CloudDirectoryConn                *clouddirectory.CloudDirectory
-	CloudFormationConn                *cloudformation.CloudFormation
-	CloudFrontConn                    *cloudfront.CloudFront
-	CloudHSMV2Conn                    *cloudhsmv2.CloudHSMV2
-	CloudSearchConn                   *cloudsearch.CloudSearch
-	CloudSearchDomainConn             *cloudsearchdomain.CloudSearchDomain
-	CloudTrailConn                    *cloudtrail.CloudTrail
-	CloudWatchConn                    *cloudwatch.CloudWatch
-	CloudWatchLogsConn                *cloudwatchlogs.CloudWatchLogs
-	CodeArtifactConn                  *codeartifact.CodeArtifact
-	CodeBuildConn                     *codebuild.CodeBuild
-	CodeCommitConn                    *codecommit.CodeCommit
-	CodeDeployConn                    *codedeploy.CodeDeploy
-	CodeGuruProfilerConn              *codeguruprofiler.CodeGuruProfiler
-	CodeGuruReviewerConn              *codegurureviewer.CodeGuruReviewer
-	CodePipelineConn                  *codepipeline.CodePipeline
-	CodeStarConn                      *codestar.CodeStar
-	CodeStarConnectionsConn           *codestarconnections.CodeStarConnections
-	CodeStarNotificationsConn         *codestarnotifications.CodeStarNotifications
-	CognitoIdentityConn               *cognitoidentity.CognitoIdentity
-	CognitoIDPConn                    *cognitoidentityprovider.CognitoIdentityProvider
-	CognitoSyncConn                   *cognitosync.CognitoSync
-	ComprehendConn                    *comprehend.Comprehend
-	ComprehendMedicalConn             *comprehendmedical.ComprehendMedical
-	ConfigServiceConn                 *configservice.ConfigService
-	ConnectConn                       *connect.Connect
-	ConnectContactLensConn            *connectcontactlens.ConnectContactLens
-	ConnectParticipantConn            *connectparticipant.ConnectParticipant
-	CostExplorerConn                  *costexplorer.CostExplorer
-	CURConn                           *costandusagereportservice.CostandUsageReportService
-	DataExchangeConn                  *dataexchange.DataExchange
-	DataPipelineConn                  *datapipeline.DataPipeline
-	DataSyncConn                      *datasync.DataSync
-	DAXConn                           *dax.DAX
-	DefaultTagsConfig                 *tftags.DefaultConfig
-	DetectiveConn                     *detective.Detective
-	DeviceFarmConn                    *devicefarm.DeviceFarm
-	DevOpsGuruConn                    *devopsguru.DevOpsGuru
-	DirectConnectConn                 *directconnect.DirectConnect
-	DLMConn                           *dlm.DLM
-	DMSConn                           *databasemigrationservice.DatabaseMigrationService
-	DNSSuffix                         string
-	DocDBConn                         *docdb.DocDB
-	DSConn                            *directoryservice.DirectoryService
-	DynamoDBConn                      *dynamodb.DynamoDB
-	DynamoDBStreamsConn               *dynamodbstreams.DynamoDBStreams
-	EC2Conn                           *ec2.EC2
-	EC2InstanceConnectConn            *ec2instanceconnect.EC2InstanceConnect
-	ECRConn                           *ecr.ECR
-	ECRPublicConn                     *ecrpublic.ECRPublic
-	ECSConn                           *ecs.ECS
-	EFSConn                           *efs.EFS
-	EKSConn                           *eks.EKS
-	ElastiCacheConn                   *elasticache.ElastiCache
-	ElasticBeanstalkConn              *elasticbeanstalk.ElasticBeanstalk
-	ElasticInferenceConn              *elasticinference.ElasticInference
-	ElasticsearchConn                 *elasticsearch.ElasticsearchService
-	ElasticTranscoderConn             *elastictranscoder.ElasticTranscoder
-	ELBConn                           *elb.ELB
-	ELBV2Conn                         *elbv2.ELBV2
-	EMRConn                           *emr.EMR
-	EMRContainersConn                 *emrcontainers.EMRContainers
-	EventsConn                        *eventbridge.EventBridge
-	FinSpaceConn                      *finspace.Finspace
-	FinSpaceDataConn                  *finspacedata.FinSpaceData
-	FirehoseConn                      *firehose.Firehose
-	FISConn                           *fis.FIS
-	FMSConn                           *fms.FMS
-	ForecastConn                      *forecastservice.ForecastService
-	ForecastQueryConn                 *forecastqueryservice.ForecastQueryService
-	FraudDetectorConn                 *frauddetector.FraudDetector
-	FSxConn                           *fsx.FSx
-	GameLiftConn                      *gamelift.GameLift
-	GlacierConn                       *glacier.Glacier
-	GlobalAcceleratorConn             *globalaccelerator.GlobalAccelerator
-	GlueConn                          *glue.Glue
-	GlueDataBrewConn                  *gluedatabrew.GlueDataBrew
-	GrafanaConn                       *managedgrafana.ManagedGrafana
-	GreengrassConn                    *greengrass.Greengrass
-	GreengrassV2Conn                  *greengrassv2.GreengrassV2
-	GroundStationConn                 *groundstation.GroundStation
-	GuardDutyConn                     *guardduty.GuardDuty
-	HealthConn                        *health.Health
-	HealthLakeConn                    *healthlake.HealthLake
-	HoneycodeConn                     *honeycode.Honeycode
-	IAMConn                           *iam.IAM
-	IdentityStoreConn                 *identitystore.IdentityStore
-	IgnoreTagsConfig                  *tftags.IgnoreConfig
-	ImageBuilderConn                  *imagebuilder.Imagebuilder
-	InspectorConn                     *inspector.Inspector
-	IoT1ClickDevicesConn              *iot1clickdevicesservice.IoT1ClickDevicesService
-	IoT1ClickProjectsConn             *iot1clickprojects.IoT1ClickProjects
-	IoTAnalyticsConn                  *iotanalytics.IoTAnalytics
-	IoTConn                           *iot.IoT
-	IoTDataPlaneConn                  *iotdataplane.IoTDataPlane
-	IoTDeviceAdvisorConn              *iotdeviceadvisor.IoTDeviceAdvisor
-	IoTEventsConn                     *iotevents.IoTEvents
-	IoTEventsDataConn                 *ioteventsdata.IoTEventsData
-	IoTFleetHubConn                   *iotfleethub.IoTFleetHub
-	IoTJobsDataPlaneConn              *iotjobsdataplane.IoTJobsDataPlane
-	IoTSecureTunnelingConn            *iotsecuretunneling.IoTSecureTunneling
-	IoTSiteWiseConn                   *iotsitewise.IoTSiteWise
-	IoTThingsGraphConn                *iotthingsgraph.IoTThingsGraph
-	IoTWirelessConn                   *iotwireless.IoTWireless
-	KafkaConn                         *kafka.Kafka
-	KafkaConnectConn                  *kafkaconnect.KafkaConnect
-	KendraConn                        *kendra.Kendra
-	KinesisAnalyticsConn              *kinesisanalytics.KinesisAnalytics
-	KinesisAnalyticsV2Conn            *kinesisanalyticsv2.KinesisAnalyticsV2
-	KinesisConn                       *kinesis.Kinesis
-	KinesisVideoArchivedMediaConn     *kinesisvideoarchivedmedia.KinesisVideoArchivedMedia
-	KinesisVideoConn                  *kinesisvideo.KinesisVideo
-	KinesisVideoMediaConn             *kinesisvideomedia.KinesisVideoMedia
-	KinesisVideoSignalingChannelsConn *kinesisvideosignalingchannels.KinesisVideoSignalingChannels
-	KMSConn                           *kms.KMS
-	LakeFormationConn                 *lakeformation.LakeFormation
-	LambdaConn                        *lambda.Lambda
-	LexModelsConn                     *lexmodelbuildingservice.LexModelBuildingService
-	LexModelsV2Conn                   *lexmodelsv2.LexModelsV2
-	LexRuntimeConn                    *lexruntimeservice.LexRuntimeService
-	LexRuntimeV2Conn                  *lexruntimev2.LexRuntimeV2
-	LicenseManagerConn                *licensemanager.LicenseManager
-	LightsailConn                     *lightsail.Lightsail
-	LocationConn                      *locationservice.LocationService
-	LookoutEquipmentConn              *lookoutequipment.LookoutEquipment
-	LookoutForVisionConn              *lookoutforvision.LookoutForVision
-	LookoutMetricsConn                *lookoutmetrics.LookoutMetrics
-	MachineLearningConn               *machinelearning.MachineLearning
-	Macie2Conn                        *macie2.Macie2
-	MacieConn                         *macie.Macie
-	ManagedBlockchainConn             *managedblockchain.ManagedBlockchain
-	MarketplaceCatalogConn            *marketplacecatalog.MarketplaceCatalog
-	MarketplaceCommerceAnalyticsConn  *marketplacecommerceanalytics.MarketplaceCommerceAnalytics
-	MarketplaceEntitlementConn        *marketplaceentitlementservice.MarketplaceEntitlementService
-	MarketplaceMeteringConn           *marketplacemetering.MarketplaceMetering
-	MediaConnectConn                  *mediaconnect.MediaConnect
-	MediaConvertAccountConn           *mediaconvert.MediaConvert
-	MediaConvertConn                  *mediaconvert.MediaConvert
-	MediaLiveConn                     *medialive.MediaLive
-	MediaPackageConn                  *mediapackage.MediaPackage
-	MediaPackageVODConn               *mediapackagevod.MediaPackageVod
-	MediaStoreConn                    *mediastore.MediaStore
-	MediaStoreDataConn                *mediastoredata.MediaStoreData
-	MediaTailorConn                   *mediatailor.MediaTailor
-	MemoryDBConn                      *memorydb.MemoryDB
-	MgnConn                           *mgn.Mgn
-	MigrationHubConfigConn            *migrationhubconfig.MigrationHubConfig
-	MigrationHubConn                  *migrationhub.MigrationHub
-	MobileAnalyticsConn               *mobileanalytics.MobileAnalytics
-	MobileConn                        *mobile.Mobile
-	MQConn                            *mq.MQ
-	MTurkConn                         *mturk.MTurk
-	MWAAConn                          *mwaa.MWAA
-	NeptuneConn                       *neptune.Neptune
-	NetworkFirewallConn               *networkfirewall.NetworkFirewall
-	NetworkManagerConn                *networkmanager.NetworkManager
-	NimbleStudioConn                  *nimblestudio.NimbleStudio
-	OpsWorksCMConn                    *opsworkscm.OpsWorksCM
-	OpsWorksConn                      *opsworks.OpsWorks
-	OrganizationsConn                 *organizations.Organizations
-	OutpostsConn                      *outposts.Outposts
-	Partition                         string
-	PersonalizeConn                   *personalize.Personalize
-	PersonalizeEventsConn             *personalizeevents.PersonalizeEvents
-	PersonalizeRuntimeConn            *personalizeruntime.PersonalizeRuntime
-	PIConn                            *pi.PI
-	PinpointConn                      *pinpoint.Pinpoint
-	PinpointEmailConn                 *pinpointemail.PinpointEmail
-	PinpointSMSVoiceConn              *pinpointsmsvoice.PinpointSMSVoice
-	PollyConn                         *polly.Polly
-	PricingConn                       *pricing.Pricing
-	ProtonConn                        *proton.Proton
-	QLDBConn                          *qldb.QLDB
-	QLDBSessionConn                   *qldbsession.QLDBSession
-	QuickSightConn                    *quicksight.QuickSight
-	RAMConn                           *ram.RAM
-	RDSConn                           *rds.RDS
-	RDSDataConn                       *rdsdataservice.RDSDataService
-	RedshiftConn                      *redshift.Redshift
-	RedshiftDataConn                  *redshiftdataapiservice.RedshiftDataAPIService
-	Region                            string
-	RekognitionConn                   *rekognition.Rekognition
-	ResourceGroupsConn                *resourcegroups.ResourceGroups
-	ResourceGroupsTaggingAPIConn      *resourcegroupstaggingapi.ResourceGroupsTaggingAPI
-	ReverseDNSPrefix                  string
-	RoboMakerConn                     *robomaker.RoboMaker
-	Route53Conn                       *route53.Route53
-	Route53DomainsConn                *route53domains.Route53Domains
-	Route53RecoveryControlConfigConn  *route53recoverycontrolconfig.Route53RecoveryControlConfig
-	Route53RecoveryReadinessConn      *route53recoveryreadiness.Route53RecoveryReadiness
-	Route53ResolverConn               *route53resolver.Route53Resolver
-	S3Conn                            *s3.S3
-	S3ConnURICleaningDisabled         *s3.S3
-	S3ControlConn                     *s3control.S3Control
-	S3OutpostsConn                    *s3outposts.S3Outposts
-	SageMakerConn                     *sagemaker.SageMaker
-	SageMakerEdgeManagerConn          *sagemakeredgemanager.SagemakerEdgeManager
-	SageMakerFeatureStoreRuntimeConn  *sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime
-	SageMakerRuntimeConn              *sagemakerruntime.SageMakerRuntime
-	SavingsPlansConn                  *savingsplans.SavingsPlans
-	SchemasConn                       *schemas.Schemas
-	SecretsManagerConn                *secretsmanager.SecretsManager
-	SecurityHubConn                   *securityhub.SecurityHub
-	ServerlessRepoConn                *serverlessapplicationrepository.ServerlessApplicationRepository
-	ServiceCatalogConn                *servicecatalog.ServiceCatalog
-	ServiceDiscoveryConn              *servicediscovery.ServiceDiscovery
-	ServiceQuotasConn                 *servicequotas.ServiceQuotas
-	SESConn                           *ses.SES
-	SESV2Conn                         *sesv2.SESV2
-	SFNConn                           *sfn.SFN
-	ShieldConn                        *shield.Shield
-	SignerConn                        *signer.Signer
-	SimpleDBConn                      *simpledb.SimpleDB
-	SMSConn                           *sms.SMS
-	SnowballConn                      *snowball.Snowball
-	SNSConn                           *sns.SNS
-	SQSConn                           *sqs.SQS
-	SSMConn                           *ssm.SSM
-	SSMContactsConn                   *ssmcontacts.SSMContacts
-	SSMIncidentsConn                  *ssmincidents.SSMIncidents
-	SSOAdminConn                      *ssoadmin.SSOAdmin
-	SSOConn                           *sso.SSO
-	SSOOIDCConn                       *ssooidc.SSOOIDC
-	StorageGatewayConn                *storagegateway.StorageGateway
-	STSConn                           *sts.STS
-	SupportConn                       *support.Support
-	SupportedPlatforms                []string
-	SWFConn                           *swf.SWF
-	SyntheticsConn                    *synthetics.Synthetics
-	TerraformVersion                  string
-	TextractConn                      *textract.Textract
-	TimestreamQueryConn               *timestreamquery.TimestreamQuery
-	TimestreamWriteConn               *timestreamwrite.TimestreamWrite
-	TranscribeConn                    *transcribeservice.TranscribeService
-	TranscribeStreamingConn           *transcribestreamingservice.TranscribeStreamingService
-	TransferConn                      *transfer.Transfer
-	TranslateConn                     *translate.Translate
-	WAFConn                           *waf.WAF
-	WAFRegionalConn                   *wafregional.WAFRegional
-	WAFV2Conn                         *wafv2.WAFV2
-	WellArchitectedConn               *wellarchitected.WellArchitected
-	WorkDocsConn                      *workdocs.WorkDocs
-	WorkLinkConn                      *worklink.WorkLink
-	WorkMailConn                      *workmail.WorkMail
-	WorkMailMessageFlowConn           *workmailmessageflow.WorkMailMessageFlow
-	WorkSpacesConn                    *workspaces.WorkSpaces
-	XRayConn                          *xray.XRay
+	AccountID               string
+	DefaultTagsConfig       *tftags.DefaultConfig
+	DNSSuffix               string
+	IgnoreTagsConfig        *tftags.IgnoreConfig
+	MediaConvertAccountConn *mediaconvert.MediaConvert
+	Partition               string
+	Region                  string
+	ReverseDNSPrefix        string
+	SupportedPlatforms      []string
+	TagPolicy               *tftags.Policy
+	TerraformVersion        string
+
+	config          *Config
+	regionalClients *regionalClientCache
+	serviceConns    *serviceConnCache
+	session         *session.Session
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -1197,7 +940,7 @@ func (c *Config) Client() (interface{}, error) {
 		APNInfo:                 StdUserAgentProducts(c.TerraformVersion),
 		CallerDocumentationURL:  "https://registry.terraform.io/providers/hashicorp/aws",
 		CallerName:              "Terraform AWS Provider",
-		DebugLogging:            true, // Until https://github.com/hashicorp/aws-sdk-go-base/issues/96 is implemented
+		DebugLogging:            c.RequestTraceFile == "", // Until https://github.com/hashicorp/aws-sdk-go-base/issues/96 is implemented; request traces are redacted instead
 		IamEndpoint:             c.Endpoints[IAM],
 		Insecure:                c.Insecure,
 		HTTPProxy:               c.HTTPProxy,
@@ -1242,619 +985,123 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, fmt.Errorf("error creating AWS SDK v1 session: %w", err)
 	}
 
-	accountID, Partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
-	if err != nil {
-		return nil, fmt.Errorf("error retrieving account details: %w", err)
+	if c.RetryPolicy != nil {
+		newRetryPolicyHandlers(c.RetryPolicy, c.MaxRetries).configure(sess)
 	}
 
-	if accountID == "" {
-		log.Println("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
+	if len(c.RateLimits) > 0 {
+		newRateLimitHandlers(c.RateLimits).configure(sess)
 	}
 
-	if len(c.ForbiddenAccountIds) > 0 {
-		for _, forbiddenAccountID := range c.AllowedAccountIds {
-			if accountID == forbiddenAccountID {
-				return nil, fmt.Errorf("AWS Account ID not allowed: %s", accountID)
-			}
-		}
-	}
-	if len(c.AllowedAccountIds) > 0 {
-		found := false
-		for _, allowedAccountID := range c.AllowedAccountIds {
-			if accountID == allowedAccountID {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return nil, fmt.Errorf("AWS Account ID not allowed: %s", accountID)
+	if c.RequestTraceFile != "" {
+		file, err := openRequestTraceFile(c.RequestTraceFile)
+
+		if err != nil {
+			return nil, err
 		}
-	}
 
-	DNSSuffix := "amazonaws.com"
-	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
-		DNSSuffix = p.DNSSuffix()
+		newRequestTracer(file, c.RequestTraceRedactedFields).configure(sess)
 	}
 
-	client := &AWSClient{
-		AccessAnalyzerConn:                accessanalyzer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AccessAnalyzer])})),
-		AccountConn:                       account.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Account])})),
-		AccountID:                         accountID,
-		ACMConn:                           acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ACM])})),
-		ACMPCAConn:                        acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ACMPCA])})),
-		AlexaForBusinessConn:              alexaforbusiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AlexaForBusiness])})),
-		AMPConn:                           prometheusservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AMP])})),
-		AmplifyBackendConn:                amplifybackend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AmplifyBackend])})),
-		AmplifyConn:                       amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Amplify])})),
-		APIGatewayConn:                    apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[APIGateway])})),
-		APIGatewayV2Conn:                  apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[APIGatewayV2])})),
-		AppAutoScalingConn:                applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppAutoScaling])})),
-		AppConfigConn:                     appconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppConfig])})),
-		AppFlowConn:                       appflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppFlow])})),
-		AppIntegrationsConn:               appintegrationsservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppIntegrations])})),
-		ApplicationCostProfilerConn:       applicationcostprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ApplicationCostProfiler])})),
-		ApplicationDiscoveryConn:          applicationdiscoveryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ApplicationDiscovery])})),
-		ApplicationInsightsConn:           applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ApplicationInsights])})),
-		AppMeshConn:                       appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppMesh])})),
-		AppRegistryConn:                   appregistry.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppRegistry])})),
-		AppRunnerConn:                     apprunner.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppRunner])})),
-		AppStreamConn:                     appstream.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppStream])})),
-		AppSyncConn:                       appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppSync])})),
-		AthenaConn:                        athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Athena])})),
-		AuditManagerConn:                  auditmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AuditManager])})),
-		AugmentedAIRuntimeConn:            augmentedairuntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AugmentedAIRuntime])})),
-		AutoScalingConn:                   autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AutoScaling])})),
-		AutoScalingPlansConn:              autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AutoScalingPlans])})),
-		BackupConn:                        backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Backup])})),
-		BatchConn:                         batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Batch])})),
-		BraketConn:                        braket.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Braket])})),
-		BudgetsConn:                       budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Budgets])})),
-		ChimeConn:                         chime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Chime])})),
-		Cloud9Conn:                        cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Cloud9])})),
-		CloudControlConn:                  cloudcontrolapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudControl])})),
-		CloudDirectoryConn:                clouddirectory.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudDirectory])})),
-		CloudFormationConn:                cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudFormation])})),
-		CloudFrontConn:                    cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudFront])})),
-		CloudHSMV2Conn:                    cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudHSMV2])})),
-		CloudSearchConn:                   cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudSearch])})),
-		CloudSearchDomainConn:             cloudsearchdomain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudSearchDomain])})),
-		CloudTrailConn:                    cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudTrail])})),
-		CloudWatchConn:                    cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudWatch])})),
-		CloudWatchLogsConn:                cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudWatchLogs])})),
-		CodeArtifactConn:                  codeartifact.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeArtifact])})),
-		CodeBuildConn:                     codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeBuild])})),
-		CodeCommitConn:                    codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeCommit])})),
-		CodeDeployConn:                    codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeDeploy])})),
-		CodeGuruProfilerConn:              codeguruprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeGuruProfiler])})),
-		CodeGuruReviewerConn:              codegurureviewer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeGuruReviewer])})),
-		CodePipelineConn:                  codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodePipeline])})),
-		CodeStarConn:                      codestar.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeStar])})),
-		CodeStarConnectionsConn:           codestarconnections.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeStarConnections])})),
-		CodeStarNotificationsConn:         codestarnotifications.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeStarNotifications])})),
-		CognitoIdentityConn:               cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CognitoIdentity])})),
-		CognitoIDPConn:                    cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CognitoIDP])})),
-		CognitoSyncConn:                   cognitosync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CognitoSync])})),
-		ComprehendConn:                    comprehend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Comprehend])})),
-		ComprehendMedicalConn:             comprehendmedical.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ComprehendMedical])})),
-		ConfigServiceConn:                 configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ConfigService])})),
-		ConnectConn:                       connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Connect])})),
-		ConnectContactLensConn:            connectcontactlens.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ConnectContactLens])})),
-		ConnectParticipantConn:            connectparticipant.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ConnectParticipant])})),
-		CostExplorerConn:                  costexplorer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CostExplorer])})),
-		CURConn:                           costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CUR])})),
-		DataExchangeConn:                  dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DataExchange])})),
-		DataPipelineConn:                  datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DataPipeline])})),
-		DataSyncConn:                      datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DataSync])})),
-		DAXConn:                           dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DAX])})),
-		DefaultTagsConfig:                 c.DefaultTagsConfig,
-		DetectiveConn:                     detective.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Detective])})),
-		DeviceFarmConn:                    devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DeviceFarm])})),
-		DevOpsGuruConn:                    devopsguru.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DevOpsGuru])})),
-		DirectConnectConn:                 directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DirectConnect])})),
-		DLMConn:                           dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DLM])})),
-		DMSConn:                           databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DMS])})),
-		DNSSuffix:                         DNSSuffix,
-		DocDBConn:                         docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DocDB])})),
-		DSConn:                            directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DS])})),
-		DynamoDBConn:                      dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DynamoDB])})),
-		DynamoDBStreamsConn:               dynamodbstreams.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DynamoDBStreams])})),
-		EC2Conn:                           ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EC2])})),
-		EC2InstanceConnectConn:            ec2instanceconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EC2InstanceConnect])})),
-		ECRConn:                           ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ECR])})),
-		ECRPublicConn:                     ecrpublic.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ECRPublic])})),
-		ECSConn:                           ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ECS])})),
-		EFSConn:                           efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EFS])})),
-		EKSConn:                           eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EKS])})),
-		ElastiCacheConn:                   elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ElastiCache])})),
-		ElasticBeanstalkConn:              elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ElasticBeanstalk])})),
-		ElasticInferenceConn:              elasticinference.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ElasticInference])})),
-		ElasticsearchConn:                 elasticsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Elasticsearch])})),
-		ElasticTranscoderConn:             elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ElasticTranscoder])})),
-		ELBConn:                           elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ELB])})),
-		ELBV2Conn:                         elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ELBV2])})),
-		EMRConn:                           emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EMR])})),
-		EMRContainersConn:                 emrcontainers.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EMRContainers])})),
-		EventsConn:                        eventbridge.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Events])})),
-		FinSpaceConn:                      finspace.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FinSpace])})),
-		FinSpaceDataConn:                  finspacedata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FinSpaceData])})),
-		FirehoseConn:                      firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Firehose])})),
-		FISConn:                           fis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FIS])})),
-		FMSConn:                           fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FMS])})),
-		ForecastConn:                      forecastservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Forecast])})),
-		ForecastQueryConn:                 forecastqueryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ForecastQuery])})),
-		FraudDetectorConn:                 frauddetector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FraudDetector])})),
-		FSxConn:                           fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FSx])})),
-		GameLiftConn:                      gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GameLift])})),
-		GlacierConn:                       glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Glacier])})),
-		GlueConn:                          glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Glue])})),
-		GlueDataBrewConn:                  gluedatabrew.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GlueDataBrew])})),
-		GrafanaConn:                       managedgrafana.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Grafana])})),
-		GreengrassConn:                    greengrass.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Greengrass])})),
-		GreengrassV2Conn:                  greengrassv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GreengrassV2])})),
-		GroundStationConn:                 groundstation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GroundStation])})),
-		GuardDutyConn:                     guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GuardDuty])})),
-		HealthConn:                        health.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Health])})),
-		HealthLakeConn:                    healthlake.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[HealthLake])})),
-		HoneycodeConn:                     honeycode.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Honeycode])})),
-		IAMConn:                           iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IAM])})),
-		IdentityStoreConn:                 identitystore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IdentityStore])})),
-		IgnoreTagsConfig:                  c.IgnoreTagsConfig,
-		ImageBuilderConn:                  imagebuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ImageBuilder])})),
-		InspectorConn:                     inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Inspector])})),
-		IoT1ClickDevicesConn:              iot1clickdevicesservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoT1ClickDevices])})),
-		IoT1ClickProjectsConn:             iot1clickprojects.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoT1ClickProjects])})),
-		IoTAnalyticsConn:                  iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTAnalytics])})),
-		IoTConn:                           iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoT])})),
-		IoTDataPlaneConn:                  iotdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTDataPlane])})),
-		IoTDeviceAdvisorConn:              iotdeviceadvisor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTDeviceAdvisor])})),
-		IoTEventsConn:                     iotevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTEvents])})),
-		IoTEventsDataConn:                 ioteventsdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTEventsData])})),
-		IoTFleetHubConn:                   iotfleethub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTFleetHub])})),
-		IoTJobsDataPlaneConn:              iotjobsdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTJobsDataPlane])})),
-		IoTSecureTunnelingConn:            iotsecuretunneling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTSecureTunneling])})),
-		IoTSiteWiseConn:                   iotsitewise.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTSiteWise])})),
-		IoTThingsGraphConn:                iotthingsgraph.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTThingsGraph])})),
-		IoTWirelessConn:                   iotwireless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTWireless])})),
-		KafkaConn:                         kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Kafka])})),
-		KafkaConnectConn:                  kafkaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KafkaConnect])})),
-		KendraConn:                        kendra.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Kendra])})),
-		KinesisAnalyticsConn:              kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisAnalytics])})),
-		KinesisAnalyticsV2Conn:            kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisAnalyticsV2])})),
-		KinesisConn:                       kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Kinesis])})),
-		KinesisVideoArchivedMediaConn:     kinesisvideoarchivedmedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisVideoArchivedMedia])})),
-		KinesisVideoConn:                  kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisVideo])})),
-		KinesisVideoMediaConn:             kinesisvideomedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisVideoMedia])})),
-		KinesisVideoSignalingChannelsConn: kinesisvideosignalingchannels.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisVideoSignalingChannels])})),
-		KMSConn:                           kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KMS])})),
-		LakeFormationConn:                 lakeformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LakeFormation])})),
-		LambdaConn:                        lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Lambda])})),
-		LexModelsConn:                     lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LexModels])})),
-		LexModelsV2Conn:                   lexmodelsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LexModelsV2])})),
-		LexRuntimeConn:                    lexruntimeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LexRuntime])})),
-		LexRuntimeV2Conn:                  lexruntimev2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LexRuntimeV2])})),
-		LicenseManagerConn:                licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LicenseManager])})),
-		LightsailConn:                     lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Lightsail])})),
-		LocationConn:                      locationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Location])})),
-		LookoutEquipmentConn:              lookoutequipment.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LookoutEquipment])})),
-		LookoutForVisionConn:              lookoutforvision.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LookoutForVision])})),
-		LookoutMetricsConn:                lookoutmetrics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LookoutMetrics])})),
-		MachineLearningConn:               machinelearning.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MachineLearning])})),
-		Macie2Conn:                        macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Macie2])})),
-		MacieConn:                         macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Macie])})),
-		ManagedBlockchainConn:             managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ManagedBlockchain])})),
-		MarketplaceCatalogConn:            marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceCatalog])})),
-		MarketplaceCommerceAnalyticsConn:  marketplacecommerceanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceCommerceAnalytics])})),
-		MarketplaceEntitlementConn:        marketplaceentitlementservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceEntitlement])})),
-		MarketplaceMeteringConn:           marketplacemetering.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceMetering])})),
-		MediaConnectConn:                  mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaConnect])})),
-		MediaConvertConn:                  mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaConvert])})),
-		MediaLiveConn:                     medialive.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaLive])})),
-		MediaPackageConn:                  mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaPackage])})),
-		MediaPackageVODConn:               mediapackagevod.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaPackageVOD])})),
-		MediaStoreConn:                    mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaStore])})),
-		MediaStoreDataConn:                mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaStoreData])})),
-		MediaTailorConn:                   mediatailor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaTailor])})),
-		MemoryDBConn:                      memorydb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MemoryDB])})),
-		MgnConn:                           mgn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Mgn])})),
-		MigrationHubConfigConn:            migrationhubconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MigrationHubConfig])})),
-		MigrationHubConn:                  migrationhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MigrationHub])})),
-		MobileAnalyticsConn:               mobileanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MobileAnalytics])})),
-		MobileConn:                        mobile.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Mobile])})),
-		MQConn:                            mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MQ])})),
-		MTurkConn:                         mturk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MTurk])})),
-		MWAAConn:                          mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MWAA])})),
-		NeptuneConn:                       neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Neptune])})),
-		NetworkFirewallConn:               networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkFirewall])})),
-		NetworkManagerConn:                networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkManager])})),
-		NimbleStudioConn:                  nimblestudio.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NimbleStudio])})),
-		OpsWorksCMConn:                    opsworkscm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpsWorksCM])})),
-		OpsWorksConn:                      opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpsWorks])})),
-		OrganizationsConn:                 organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Organizations])})),
-		OutpostsConn:                      outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Outposts])})),
-		Partition:                         Partition,
-		PersonalizeConn:                   personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Personalize])})),
-		PersonalizeEventsConn:             personalizeevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PersonalizeEvents])})),
-		PersonalizeRuntimeConn:            personalizeruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PersonalizeRuntime])})),
-		PIConn:                            pi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PI])})),
-		PinpointConn:                      pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Pinpoint])})),
-		PinpointEmailConn:                 pinpointemail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PinpointEmail])})),
-		PinpointSMSVoiceConn:              pinpointsmsvoice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PinpointSMSVoice])})),
-		PollyConn:                         polly.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Polly])})),
-		PricingConn:                       pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Pricing])})),
-		ProtonConn:                        proton.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Proton])})),
-		QLDBConn:                          qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[QLDB])})),
-		QLDBSessionConn:                   qldbsession.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[QLDBSession])})),
-		QuickSightConn:                    quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[QuickSight])})),
-		RAMConn:                           ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RAM])})),
-		RDSConn:                           rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RDS])})),
-		RDSDataConn:                       rdsdataservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RDSData])})),
-		RedshiftConn:                      redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Redshift])})),
-		RedshiftDataConn:                  redshiftdataapiservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RedshiftData])})),
-		Region:                            c.Region,
-		RekognitionConn:                   rekognition.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Rekognition])})),
-		ResourceGroupsConn:                resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ResourceGroups])})),
-		ResourceGroupsTaggingAPIConn:      resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ResourceGroupsTaggingAPI])})),
-		ReverseDNSPrefix:                  ReverseDNS(DNSSuffix),
-		RoboMakerConn:                     robomaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RoboMaker])})),
-		Route53DomainsConn:                route53domains.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53Domains])})),
-		Route53RecoveryControlConfigConn:  route53recoverycontrolconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53RecoveryControlConfig])})),
-		Route53RecoveryReadinessConn:      route53recoveryreadiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53RecoveryReadiness])})),
-		Route53ResolverConn:               route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53Resolver])})),
-		S3ControlConn:                     s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[S3Control])})),
-		S3OutpostsConn:                    s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[S3Outposts])})),
-		SageMakerConn:                     sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMaker])})),
-		SageMakerEdgeManagerConn:          sagemakeredgemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMakerEdgeManager])})),
-		SageMakerFeatureStoreRuntimeConn:  sagemakerfeaturestoreruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMakerFeatureStoreRuntime])})),
-		SageMakerRuntimeConn:              sagemakerruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMakerRuntime])})),
-		SavingsPlansConn:                  savingsplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SavingsPlans])})),
-		SchemasConn:                       schemas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Schemas])})),
-		SecretsManagerConn:                secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SecretsManager])})),
-		SecurityHubConn:                   securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SecurityHub])})),
-		ServerlessRepoConn:                serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServerlessRepo])})),
-		ServiceCatalogConn:                servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServiceCatalog])})),
-		ServiceDiscoveryConn:              servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServiceDiscovery])})),
-		ServiceQuotasConn:                 servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServiceQuotas])})),
-		SESConn:                           ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SES])})),
-		SESV2Conn:                         sesv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SESV2])})),
-		SFNConn:                           sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SFN])})),
-		SignerConn:                        signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Signer])})),
-		SimpleDBConn:                      simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SimpleDB])})),
-		SMSConn:                           sms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SMS])})),
-		SnowballConn:                      snowball.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Snowball])})),
-		SNSConn:                           sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SNS])})),
-		SQSConn:                           sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SQS])})),
-		SSMConn:                           ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSM])})),
-		SSMContactsConn:                   ssmcontacts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSMContacts])})),
-		SSMIncidentsConn:                  ssmincidents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSMIncidents])})),
-		SSOAdminConn:                      ssoadmin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSOAdmin])})),
-		SSOConn:                           sso.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSO])})),
-		SSOOIDCConn:                       ssooidc.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSOOIDC])})),
-		StorageGatewayConn:                storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[StorageGateway])})),
-		STSConn:                           sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[STS])})),
-		SupportConn:                       support.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Support])})),
-		SWFConn:                           swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SWF])})),
-		SyntheticsConn:                    synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Synthetics])})),
-		TerraformVersion:                  c.TerraformVersion,
-		TextractConn:                      textract.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Textract])})),
-		TimestreamQueryConn:               timestreamquery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TimestreamQuery])})),
-		TimestreamWriteConn:               timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TimestreamWrite])})),
-		TranscribeConn:                    transcribeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Transcribe])})),
-		TranscribeStreamingConn:           transcribestreamingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TranscribeStreaming])})),
-		TransferConn:                      transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Transfer])})),
-		TranslateConn:                     translate.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Translate])})),
-		WAFConn:                           waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAF])})),
-		WAFRegionalConn:                   wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAFRegional])})),
-		WAFV2Conn:                         wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAFV2])})),
-		WellArchitectedConn:               wellarchitected.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WellArchitected])})),
-		WorkDocsConn:                      workdocs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkDocs])})),
-		WorkLinkConn:                      worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkLink])})),
-		WorkMailConn:                      workmail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkMail])})),
-		WorkMailMessageFlowConn:           workmailmessageflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkMailMessageFlow])})),
-		WorkSpacesConn:                    workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkSpaces])})),
-		XRayConn:                          xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[XRay])})),
+	if c.APIMetricsFile != "" {
+		apiMetricsForPath(c.APIMetricsFile).configure(sess)
 	}
 
-	// "Global" services that require customizations
-	globalAcceleratorConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[GlobalAccelerator]),
-	}
-	route53Config := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[Route53]),
-	}
-	route53RecoveryControlConfigConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[Route53RecoveryControlConfig]),
-	}
-	route53RecoveryReadinessConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[Route53RecoveryReadiness]),
-	}
-	shieldConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[Shield]),
+	if c.ReadOnly {
+		configureReadOnly(sess)
 	}
 
-	// Services that require multiple client configurations
-	s3Config := &aws.Config{
-		Endpoint:         aws.String(c.Endpoints[S3]),
-		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
+	accountID, Partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving account details: %w", err)
 	}
 
-	client.S3Conn = s3.New(sess.Copy(s3Config))
-
-	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
-	client.S3ConnURICleaningDisabled = s3.New(sess.Copy(s3Config))
-
-	// Force "global" services to correct regions
-	switch Partition {
-	case endpoints.AwsPartitionID:
-		globalAcceleratorConfig.Region = aws.String(endpoints.UsWest2RegionID)
-		route53Config.Region = aws.String(endpoints.UsEast1RegionID)
-		route53RecoveryControlConfigConfig.Region = aws.String(endpoints.UsWest2RegionID)
-		route53RecoveryReadinessConfig.Region = aws.String(endpoints.UsWest2RegionID)
-		shieldConfig.Region = aws.String(endpoints.UsEast1RegionID)
-	case endpoints.AwsCnPartitionID:
-		// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
-		// This can likely be removed in the future.
-		if aws.StringValue(route53Config.Endpoint) == "" {
-			route53Config.Endpoint = aws.String("https://api.route53.cn")
-		}
-		route53Config.Region = aws.String(endpoints.CnNorthwest1RegionID)
-	case endpoints.AwsUsGovPartitionID:
-		route53Config.Region = aws.String(endpoints.UsGovWest1RegionID)
+	if accountID == "" {
+		log.Println("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
 
-	client.GlobalAcceleratorConn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
-	client.Route53Conn = route53.New(sess.Copy(route53Config))
-	client.Route53RecoveryControlConfigConn = route53recoverycontrolconfig.New(sess.Copy(route53RecoveryControlConfigConfig))
-	client.Route53RecoveryReadinessConn = route53recoveryreadiness.New(sess.Copy(route53RecoveryReadinessConfig))
-	client.ShieldConn = shield.New(sess.Copy(shieldConfig))
-
-	client.APIGatewayConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Many operations can return an error such as:
-		//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
-		// Handle them all globally for the service client.
-		if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
-	client.AppAutoScalingConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// StartDeployment operations can return a ConflictException
-	// if ongoing deployments are in-progress, thus we handle them
-	// here for the service client.
-	client.AppConfigConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "StartDeployment" {
-			if tfawserr.ErrCodeEquals(r.Error, appconfig.ErrCodeConflictException) {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.AppSyncConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateGraphqlApi" {
-			if tfawserr.ErrMessageContains(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.ChimeConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// When calling CreateVoiceConnector across multiple resources,
-		// the API can randomly return a BadRequestException without explanation
-		if r.Operation.Name == "CreateVoiceConnector" {
-			if tfawserr.ErrMessageContains(r.Error, chime.ErrCodeBadRequestException, "Service received a bad request") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.CloudHSMV2Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.ConfigServiceConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// When calling Config Organization Rules API actions immediately
-		// after Organization creation, the API can randomly return the
-		// OrganizationAccessDeniedException error for a few minutes, even
-		// after succeeding a few requests.
-		switch r.Operation.Name {
-		case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
-			if !tfawserr.ErrMessageContains(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
-				return
-			}
-
-			// We only want to retry briefly as the default max retry count would
-			// excessively retry when the error could be legitimate.
-			// We currently depend on the DefaultRetryer exponential backoff here.
-			// ~10 retries gives a fair backoff of a few seconds.
-			if r.RetryCount < 9 {
-				r.Retryable = aws.Bool(true)
-			} else {
-				r.Retryable = aws.Bool(false)
-			}
-		case "DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack":
-			if !tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeOrganizationAccessDeniedException) {
-				if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(err, configservice.ErrCodeResourceInUseException) {
-					r.Retryable = aws.Bool(true)
-				}
-				return
-			}
-
-			// We only want to retry briefly as the default max retry count would
-			// excessively retry when the error could be legitimate.
-			// We currently depend on the DefaultRetryer exponential backoff here.
-			// ~10 retries gives a fair backoff of a few seconds.
-			if r.RetryCount < 9 {
-				r.Retryable = aws.Bool(true)
-			} else {
-				r.Retryable = aws.Bool(false)
-			}
-		}
-	})
-
-	client.CloudFormationConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, cloudformation.ErrCodeOperationInProgressException, "Another Operation on StackSet") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// See https://github.com/aws/aws-sdk-go/pull/1276
-	client.DynamoDBConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
-			return
-		}
-		if tfawserr.ErrMessageContains(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.EC2Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		switch err := r.Error; r.Operation.Name {
-		case "AttachVpnGateway", "DetachVpnGateway":
-			if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
-				r.Retryable = aws.Bool(true)
-			}
-
-		case "CreateClientVpnEndpoint":
-			if tfawserr.ErrMessageContains(err, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
-				r.Retryable = aws.Bool(true)
-			}
-
-		case "CreateClientVpnRoute", "DeleteClientVpnRoute":
-			if tfawserr.ErrMessageContains(err, "ConcurrentMutationLimitExceeded", "Cannot initiate another change for this endpoint at this time") {
-				r.Retryable = aws.Bool(true)
-			}
-
-		case "CreateVpnConnection":
-			if tfawserr.ErrMessageContains(err, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
+	if err := c.verifyCallerIdentity(organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Organizations])})), accountID, Partition); err != nil {
+		return nil, err
+	}
 
-		case "CreateVpnGateway":
-			if tfawserr.ErrMessageContains(err, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.FMSConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Acceptance testing creates and deletes resources in quick succession.
-		// The FMS onboarding process into Organizations is opaque to consumers.
-		// Since we cannot reasonably check this status before receiving the error,
-		// set the operation as retryable.
-		switch r.Operation.Name {
-		case "AssociateAdminAccount":
-			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.") {
-				r.Retryable = aws.Bool(true)
-			}
-		case "DisassociateAdminAccount":
-			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
+	client := c.newClient(sess, accountID, Partition)
+	client.regionalClients = &regionalClientCache{clients: map[string]*AWSClient{c.Region: client}}
 
-	client.KafkaConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	return client, nil
+}
 
-	client.KinesisConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateStream" {
-			if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
-			if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
+// newClient returns an AWSClient for the configured region. Service clients are created from the session on first use.
+func (c *Config) newClient(sess *session.Session, accountID, Partition string) *AWSClient {
+	DNSSuffix := "amazonaws.com"
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+		DNSSuffix = p.DNSSuffix()
+	}
 
-	client.OrganizationsConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Retry on the following error:
-		// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
-		if tfawserr.ErrMessageContains(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	client := &AWSClient{
+		AccountID:         accountID,
+		DefaultTagsConfig: c.DefaultTagsConfig,
+		DNSSuffix:         DNSSuffix,
+		IgnoreTagsConfig:  c.IgnoreTagsConfig,
+		Partition:         Partition,
+		Region:            c.Region,
+		ReverseDNSPrefix:  ReverseDNS(DNSSuffix),
+		TagPolicy:         c.TagPolicy,
+		TerraformVersion:  c.TerraformVersion,
+
+		config:       c,
+		serviceConns: &serviceConnCache{conns: make(map[string]interface{})},
+		session:      sess,
+	}
 
-	client.S3Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, "OperationAborted", "A conflicting conditional operation is currently in progress against this resource. Please try again.") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
-	client.SecurityHubConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		switch r.Operation.Name {
-		case "EnableOrganizationAdminAccount":
-			if tfawserr.ErrCodeEquals(r.Error, securityhub.ErrCodeResourceConflictException) {
-				r.Retryable = aws.Bool(true)
-			}
+	if !c.SkipGetEC2Platforms {
+		supportedPlatforms, err := GetSupportedEC2Platforms(client.EC2Conn())
+		if err != nil {
+			// We intentionally fail *silently* because there's a chance
+			// user just doesn't have ec2:DescribeAccountAttributes permissions
+			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
+		} else {
+			client.SupportedPlatforms = supportedPlatforms
 		}
-	})
+	}
 
-	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
-	client.SSOAdminConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "AttachManagedPolicyToPermissionSet" || r.Operation.Name == "DetachManagedPolicyFromPermissionSet" {
-			if tfawserr.ErrCodeEquals(r.Error, ssoadmin.ErrCodeConflictException) {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
+	return client
+}
 
-	client.StorageGatewayConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
-		if tfawserr.ErrMessageContains(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+// serviceConfig returns the AWS SDK configuration overrides for the service with the specified endpoint key.
+func (c *Config) serviceConfig(key, partition string) *aws.Config {
+	config := &aws.Config{
+		Endpoint: aws.String(c.Endpoints[key]),
+	}
 
-	client.WAFV2Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
-			r.Retryable = aws.Bool(true)
-		}
+	if key == S3 {
+		config.S3ForcePathStyle = aws.Bool(c.S3ForcePathStyle)
+	}
 
-		if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
-			r.Retryable = aws.Bool(true)
+	// Force "global" services to correct regions
+	switch partition {
+	case endpoints.AwsPartitionID:
+		switch key {
+		case GlobalAccelerator, Route53RecoveryControlConfig, Route53RecoveryReadiness:
+			config.Region = aws.String(endpoints.UsWest2RegionID)
+		case Route53, Shield:
+			config.Region = aws.String(endpoints.UsEast1RegionID)
 		}
-
-		if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
-			r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
-			// WAFv2 supports tag on create which can result in the below error codes according to the documentation
-			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
-			}
-			if tfawserr.ErrMessageContains(err, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
+	case endpoints.AwsCnPartitionID:
+		if key == Route53 {
+			// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
+			// This can likely be removed in the future.
+			if aws.StringValue(config.Endpoint) == "" {
+				config.Endpoint = aws.String("https://api.route53.cn")
 			}
+			config.Region = aws.String(endpoints.CnNorthwest1RegionID)
 		}
-	})
-
-	if !c.SkipGetEC2Platforms {
-		supportedPlatforms, err := GetSupportedEC2Platforms(client.EC2Conn)
-		if err != nil {
-			// We intentionally fail *silently* because there's a chance
-			// user just doesn't have ec2:DescribeAccountAttributes permissions
-			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
-		} else {
-			client.SupportedPlatforms = supportedPlatforms
+	case endpoints.AwsUsGovPartitionID:
+		if key == Route53 {
+			config.Region = aws.String(endpoints.UsGovWest1RegionID)
 		}
 	}
 
-	return client, nil
+	return config
 }
 
 func StdUserAgentProducts(terraformVersion string) *awsbase.APNInfo {
@@ -1966,6 +1213,17 @@ func HCLKeys() []string {
 	return keys
 }
 
+// ServiceNames returns the AWS SDK service names and endpoint IDs of all services, e.g. "elasticloadbalancing" and "monitoring".
+func ServiceNames() []string {
+	names := make([]string, 0)
+
+	for _, v := range serviceData {
+		names = append(names, v.AWSServiceName, v.AWSEndpointsID)
+	}
+
+	return names
+}
+
 func ServiceProviderNameUpper(key string) (string, error) {
 	if v, ok := serviceData[key]; ok {
 		return v.ProviderNameUpper, nil
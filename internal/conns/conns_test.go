@@ -1,6 +1,8 @@
 package conns
 
 import (
+	"io"
+	"log"
 	"reflect"
 	"testing"
 
@@ -83,6 +85,42 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 	}
 }
 
+func BenchmarkConfigClient(b *testing.B) {
+	config := &Config{
+		AccessKey:               "AKID",
+		Region:                  "us-west-2", //lintignore:AWSAT003
+		SecretKey:               "SECRET",
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}
+
+	// Isolate the benchmark from any AWS shared configuration in the environment.
+	for _, k := range []string{"AWS_CA_BUNDLE", "AWS_CONFIG_FILE", "AWS_PROFILE", "AWS_SHARED_CREDENTIALS_FILE"} {
+		b.Setenv(k, "")
+	}
+
+	writer := log.Writer()
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(writer)
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		v, err := config.Client()
+
+		if err != nil {
+			b.Fatalf("error configuring client: %s", err)
+		}
+
+		// A typical configuration uses a handful of services.
+		client := v.(*AWSClient)
+		client.IAMConn()
+		client.S3Conn()
+	}
+}
+
 func TestGetSupportedEC2Platforms(t *testing.T) {
 	ec2Endpoints := []*servicemocks.MockEndpoint{
 		{
@@ -0,0 +1,127 @@
+package conns
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/organizations/organizationsiface"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+// hasOrganizationGuardrails returns whether any guardrail requires AWS Organizations API calls.
+func (c *Config) hasOrganizationGuardrails() bool {
+	return len(c.AllowedOrganizationIds) > 0 || len(c.AllowedOrganizationalUnits) > 0
+}
+
+// verifyCallerIdentity returns an error if the caller's account, organization, partition or region
+// is not allowed by the provider configuration.
+// conn is only used when organization or organizational unit guardrails are configured.
+func (c *Config) verifyCallerIdentity(conn organizationsiface.OrganizationsAPI, accountID, partition string) error {
+	if len(c.AllowedPartitions) > 0 && !containsString(c.AllowedPartitions, partition) {
+		return fmt.Errorf("AWS Partition not allowed: %s", partition)
+	}
+
+	if len(c.AllowedRegions) > 0 && !containsString(c.AllowedRegions, c.Region) {
+		return fmt.Errorf("AWS Region not allowed: %s", c.Region)
+	}
+
+	if len(c.ForbiddenAccountIds) > 0 && containsString(c.ForbiddenAccountIds, accountID) {
+		return fmt.Errorf("AWS Account ID not allowed: %s", accountID)
+	}
+
+	if len(c.AllowedAccountIds) > 0 && !containsString(c.AllowedAccountIds, accountID) {
+		return fmt.Errorf("AWS Account ID not allowed: %s", accountID)
+	}
+
+	if !c.hasOrganizationGuardrails() {
+		return nil
+	}
+
+	if accountID == "" {
+		return fmt.Errorf("AWS Account ID is required to verify the AWS Organization: skip_requesting_account_id must not be set")
+	}
+
+	if len(c.AllowedOrganizationIds) > 0 {
+		output, err := conn.DescribeOrganization(&organizations.DescribeOrganizationInput{})
+
+		if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAWSOrganizationsNotInUseException) {
+			return fmt.Errorf("AWS Account (%s) is not a member of an AWS Organization", accountID)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading AWS Organization for AWS Account (%s): %w", accountID, err)
+		}
+
+		if output == nil || output.Organization == nil {
+			return fmt.Errorf("error reading AWS Organization for AWS Account (%s): empty result", accountID)
+		}
+
+		if organizationID := aws.StringValue(output.Organization.Id); !containsString(c.AllowedOrganizationIds, organizationID) {
+			return fmt.Errorf("AWS Organization ID not allowed: %s", organizationID)
+		}
+	}
+
+	if len(c.AllowedOrganizationalUnits) > 0 {
+		parentIDs, err := findAccountParentIDs(conn, accountID)
+
+		if err != nil {
+			return fmt.Errorf("error reading AWS Organizations parents for AWS Account (%s): %w", accountID, err)
+		}
+
+		found := false
+		for _, parentID := range parentIDs {
+			if containsString(c.AllowedOrganizationalUnits, parentID) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("AWS Account (%s) not in an allowed AWS Organizations organizational unit", accountID)
+		}
+	}
+
+	return nil
+}
+
+// findAccountParentIDs returns the IDs of all organizational units containing the account,
+// from its direct parent up to and including the organization root.
+func findAccountParentIDs(conn organizationsiface.OrganizationsAPI, accountID string) ([]string, error) {
+	var parentIDs []string
+
+	childID := accountID
+
+	for {
+		output, err := conn.ListParents(&organizations.ListParentsInput{
+			ChildId: aws.String(childID),
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		// An account or organizational unit has exactly one parent.
+		if output == nil || len(output.Parents) == 0 || output.Parents[0] == nil {
+			return parentIDs, nil
+		}
+
+		parent := output.Parents[0]
+		childID = aws.StringValue(parent.Id)
+		parentIDs = append(parentIDs, childID)
+
+		if aws.StringValue(parent.Type) == organizations.ParentTypeRoot {
+			return parentIDs, nil
+		}
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
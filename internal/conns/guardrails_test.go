@@ -0,0 +1,160 @@
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/organizations/organizationsiface"
+)
+
+type mockOrganizationsConn struct {
+	organizationsiface.OrganizationsAPI
+
+	organizationID string
+	parents        map[string]*organizations.Parent
+}
+
+func (m *mockOrganizationsConn) DescribeOrganization(*organizations.DescribeOrganizationInput) (*organizations.DescribeOrganizationOutput, error) {
+	if m.organizationID == "" {
+		return nil, awserr.New(organizations.ErrCodeAWSOrganizationsNotInUseException, "not in use", nil)
+	}
+
+	return &organizations.DescribeOrganizationOutput{
+		Organization: &organizations.Organization{Id: aws.String(m.organizationID)},
+	}, nil
+}
+
+func (m *mockOrganizationsConn) ListParents(input *organizations.ListParentsInput) (*organizations.ListParentsOutput, error) {
+	output := &organizations.ListParentsOutput{}
+
+	if parent, ok := m.parents[aws.StringValue(input.ChildId)]; ok {
+		output.Parents = []*organizations.Parent{parent}
+	}
+
+	return output, nil
+}
+
+func TestConfigVerifyCallerIdentity(t *testing.T) {
+	conn := &mockOrganizationsConn{
+		organizationID: "o-exampleorgid",
+		parents: map[string]*organizations.Parent{
+			"123456789012":      {Id: aws.String("ou-abcd-sandbox1"), Type: aws.String(organizations.ParentTypeOrganizationalUnit)},
+			"ou-abcd-sandbox1":  {Id: aws.String("ou-abcd-workloads"), Type: aws.String(organizations.ParentTypeOrganizationalUnit)},
+			"ou-abcd-workloads": {Id: aws.String("r-abcd"), Type: aws.String(organizations.ParentTypeRoot)},
+		},
+	}
+
+	testCases := []struct {
+		Name        string
+		Config      *Config
+		Conn        organizationsiface.OrganizationsAPI
+		AccountID   string
+		ExpectError bool
+	}{
+		{
+			Name:      "no guardrails",
+			Config:    &Config{},
+			AccountID: "123456789012",
+		},
+		{
+			Name:      "allowed account",
+			Config:    &Config{AllowedAccountIds: []string{"123456789012"}},
+			AccountID: "123456789012",
+		},
+		{
+			Name:        "not allowed account",
+			Config:      &Config{AllowedAccountIds: []string{"210987654321"}},
+			AccountID:   "123456789012",
+			ExpectError: true,
+		},
+		{
+			Name:        "forbidden account",
+			Config:      &Config{ForbiddenAccountIds: []string{"123456789012"}},
+			AccountID:   "123456789012",
+			ExpectError: true,
+		},
+		{
+			Name:      "not forbidden account",
+			Config:    &Config{ForbiddenAccountIds: []string{"210987654321"}},
+			AccountID: "123456789012",
+		},
+		{
+			Name:      "allowed partition and region",
+			Config:    &Config{AllowedPartitions: []string{"aws"}, AllowedRegions: []string{"us-west-2"}, Region: "us-west-2"}, //lintignore:AWSAT003
+			AccountID: "123456789012",
+		},
+		{
+			Name:        "not allowed partition",
+			Config:      &Config{AllowedPartitions: []string{"aws-us-gov"}},
+			AccountID:   "123456789012",
+			ExpectError: true,
+		},
+		{
+			Name:        "not allowed region",
+			Config:      &Config{AllowedRegions: []string{"us-east-1"}, Region: "us-west-2"}, //lintignore:AWSAT003
+			AccountID:   "123456789012",
+			ExpectError: true,
+		},
+		{
+			Name:      "allowed organization",
+			Config:    &Config{AllowedOrganizationIds: []string{"o-exampleorgid"}},
+			Conn:      conn,
+			AccountID: "123456789012",
+		},
+		{
+			Name:        "not allowed organization",
+			Config:      &Config{AllowedOrganizationIds: []string{"o-otherorgid"}},
+			Conn:        conn,
+			AccountID:   "123456789012",
+			ExpectError: true,
+		},
+		{
+			Name:        "no organization",
+			Config:      &Config{AllowedOrganizationIds: []string{"o-exampleorgid"}},
+			Conn:        &mockOrganizationsConn{},
+			AccountID:   "123456789012",
+			ExpectError: true,
+		},
+		{
+			Name:        "organization without account ID",
+			Config:      &Config{AllowedOrganizationIds: []string{"o-exampleorgid"}},
+			Conn:        conn,
+			ExpectError: true,
+		},
+		{
+			Name:      "allowed direct organizational unit",
+			Config:    &Config{AllowedOrganizationalUnits: []string{"ou-abcd-sandbox1"}},
+			Conn:      conn,
+			AccountID: "123456789012",
+		},
+		{
+			Name:      "allowed ancestor organizational unit",
+			Config:    &Config{AllowedOrganizationalUnits: []string{"ou-abcd-workloads"}},
+			Conn:      conn,
+			AccountID: "123456789012",
+		},
+		{
+			Name:        "not allowed organizational unit",
+			Config:      &Config{AllowedOrganizationalUnits: []string{"ou-abcd-production"}},
+			Conn:        conn,
+			AccountID:   "123456789012",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := testCase.Config.verifyCallerIdentity(testCase.Conn, testCase.AccountID, "aws")
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// APIOperationMetrics are the aggregated metrics of calls to a single AWS API operation.
+type APIOperationMetrics struct {
+	Service   string `json:"service"`
+	Operation string `json:"operation"`
+	Calls     int64  `json:"calls"`
+	Errors    int64  `json:"errors"`
+	Throttles int64  `json:"throttles"`
+	Retries   int64  `json:"retries"`
+	LatencyMS int64  `json:"latency_ms"`
+}
+
+// APIMetricsSummary is the summary of AWS API calls appended to the API metrics file when the provider process stops.
+// Terraform starts a provider process for each command, and possibly more than one, so a file contains
+// one summary per line, for each process. Operations are sorted by descending cumulative latency.
+type APIMetricsSummary struct {
+	StartTime  time.Time              `json:"start_time"`
+	EndTime    time.Time              `json:"end_time"`
+	Calls      int64                  `json:"calls"`
+	Errors     int64                  `json:"errors"`
+	Throttles  int64                  `json:"throttles"`
+	Retries    int64                  `json:"retries"`
+	LatencyMS  int64                  `json:"latency_ms"`
+	Operations []*APIOperationMetrics `json:"operations"`
+}
+
+type apiOperationKey struct {
+	service   string
+	operation string
+}
+
+// apiMetrics aggregates API call metrics across all clients created from configured sessions.
+type apiMetrics struct {
+	mutex      sync.Mutex
+	now        func() time.Time
+	operations map[apiOperationKey]*APIOperationMetrics
+	path       string
+	start      time.Time
+}
+
+var (
+	// apiMetricsByPath holds the metrics of all provider configurations in the process, by summary file path.
+	// Provider configurations sharing a path share metrics.
+	apiMetricsByPath      = make(map[string]*apiMetrics)
+	apiMetricsByPathMutex sync.Mutex
+)
+
+func newAPIMetrics(path string) *apiMetrics {
+	return &apiMetrics{
+		now:        time.Now,
+		operations: make(map[apiOperationKey]*APIOperationMetrics),
+		path:       path,
+		start:      time.Now(),
+	}
+}
+
+// apiMetricsForPath returns the process-wide API metrics written to the specified path.
+func apiMetricsForPath(path string) *apiMetrics {
+	apiMetricsByPathMutex.Lock()
+	defer apiMetricsByPathMutex.Unlock()
+
+	m, ok := apiMetricsByPath[path]
+
+	if !ok {
+		m = newAPIMetrics(path)
+		apiMetricsByPath[path] = m
+	}
+
+	return m
+}
+
+func (m *apiMetrics) configure(sess *session.Session) {
+	sess.Handlers.Retry.PushBackNamed(request.NamedHandler{
+		Name: "tf.APIMetricsThrottle",
+		Fn:   m.recordAttempt,
+	})
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "tf.APIMetrics",
+		Fn:   m.recordCall,
+	})
+}
+
+// operation returns the metrics for the request's operation. The caller must hold the mutex.
+func (m *apiMetrics) operation(r *request.Request) *APIOperationMetrics {
+	key := apiOperationKey{service: r.ClientInfo.ServiceID, operation: r.Operation.Name}
+	metrics, ok := m.operations[key]
+
+	if !ok {
+		metrics = &APIOperationMetrics{Service: key.service, Operation: key.operation}
+		m.operations[key] = metrics
+	}
+
+	return metrics
+}
+
+// recordAttempt records a failed attempt, which may be retried.
+func (m *apiMetrics) recordAttempt(r *request.Request) {
+	if !r.IsErrorThrottle() {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.operation(r).Throttles++
+}
+
+// recordCall records a completed call, including all retries.
+func (m *apiMetrics) recordCall(r *request.Request) {
+	latency := m.now().Sub(r.Time)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	metrics := m.operation(r)
+	metrics.Calls++
+	metrics.Retries += int64(r.RetryCount)
+	metrics.LatencyMS += latency.Milliseconds()
+
+	if r.Error != nil {
+		metrics.Errors++
+	}
+}
+
+func (m *apiMetrics) summary() *APIMetricsSummary {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	summary := &APIMetricsSummary{
+		StartTime:  m.start,
+		EndTime:    m.now(),
+		Operations: make([]*APIOperationMetrics, 0, len(m.operations)),
+	}
+
+	for _, v := range m.operations {
+		metrics := *v
+
+		summary.Calls += metrics.Calls
+		summary.Errors += metrics.Errors
+		summary.Throttles += metrics.Throttles
+		summary.Retries += metrics.Retries
+		summary.LatencyMS += metrics.LatencyMS
+		summary.Operations = append(summary.Operations, &metrics)
+	}
+
+	sort.Slice(summary.Operations, func(i, j int) bool {
+		a, b := summary.Operations[i], summary.Operations[j]
+
+		if a.LatencyMS != b.LatencyMS {
+			return a.LatencyMS > b.LatencyMS
+		}
+
+		if a.Service != b.Service {
+			return a.Service < b.Service
+		}
+
+		return a.Operation < b.Operation
+	})
+
+	return summary
+}
+
+// write appends the summary to the file as a single line.
+func (m *apiMetrics) write() error {
+	b, err := json.Marshal(m.summary())
+
+	if err != nil {
+		return fmt.Errorf("error encoding API metrics summary: %w", err)
+	}
+
+	file, err := os.OpenFile(m.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return fmt.Errorf("error opening API metrics file (%s): %w", m.path, err)
+	}
+
+	if _, err := file.Write(append(b, '\n')); err != nil {
+		file.Close()
+
+		return fmt.Errorf("error writing API metrics summary (%s): %w", m.path, err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("error writing API metrics summary (%s): %w", m.path, err)
+	}
+
+	return nil
+}
+
+// WriteAPIMetrics writes the API call metrics summaries of all provider configurations
+// with an API metrics file configured. It is called when the provider process stops.
+func WriteAPIMetrics() {
+	apiMetricsByPathMutex.Lock()
+	defer apiMetricsByPathMutex.Unlock()
+
+	for _, m := range apiMetricsByPath {
+		if err := m.write(); err != nil {
+			log.Printf("[ERROR] %s", err)
+		}
+	}
+}
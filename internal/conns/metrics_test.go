@@ -0,0 +1,145 @@
+package conns
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestAPIMetrics(t *testing.T) {
+	var stsAttempts int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing form: %s", err)
+		}
+
+		switch r.Form.Get("Action") {
+		case "GetCallerIdentity":
+			// Throttle the first attempt.
+			if atomic.AddInt32(&stsAttempts, 1) == 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error><RequestId>1</RequestId></ErrorResponse>`)) //nolint:errcheck
+				return
+			}
+
+			w.Write([]byte(`<GetCallerIdentityResponse><GetCallerIdentityResult><Account>123456789012</Account></GetCallerIdentityResult></GetCallerIdentityResponse>`)) //nolint:errcheck
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>NoSuchEntity</Code><Message>not found</Message></Error><RequestId>1</RequestId></ErrorResponse>`)) //nolint:errcheck
+		}
+	}))
+	defer ts.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(ts.URL),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+		SleepDelay:  func(time.Duration) {},
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	metrics := newAPIMetrics(path)
+	metrics.configure(sess)
+
+	stsConn := sts.New(sess)
+
+	for i := 0; i < 2; i++ {
+		if _, err := stsConn.GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+			t.Fatalf("error calling GetCallerIdentity: %s", err)
+		}
+	}
+
+	if _, err := iam.New(sess).GetRole(&iam.GetRoleInput{RoleName: aws.String("example")}); err == nil {
+		t.Fatal("expected GetRole error")
+	}
+
+	// Summaries of earlier provider processes are preserved.
+	if err := os.WriteFile(path, []byte(`{"calls":1}`+"\n"), 0600); err != nil {
+		t.Fatalf("error writing earlier summary: %s", err)
+	}
+
+	if err := metrics.write(); err != nil {
+		t.Fatalf("error writing summary: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("error reading summary: %s", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+
+	if got, expected := len(lines), 2; got != expected {
+		t.Fatalf("got %d summaries, expected %d", got, expected)
+	}
+
+	var summary APIMetricsSummary
+
+	if err := json.Unmarshal([]byte(lines[1]), &summary); err != nil {
+		t.Fatalf("error decoding summary: %s", err)
+	}
+
+	if got, expected := summary.Calls, int64(3); got != expected {
+		t.Errorf("got %d calls, expected %d", got, expected)
+	}
+
+	if got, expected := len(summary.Operations), 2; got != expected {
+		t.Fatalf("got %d operations, expected %d", got, expected)
+	}
+
+	operations := make(map[string]*APIOperationMetrics)
+	for _, v := range summary.Operations {
+		operations[v.Service+"."+v.Operation] = v
+	}
+
+	expected := map[string]APIOperationMetrics{
+		sts.ServiceID + ".GetCallerIdentity": {Calls: 2, Throttles: 1, Retries: 1},
+		iam.ServiceID + ".GetRole":           {Calls: 1, Errors: 1},
+	}
+
+	for k, v := range expected {
+		got, ok := operations[k]
+
+		if !ok {
+			t.Errorf("missing operation %s", k)
+			continue
+		}
+
+		if got.Calls != v.Calls || got.Errors != v.Errors || got.Throttles != v.Throttles || got.Retries != v.Retries {
+			t.Errorf("%s: got %#v, expected %#v", k, got, v)
+		}
+	}
+}
+
+func TestAPIMetricsForPath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.json")
+
+	t.Cleanup(func() {
+		apiMetricsByPathMutex.Lock()
+		defer apiMetricsByPathMutex.Unlock()
+
+		delete(apiMetricsByPath, path)
+	})
+
+	if apiMetricsForPath(path) != apiMetricsForPath(path) {
+		t.Error("expected provider configurations with the same path to share metrics")
+	}
+}
@@ -0,0 +1,137 @@
+package conns
+
+import (
+	"context"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// RateLimit describes the client-side request rate limit for a service.
+type RateLimit struct {
+	Burst             int
+	RequestsPerSecond float64
+}
+
+// tokenBucket is a simple token bucket rate limiter.
+// Tokens are added at a fixed rate up to the bucket's capacity (burst) and each request consumes a token.
+type tokenBucket struct {
+	burst  float64
+	last   time.Time
+	mutex  sync.Mutex
+	now    func() time.Time
+	rate   float64
+	tokens float64
+}
+
+func newTokenBucket(rateLimit *RateLimit) *tokenBucket {
+	burst := rateLimit.Burst
+
+	if burst < 1 {
+		burst = int(math.Max(1, math.Ceil(rateLimit.RequestsPerSecond)))
+	}
+
+	return &tokenBucket{
+		burst:  float64(burst),
+		now:    time.Now,
+		rate:   rateLimit.RequestsPerSecond,
+		tokens: float64(burst),
+	}
+}
+
+// reserve consumes a token and returns how long the caller must wait before proceeding.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := b.now()
+
+	if !b.last.IsZero() {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 || b.rate <= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// Wait blocks until a token is available or the context is done.
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	delay := b.reserve()
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimitHandlers installs session handlers enforcing per-service client-side rate limits
+// for every client subsequently created from the session.
+type rateLimitHandlers struct {
+	buckets map[string]*tokenBucket
+}
+
+// newRateLimitHandlers returns rate limit handlers for the specified rate limits, keyed by service key.
+func newRateLimitHandlers(rateLimits map[string]*RateLimit) *rateLimitHandlers {
+	h := &rateLimitHandlers{
+		buckets: make(map[string]*tokenBucket),
+	}
+
+	for key, rateLimit := range rateLimits {
+		if rateLimit == nil || rateLimit.RequestsPerSecond <= 0 {
+			continue
+		}
+
+		if v, ok := serviceData[key]; ok {
+			h.buckets[v.AWSServiceID] = newTokenBucket(rateLimit)
+		}
+	}
+
+	return h
+}
+
+func (h *rateLimitHandlers) configure(sess *session.Session) {
+	// Sign handlers run before every attempt, so retries are also rate limited.
+	sess.Handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: "tf.RateLimit",
+		Fn:   h.wait,
+	})
+}
+
+func (h *rateLimitHandlers) wait(r *request.Request) {
+	bucket, ok := h.buckets[r.ClientInfo.ServiceID]
+
+	if !ok {
+		return
+	}
+
+	start := time.Now()
+
+	if err := bucket.Wait(r.Context()); err != nil {
+		r.Error = awserr.New(request.CanceledErrorCode, "request context canceled", err)
+		return
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		log.Printf("[DEBUG] %s/%s request rate limited for %s", r.ClientInfo.ServiceName, r.Operation.Name, elapsed)
+	}
+}
@@ -0,0 +1,143 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestTokenBucketReserve(t *testing.T) {
+	now := time.Now()
+	bucket := newTokenBucket(&RateLimit{RequestsPerSecond: 2, Burst: 2})
+	bucket.now = func() time.Time { return now }
+
+	testCases := []struct {
+		Name     string
+		Advance  time.Duration
+		Expected time.Duration
+	}{
+		{Name: "burst 1", Expected: 0},
+		{Name: "burst 2", Expected: 0},
+		{Name: "exhausted", Expected: 500 * time.Millisecond},
+		{Name: "exhausted again", Expected: 1 * time.Second},
+		{Name: "refilled", Advance: 2 * time.Second, Expected: 0},
+	}
+
+	for _, testCase := range testCases {
+		now = now.Add(testCase.Advance)
+
+		if got := bucket.reserve(); got != testCase.Expected {
+			t.Errorf("%s: got %s, expected %s", testCase.Name, got, testCase.Expected)
+		}
+	}
+}
+
+func TestTokenBucketDefaultBurst(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		RateLimit *RateLimit
+		Expected  float64
+	}{
+		{Name: "fractional rate", RateLimit: &RateLimit{RequestsPerSecond: 0.5}, Expected: 1},
+		{Name: "rate", RateLimit: &RateLimit{RequestsPerSecond: 2.5}, Expected: 3},
+		{Name: "burst", RateLimit: &RateLimit{RequestsPerSecond: 2.5, Burst: 10}, Expected: 10},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := newTokenBucket(testCase.RateLimit).burst; got != testCase.Expected {
+				t.Errorf("got %f, expected %f", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestTokenBucketWaitContextCanceled(t *testing.T) {
+	bucket := newTokenBucket(&RateLimit{RequestsPerSecond: 0.01})
+	bucket.reserve()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := bucket.Wait(ctx); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestRateLimitHandlers(t *testing.T) {
+	var mutex sync.Mutex
+	var timestamps []time.Time
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		timestamps = append(timestamps, time.Now())
+		mutex.Unlock()
+
+		w.Write([]byte(`<GetCallerIdentityResponse><GetCallerIdentityResult><Account>123456789012</Account></GetCallerIdentityResult></GetCallerIdentityResponse>`)) //nolint:errcheck
+	}))
+	defer ts.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(ts.URL),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	newRateLimitHandlers(map[string]*RateLimit{
+		STS: {RequestsPerSecond: 20, Burst: 1},
+	}).configure(sess)
+
+	conn := sts.New(sess)
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+				t.Errorf("error calling GetCallerIdentity: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	mutex.Lock()
+	requests := append([]time.Time{}, timestamps...)
+	mutex.Unlock()
+
+	if got, expected := len(requests), 6; got != expected {
+		t.Fatalf("got %d requests, expected %d", got, expected)
+	}
+
+	// 1 request from the burst followed by 5 requests at 20 requests per second.
+	if elapsed, expected := requests[len(requests)-1].Sub(start), 250*time.Millisecond-10*time.Millisecond; elapsed < expected {
+		t.Errorf("requests completed in %s, expected at least %s", elapsed, expected)
+	}
+
+	// Other services are not rate limited.
+	iamStart := time.Now()
+	iamConn := iam.New(sess)
+
+	for i := 0; i < 6; i++ {
+		iamConn.GetAccountSummary(&iam.GetAccountSummaryInput{}) //nolint:errcheck
+	}
+
+	if elapsed := time.Since(iamStart); elapsed > 200*time.Millisecond {
+		t.Errorf("unlimited requests completed in %s", elapsed)
+	}
+}
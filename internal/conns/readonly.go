@@ -0,0 +1,51 @@
+package conns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const ErrCodeReadOnlyOperation = "ReadOnlyOperation"
+
+// readOnlyOperationPrefixes are the name prefixes of AWS API operations that do not modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+// isReadOnlyOperation returns whether the AWS API operation does not modify resources, judging by its name.
+func isReadOnlyOperation(name string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// configureReadOnly makes requests for operations that may modify resources fail without being sent.
+func configureReadOnly(sess *session.Session) {
+	sess.Handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "tf.ReadOnly",
+		Fn: func(r *request.Request) {
+			if r.Operation == nil || isReadOnlyOperation(r.Operation.Name) {
+				return
+			}
+
+			r.Error = awserr.New(ErrCodeReadOnlyOperation, fmt.Sprintf("%s %s not allowed by read-only client", r.ClientInfo.ServiceID, r.Operation.Name), nil)
+		},
+	})
+}
@@ -0,0 +1,76 @@
+package conns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Expected bool
+	}{
+		{Name: "DescribeInstances", Expected: true},
+		{Name: "GetObject", Expected: true},
+		{Name: "ListBuckets", Expected: true},
+		{Name: "HeadObject", Expected: true},
+		{Name: "BatchGetItem", Expected: true},
+		{Name: "DeleteBucket", Expected: false},
+		{Name: "TerminateInstances", Expected: false},
+		{Name: "PutObject", Expected: false},
+		{Name: "BatchWriteItem", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		if got := isReadOnlyOperation(testCase.Name); got != testCase.Expected {
+			t.Errorf("%s: got %t, expected %t", testCase.Name, got, testCase.Expected)
+		}
+	}
+}
+
+func TestConfigureReadOnly(t *testing.T) {
+	var requests int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		w.Write([]byte(`<ListQueuesResponse><ListQueuesResult></ListQueuesResult></ListQueuesResponse>`)) //nolint:errcheck
+	}))
+	defer ts.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(ts.URL),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	configureReadOnly(sess)
+
+	conn := sqs.New(sess)
+
+	if _, err := conn.ListQueues(&sqs.ListQueuesInput{}); err != nil {
+		t.Errorf("error calling ListQueues: %s", err)
+	}
+
+	_, err = conn.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: aws.String(ts.URL + "/123456789012/test")})
+
+	if !tfawserr.ErrCodeEquals(err, ErrCodeReadOnlyOperation) {
+		t.Errorf("expected %s error, got: %v", ErrCodeReadOnlyOperation, err)
+	}
+
+	if got, expected := atomic.LoadInt32(&requests), int32(1); got != expected {
+		t.Errorf("got %d requests, expected %d", got, expected)
+	}
+}
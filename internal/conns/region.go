@@ -0,0 +1,109 @@
+package conns
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// singleRegionServices are services, missing from the AWS SDK endpoints metadata, whose APIs are only available in one region.
+var singleRegionServices = []string{
+	GlobalAccelerator,
+	Route53RecoveryReadiness,
+}
+
+var (
+	globalServices     map[string]bool
+	globalServicesOnce sync.Once
+)
+
+// ServiceIsGlobal returns whether the service with the specified key is global, e.g. IAM or Route 53.
+// A service is global if its API is available in at most one region of the AWS partition,
+// according to the AWS SDK endpoints metadata.
+func ServiceIsGlobal(key string) bool {
+	globalServicesOnce.Do(func() {
+		globalServices = make(map[string]bool)
+
+		for _, key := range singleRegionServices {
+			globalServices[key] = true
+		}
+
+		partition := endpoints.AwsPartition()
+		regions := partition.Regions()
+		services := partition.Services()
+
+		for key, v := range serviceData {
+			service, ok := services[v.AWSEndpointsID]
+
+			if !ok {
+				continue
+			}
+
+			n := 0
+
+			for id := range service.Endpoints() {
+				if _, ok := regions[id]; ok {
+					n++
+				}
+			}
+
+			if n <= 1 {
+				globalServices[key] = true
+			}
+		}
+	})
+
+	return globalServices[key]
+}
+
+// regionalClientCache is a cache of AWSClients by region, shared by an AWSClient and its copies.
+type regionalClientCache struct {
+	clients map[string]*AWSClient
+	mutex   sync.Mutex
+}
+
+// RegionalClient returns an AWSClient for the specified region, sharing the provider configuration's
+// credentials and settings. Regional clients are created on first use and cached for the lifetime of the provider.
+// The client itself is returned if the region is empty or the client's region.
+// Other regions must be allowed by the provider configuration's allowed_regions, if set.
+func (client *AWSClient) RegionalClient(region string) (*AWSClient, error) {
+	if region == "" || region == client.Region {
+		return client, nil
+	}
+
+	if client.regionalClients == nil {
+		return nil, fmt.Errorf("AWS client for region (%s) does not support other regions (%s)", client.Region, region)
+	}
+
+	if len(client.config.AllowedRegions) > 0 && !containsString(client.config.AllowedRegions, region) {
+		return nil, fmt.Errorf("AWS Region not allowed: %s", region)
+	}
+
+	cache := client.regionalClients
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if regionalClient, ok := cache.clients[region]; ok {
+		return regionalClient, nil
+	}
+
+	if !client.config.SkipRegionValidation {
+		if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); !ok || p.ID() != client.Partition {
+			return nil, fmt.Errorf("region (%s) is not a region of the AWS Partition (%s)", region, client.Partition)
+		}
+	}
+
+	config := *client.config
+	config.Region = region
+	sess := client.session.Copy(&aws.Config{Region: aws.String(region)})
+
+	regionalClient := config.newClient(sess, client.AccountID, client.Partition)
+	regionalClient.regionalClients = cache
+
+	cache.clients[region] = regionalClient
+
+	return regionalClient, nil
+}
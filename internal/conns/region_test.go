@@ -0,0 +1,123 @@
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestAWSClientRegionalClient(t *testing.T) {
+	config := &Config{
+		Region:              "us-west-2", //lintignore:AWSAT003
+		SkipGetEC2Platforms: true,
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String(config.Region),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	client := config.newClient(sess, "123456789012", "aws")
+	client.regionalClients = &regionalClientCache{clients: map[string]*AWSClient{config.Region: client}}
+
+	if got, err := client.RegionalClient(""); err != nil || got != client {
+		t.Errorf("expected provider client for empty region, got %v (%v)", got, err)
+	}
+
+	if got, err := client.RegionalClient(client.Region); err != nil || got != client {
+		t.Errorf("expected provider client for provider region, got %v (%v)", got, err)
+	}
+
+	region := "us-east-1" //lintignore:AWSAT003
+	regionalClient, err := client.RegionalClient(region)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := regionalClient.Region; got != region {
+		t.Errorf("got region %s, expected %s", got, region)
+	}
+
+	if got := aws.StringValue(regionalClient.EC2Conn().Config.Region); got != region {
+		t.Errorf("got EC2 client region %s, expected %s", got, region)
+	}
+
+	if got, expected := regionalClient.AccountID, client.AccountID; got != expected {
+		t.Errorf("got account ID %s, expected %s", got, expected)
+	}
+
+	// Copies of the provider client, e.g. with scoped default tags, share the cache.
+	clientCopy := *client
+
+	if got, err := clientCopy.RegionalClient(region); err != nil || got != regionalClient {
+		t.Errorf("expected cached regional client, got %v (%v)", got, err)
+	}
+
+	if got, err := regionalClient.RegionalClient(client.Region); err != nil || got != client {
+		t.Errorf("expected provider client from regional client, got %v (%v)", got, err)
+	}
+
+	if _, err := client.RegionalClient("cn-north-1"); err == nil { //lintignore:AWSAT003
+		t.Error("expected error for region in another partition")
+	}
+}
+
+func TestAWSClientRegionalClientAllowedRegions(t *testing.T) {
+	config := &Config{
+		AllowedRegions:      []string{"us-east-1", "us-west-2"}, //lintignore:AWSAT003
+		Region:              "us-west-2",                        //lintignore:AWSAT003
+		SkipGetEC2Platforms: true,
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String(config.Region),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	client := config.newClient(sess, "123456789012", "aws")
+	client.regionalClients = &regionalClientCache{clients: map[string]*AWSClient{config.Region: client}}
+
+	if _, err := client.RegionalClient("us-east-1"); err != nil { //lintignore:AWSAT003
+		t.Errorf("unexpected error for allowed region: %s", err)
+	}
+
+	if _, err := client.RegionalClient("eu-west-1"); err == nil { //lintignore:AWSAT003
+		t.Error("expected error for region not allowed")
+	}
+}
+
+func TestServiceIsGlobal(t *testing.T) {
+	testCases := []struct {
+		Key      string
+		Expected bool
+	}{
+		{Key: CloudFront, Expected: true},
+		{Key: EC2, Expected: false},
+		{Key: GlobalAccelerator, Expected: true},
+		{Key: IAM, Expected: true},
+		{Key: Organizations, Expected: true},
+		{Key: Route53, Expected: true},
+		{Key: Route53Resolver, Expected: false},
+		{Key: S3, Expected: false},
+		{Key: WAF, Expected: true},
+		{Key: WAFRegional, Expected: false},
+		{Key: "unknown", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		if got := ServiceIsGlobal(testCase.Key); got != testCase.Expected {
+			t.Errorf("ServiceIsGlobal(%s) = %t, expected %t", testCase.Key, got, testCase.Expected)
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package conns
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+const (
+	RetryModeAdaptive = "adaptive"
+	RetryModeStandard = "standard"
+)
+
+func RetryMode_Values() []string {
+	return []string{
+		RetryModeAdaptive,
+		RetryModeStandard,
+	}
+}
+
+const (
+	// Bounds for the client-side delay applied by the adaptive retry mode.
+	adaptiveRetryMinDelay = 50 * time.Millisecond
+	adaptiveRetryMaxDelay = 20 * time.Second
+)
+
+// RetryConfig describes how API requests to a service are retried.
+// Zero values inherit from the enclosing policy.
+type RetryConfig struct {
+	Mode                string
+	MaxAttempts         int
+	MaxBackoff          time.Duration
+	RetryableErrorCodes []string
+}
+
+// RetryPolicy is the provider-level retry configuration with optional
+// per-service overrides keyed by service key (see ServiceKeys()).
+type RetryPolicy struct {
+	RetryConfig
+	ServiceOverrides map[string]*RetryConfig
+}
+
+// ForService returns the effective retry configuration for the specified service key.
+func (p *RetryPolicy) ForService(key string) RetryConfig {
+	config := p.RetryConfig
+	config.RetryableErrorCodes = append([]string{}, p.RetryableErrorCodes...)
+
+	override, ok := p.ServiceOverrides[key]
+
+	if !ok || override == nil {
+		return config
+	}
+
+	if override.Mode != "" {
+		config.Mode = override.Mode
+	}
+
+	if override.MaxAttempts > 0 {
+		config.MaxAttempts = override.MaxAttempts
+	}
+
+	if override.MaxBackoff > 0 {
+		config.MaxBackoff = override.MaxBackoff
+	}
+
+	config.RetryableErrorCodes = append(config.RetryableErrorCodes, override.RetryableErrorCodes...)
+
+	return config
+}
+
+// Retryer returns an SDK request.Retryer honoring the retry configuration.
+// maxRetries is used when no maximum number of attempts is configured.
+func (c RetryConfig) Retryer(maxRetries int) request.Retryer {
+	if c.MaxAttempts > 0 {
+		maxRetries = c.MaxAttempts - 1
+	}
+
+	r := &retryer{
+		DefaultRetryer: client.DefaultRetryer{
+			NumMaxRetries:    maxRetries,
+			MinRetryDelay:    client.DefaultRetryerMinRetryDelay,
+			MinThrottleDelay: client.DefaultRetryerMinThrottleDelay,
+			MaxRetryDelay:    client.DefaultRetryerMaxRetryDelay,
+			MaxThrottleDelay: client.DefaultRetryerMaxThrottleDelay,
+		},
+		retryableErrorCodes: c.RetryableErrorCodes,
+	}
+
+	if c.MaxBackoff > 0 {
+		r.MaxRetryDelay = c.MaxBackoff
+		r.MaxThrottleDelay = c.MaxBackoff
+	}
+
+	return r
+}
+
+// retryer extends the SDK's default exponential backoff retryer with additional retryable error codes.
+type retryer struct {
+	client.DefaultRetryer
+
+	retryableErrorCodes []string
+}
+
+func (r *retryer) ShouldRetry(req *request.Request) bool {
+	if len(r.retryableErrorCodes) > 0 && tfawserr.ErrCodeEquals(req.Error, r.retryableErrorCodes...) {
+		return true
+	}
+
+	return r.DefaultRetryer.ShouldRetry(req)
+}
+
+// adaptiveRetryState tracks the client-side delay applied before sending requests to a service
+// in adaptive retry mode. The delay grows when the service throttles and decays on success.
+type adaptiveRetryState struct {
+	delay    time.Duration
+	maxDelay time.Duration
+	mutex    sync.Mutex
+}
+
+func (s *adaptiveRetryState) currentDelay() time.Duration {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.delay
+}
+
+func (s *adaptiveRetryState) throttled() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.delay *= 2
+
+	if s.delay < adaptiveRetryMinDelay {
+		s.delay = adaptiveRetryMinDelay
+	}
+
+	if s.delay > s.maxDelay {
+		s.delay = s.maxDelay
+	}
+}
+
+func (s *adaptiveRetryState) succeeded() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.delay /= 2
+
+	if s.delay < adaptiveRetryMinDelay {
+		s.delay = 0
+	}
+}
+
+// retryPolicyHandlers installs session handlers applying the retry policy to every
+// client subsequently created from the session.
+type retryPolicyHandlers struct {
+	adaptiveStates map[string]*adaptiveRetryState
+	configs        map[string]RetryConfig
+	maxRetries     int
+	mutex          sync.Mutex
+	policy         *RetryPolicy
+}
+
+func newRetryPolicyHandlers(policy *RetryPolicy, maxRetries int) *retryPolicyHandlers {
+	return &retryPolicyHandlers{
+		adaptiveStates: make(map[string]*adaptiveRetryState),
+		configs:        make(map[string]RetryConfig),
+		maxRetries:     maxRetries,
+		policy:         policy,
+	}
+}
+
+func (h *retryPolicyHandlers) configure(sess *session.Session) {
+	sess.Handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "tf.RetryPolicy",
+		Fn:   h.setRetryer,
+	})
+	sess.Handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: "tf.AdaptiveRetryDelay",
+		Fn:   h.delay,
+	})
+	sess.Handlers.Retry.PushBackNamed(request.NamedHandler{
+		Name: "tf.AdaptiveRetryThrottled",
+		Fn:   h.throttled,
+	})
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "tf.AdaptiveRetrySucceeded",
+		Fn:   h.succeeded,
+	})
+}
+
+// config returns the effective retry configuration for the request's service.
+// Configurations are cached by AWS SDK service ID.
+func (h *retryPolicyHandlers) config(r *request.Request) RetryConfig {
+	serviceID := r.ClientInfo.ServiceID
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	config, ok := h.configs[serviceID]
+
+	if !ok {
+		config = h.policy.ForService(serviceKeyForServiceID(serviceID))
+		h.configs[serviceID] = config
+	}
+
+	return config
+}
+
+func (h *retryPolicyHandlers) adaptiveState(r *request.Request) *adaptiveRetryState {
+	config := h.config(r)
+	serviceID := r.ClientInfo.ServiceID
+
+	if config.Mode != RetryModeAdaptive {
+		return nil
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	state, ok := h.adaptiveStates[serviceID]
+
+	if !ok {
+		state = &adaptiveRetryState{maxDelay: adaptiveRetryMaxDelay}
+
+		if config.MaxBackoff > 0 && config.MaxBackoff < state.maxDelay {
+			state.maxDelay = config.MaxBackoff
+		}
+
+		h.adaptiveStates[serviceID] = state
+	}
+
+	return state
+}
+
+func (h *retryPolicyHandlers) setRetryer(r *request.Request) {
+	config := h.config(r)
+
+	r.Retryer = config.Retryer(h.maxRetries)
+}
+
+func (h *retryPolicyHandlers) delay(r *request.Request) {
+	state := h.adaptiveState(r)
+
+	if state == nil {
+		return
+	}
+
+	if delay := state.currentDelay(); delay > 0 {
+		log.Printf("[DEBUG] Delaying %s/%s request %s (adaptive retry mode)", r.ClientInfo.ServiceName, r.Operation.Name, delay)
+
+		if err := aws.SleepWithContext(r.Context(), delay); err != nil {
+			r.Error = awserr.New(request.CanceledErrorCode, "request context canceled", err)
+		}
+	}
+}
+
+func (h *retryPolicyHandlers) throttled(r *request.Request) {
+	if !r.IsErrorThrottle() {
+		return
+	}
+
+	if state := h.adaptiveState(r); state != nil {
+		state.throttled()
+	}
+}
+
+func (h *retryPolicyHandlers) succeeded(r *request.Request) {
+	if r.Error != nil {
+		return
+	}
+
+	if state := h.adaptiveState(r); state != nil {
+		state.succeeded()
+	}
+}
+
+// serviceKeyForServiceID returns the service key for the specified AWS SDK service ID.
+func serviceKeyForServiceID(serviceID string) string {
+	for k, v := range serviceData {
+		if v.AWSServiceID == serviceID {
+			return k
+		}
+	}
+
+	return ""
+}
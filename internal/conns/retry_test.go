@@ -0,0 +1,192 @@
+package conns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestRetryPolicyForService(t *testing.T) {
+	policy := &RetryPolicy{
+		RetryConfig: RetryConfig{
+			Mode:                RetryModeStandard,
+			MaxAttempts:         10,
+			MaxBackoff:          30 * time.Second,
+			RetryableErrorCodes: []string{"InternalFailure"},
+		},
+		ServiceOverrides: map[string]*RetryConfig{
+			Route53: {
+				Mode:                RetryModeAdaptive,
+				MaxAttempts:         50,
+				RetryableErrorCodes: []string{"PriorRequestNotComplete"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name     string
+		Key      string
+		Expected RetryConfig
+	}{
+		{
+			Name: "no override",
+			Key:  IAM,
+			Expected: RetryConfig{
+				Mode:                RetryModeStandard,
+				MaxAttempts:         10,
+				MaxBackoff:          30 * time.Second,
+				RetryableErrorCodes: []string{"InternalFailure"},
+			},
+		},
+		{
+			Name: "override",
+			Key:  Route53,
+			Expected: RetryConfig{
+				Mode:                RetryModeAdaptive,
+				MaxAttempts:         50,
+				MaxBackoff:          30 * time.Second,
+				RetryableErrorCodes: []string{"InternalFailure", "PriorRequestNotComplete"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := policy.ForService(testCase.Key)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRetryConfigRetryer(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		RetryConfig        RetryConfig
+		MaxRetries         int
+		ExpectedMaxRetries int
+	}{
+		{
+			Name:               "inherit",
+			RetryConfig:        RetryConfig{},
+			MaxRetries:         25,
+			ExpectedMaxRetries: 25,
+		},
+		{
+			Name:               "max attempts",
+			RetryConfig:        RetryConfig{MaxAttempts: 5},
+			MaxRetries:         25,
+			ExpectedMaxRetries: 4,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.RetryConfig.Retryer(testCase.MaxRetries).MaxRetries()
+
+			if got != testCase.ExpectedMaxRetries {
+				t.Errorf("got %d, expected %d", got, testCase.ExpectedMaxRetries)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyHandlers(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		ErrorCode        string
+		RetryPolicy      *RetryPolicy
+		ExpectedAttempts int32
+	}{
+		{
+			Name:      "throttling max attempts",
+			ErrorCode: "Throttling",
+			RetryPolicy: &RetryPolicy{
+				RetryConfig: RetryConfig{MaxAttempts: 3},
+			},
+			ExpectedAttempts: 3,
+		},
+		{
+			Name:      "service override",
+			ErrorCode: "Throttling",
+			RetryPolicy: &RetryPolicy{
+				RetryConfig: RetryConfig{MaxAttempts: 3},
+				ServiceOverrides: map[string]*RetryConfig{
+					STS: {MaxAttempts: 5},
+				},
+			},
+			ExpectedAttempts: 5,
+		},
+		{
+			Name:      "non-retryable error code",
+			ErrorCode: "InvalidAction",
+			RetryPolicy: &RetryPolicy{
+				RetryConfig: RetryConfig{MaxAttempts: 3},
+			},
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:      "additional retryable error code",
+			ErrorCode: "InvalidAction",
+			RetryPolicy: &RetryPolicy{
+				RetryConfig: RetryConfig{MaxAttempts: 3, RetryableErrorCodes: []string{"InvalidAction"}},
+			},
+			ExpectedAttempts: 3,
+		},
+		{
+			Name:      "adaptive",
+			ErrorCode: "Throttling",
+			RetryPolicy: &RetryPolicy{
+				RetryConfig: RetryConfig{Mode: RetryModeAdaptive, MaxAttempts: 2, MaxBackoff: time.Millisecond},
+			},
+			ExpectedAttempts: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var attempts int32
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&attempts, 1)
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>` + testCase.ErrorCode + `</Code><Message>test</Message></Error><RequestId>1</RequestId></ErrorResponse>`)) //nolint:errcheck
+			}))
+			defer ts.Close()
+
+			sess, err := session.NewSession(&aws.Config{
+				Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+				Endpoint:    aws.String(ts.URL),
+				MaxRetries:  aws.Int(25),
+				Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+				SleepDelay:  func(time.Duration) {},
+			})
+
+			if err != nil {
+				t.Fatalf("error creating session: %s", err)
+			}
+
+			newRetryPolicyHandlers(testCase.RetryPolicy, 25).configure(sess)
+
+			_, err = sts.New(sess).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if got, expected := atomic.LoadInt32(&attempts), testCase.ExpectedAttempts; got != expected {
+				t.Errorf("got %d attempts, expected %d", got, expected)
+			}
+		})
+	}
+}
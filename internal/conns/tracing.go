@@ -0,0 +1,235 @@
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// RequestTraceRedactedValue replaces the values of redacted fields in request traces.
+const RequestTraceRedactedValue = "<redacted>"
+
+// DefaultRequestTraceRedactedFields are the request and response fields always redacted from request traces.
+// A field is either a field name, matching at any depth, or a dot-separated path suffix, e.g. "SecretString".
+// A field prefixed with an operation name, e.g. "PutParameter:Value", is the full path of a field in that
+// operation's request or response only.
+var DefaultRequestTraceRedactedFields = []string{
+	"AccessKeyId",
+	"AuthToken",
+	"Ciphertext",
+	"CiphertextBlob",
+	"Credentials",
+	"MasterUserPassword",
+	"NewPassword",
+	"OldPassword",
+	"Password",
+	"Plaintext",
+	"PrivateKey",
+	"SecretAccessKey",
+	"SecretBinary",
+	"SecretString",
+	"SessionToken",
+	"UserData",
+	"GetParameter:Parameter.Value",
+	"GetParameterHistory:Parameters.Value",
+	"GetParameters:Parameters.Value",
+	"GetParametersByPath:Parameters.Value",
+	"PutParameter:Value",
+}
+
+// requestTraceRecord is a single API call in the request trace.
+type requestTraceRecord struct {
+	Time       time.Time   `json:"time"`
+	Service    string      `json:"service"`
+	Operation  string      `json:"operation"`
+	Region     string      `json:"region"`
+	LatencyMS  int64       `json:"latency_ms"`
+	RetryCount int         `json:"retry_count"`
+	StatusCode int         `json:"status_code,omitempty"`
+	RequestID  string      `json:"request_id,omitempty"`
+	ErrorCode  string      `json:"error_code,omitempty"`
+	Request    interface{} `json:"request,omitempty"`
+	Response   interface{} `json:"response,omitempty"`
+}
+
+// requestTracer writes one JSON record per API call, after all retries, to a writer.
+type requestTracer struct {
+	mutex          sync.Mutex
+	redactedFields []string
+	writer         io.Writer
+}
+
+func newRequestTracer(writer io.Writer, redactedFields []string) *requestTracer {
+	return &requestTracer{
+		redactedFields: append(append([]string{}, DefaultRequestTraceRedactedFields...), redactedFields...),
+		writer:         writer,
+	}
+}
+
+var (
+	requestTraceFilesByPath      = make(map[string]*os.File)
+	requestTraceFilesByPathMutex sync.Mutex
+)
+
+// openRequestTraceFile opens the request trace file for appending.
+// The file is shared by all provider configurations tracing to the same path and is closed by CloseRequestTraceFiles.
+func openRequestTraceFile(path string) (*os.File, error) {
+	requestTraceFilesByPathMutex.Lock()
+	defer requestTraceFilesByPathMutex.Unlock()
+
+	if file, ok := requestTraceFilesByPath[path]; ok {
+		return file, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening request trace file (%s): %w", path, err)
+	}
+
+	requestTraceFilesByPath[path] = file
+
+	return file, nil
+}
+
+// CloseRequestTraceFiles closes the request trace files of all provider configurations
+// with a request trace file configured. It is called when the provider process stops.
+func CloseRequestTraceFiles() {
+	requestTraceFilesByPathMutex.Lock()
+	defer requestTraceFilesByPathMutex.Unlock()
+
+	for path, file := range requestTraceFilesByPath {
+		if err := file.Close(); err != nil {
+			log.Printf("[ERROR] error closing request trace file (%s): %s", path, err)
+		}
+
+		delete(requestTraceFilesByPath, path)
+	}
+}
+
+func (t *requestTracer) configure(sess *session.Session) {
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "tf.RequestTrace",
+		Fn:   t.trace,
+	})
+}
+
+func (t *requestTracer) trace(r *request.Request) {
+	record := requestTraceRecord{
+		Time:       r.Time,
+		Service:    r.ClientInfo.ServiceID,
+		Operation:  r.Operation.Name,
+		Region:     aws.StringValue(r.Config.Region),
+		LatencyMS:  time.Since(r.Time).Milliseconds(),
+		RetryCount: r.RetryCount,
+		RequestID:  r.RequestID,
+		Request:    t.redact(r.Operation.Name, r.Params),
+	}
+
+	if r.HTTPResponse != nil {
+		record.StatusCode = r.HTTPResponse.StatusCode
+	}
+
+	if r.Error != nil {
+		if awsErr, ok := r.Error.(awserr.Error); ok { //nolint:errorlint // SDK errors are not wrapped
+			record.ErrorCode = awsErr.Code()
+		}
+	} else {
+		record.Response = t.redact(r.Operation.Name, r.Data)
+	}
+
+	b, err := json.Marshal(record)
+
+	if err != nil {
+		log.Printf("[WARN] Error encoding request trace for %s/%s: %s", record.Service, record.Operation, err)
+		return
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if _, err := t.writer.Write(append(b, '\n')); err != nil {
+		log.Printf("[WARN] Error writing request trace for %s/%s: %s", record.Service, record.Operation, err)
+	}
+}
+
+// redact returns a JSON-compatible copy of the SDK input or output shape with redacted field values replaced.
+func (t *requestTracer) redact(operation string, v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return nil
+	}
+
+	var generic interface{}
+
+	if err := json.Unmarshal(b, &generic); err != nil {
+		return nil
+	}
+
+	return t.redactValue(operation, generic, "")
+}
+
+func (t *requestTracer) redactValue(operation string, v interface{}, path string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			keyPath := key
+
+			if path != "" {
+				keyPath = path + "." + key
+			}
+
+			if t.redacted(operation, keyPath) {
+				v[key] = RequestTraceRedactedValue
+			} else {
+				v[key] = t.redactValue(operation, value, keyPath)
+			}
+		}
+
+		return v
+
+	case []interface{}:
+		// List elements share the path of the list.
+		for i, value := range v {
+			v[i] = t.redactValue(operation, value, path)
+		}
+
+		return v
+	}
+
+	return v
+}
+
+// redacted returns whether the field at the dot-separated path in the operation's request or response matches a redacted field.
+func (t *requestTracer) redacted(operation, path string) bool {
+	for _, field := range t.redactedFields {
+		if i := strings.Index(field, ":"); i != -1 {
+			if strings.EqualFold(operation, field[:i]) && strings.EqualFold(path, field[i+1:]) {
+				return true
+			}
+
+			continue
+		}
+
+		if strings.EqualFold(path, field) || strings.HasSuffix(strings.ToLower(path), "."+strings.ToLower(field)) {
+			return true
+		}
+	}
+
+	return false
+}
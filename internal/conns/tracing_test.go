@@ -0,0 +1,254 @@
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestRequestTracerRedact(t *testing.T) {
+	tracer := newRequestTracer(nil, []string{"Custom.Field"})
+
+	input := map[string]interface{}{
+		"Name":         "example",
+		"SecretString": "secret1",
+		"Parameter": map[string]interface{}{
+			"Name":  "example",
+			"Value": "secret2",
+		},
+		"Tags": []interface{}{
+			map[string]interface{}{"Key": "Name", "Value": "visible"},
+		},
+		"Nested": map[string]interface{}{
+			"Custom": map[string]interface{}{
+				"Field": "secret3",
+			},
+		},
+	}
+
+	b, err := json.Marshal(tracer.redact("GetParameter", input))
+
+	if err != nil {
+		t.Fatalf("error encoding: %s", err)
+	}
+
+	got := string(b)
+
+	for _, secret := range []string{"secret1", "secret2", "secret3"} {
+		if strings.Contains(got, secret) {
+			t.Errorf("%s not redacted: %s", secret, got)
+		}
+	}
+
+	for _, visible := range []string{"example", "visible"} {
+		if !strings.Contains(got, visible) {
+			t.Errorf("%s redacted: %s", visible, got)
+		}
+	}
+}
+
+func TestRequestTracer(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Amzn-Requestid", "request-1")
+
+		switch target := r.Header.Get("X-Amz-Target"); target {
+		case "secretsmanager.GetSecretValue":
+			w.Write([]byte(`{"Name":"example","SecretString":"s3cr3t"}`)) //nolint:errcheck
+		case "AmazonSSM.PutParameter":
+			w.Write([]byte(`{"Tier":"Standard","Version":1}`)) //nolint:errcheck
+		case "AmazonSSM.GetParameter":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type":"ParameterNotFound","message":"not found"}`)) //nolint:errcheck
+		default:
+			w.Write([]byte(`<GetCallerIdentityResponse><GetCallerIdentityResult><Account>123456789012</Account></GetCallerIdentityResult><ResponseMetadata><RequestId>request-1</RequestId></ResponseMetadata></GetCallerIdentityResponse>`)) //nolint:errcheck
+		}
+	}))
+	defer ts.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(ts.URL),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	var buf bytes.Buffer
+	newRequestTracer(&buf, nil).configure(sess)
+
+	if _, err := sts.New(sess).GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("error calling GetCallerIdentity: %s", err)
+	}
+
+	if _, err := secretsmanager.New(sess).GetSecretValue(&secretsmanager.GetSecretValueInput{SecretId: aws.String("example")}); err != nil {
+		t.Fatalf("error calling GetSecretValue: %s", err)
+	}
+
+	if _, err := ssm.New(sess).PutParameter(&ssm.PutParameterInput{
+		Name:  aws.String("example"),
+		Tags:  []*ssm.Tag{{Key: aws.String("Name"), Value: aws.String("visible")}},
+		Type:  aws.String(ssm.ParameterTypeSecureString),
+		Value: aws.String("p4r4m"),
+	}); err != nil {
+		t.Fatalf("error calling PutParameter: %s", err)
+	}
+
+	if _, err := ssm.New(sess).GetParameter(&ssm.GetParameterInput{Name: aws.String("example")}); err == nil {
+		t.Fatal("expected GetParameter error")
+	}
+
+	for _, secret := range []string{"s3cr3t", "p4r4m"} {
+		if strings.Contains(buf.String(), secret) {
+			t.Errorf("%s not redacted: %s", secret, buf.String())
+		}
+	}
+
+	if !strings.Contains(buf.String(), "visible") {
+		t.Errorf("tag value redacted: %s", buf.String())
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+
+	if got, expected := len(lines), 4; got != expected {
+		t.Fatalf("got %d records, expected %d", got, expected)
+	}
+
+	expected := []requestTraceRecord{
+		{Service: sts.ServiceID, Operation: "GetCallerIdentity", Region: "us-west-2", StatusCode: http.StatusOK, RequestID: "request-1"},                                    //lintignore:AWSAT003
+		{Service: secretsmanager.ServiceID, Operation: "GetSecretValue", Region: "us-west-2", StatusCode: http.StatusOK, RequestID: "request-1"},                            //lintignore:AWSAT003
+		{Service: ssm.ServiceID, Operation: "PutParameter", Region: "us-west-2", StatusCode: http.StatusOK, RequestID: "request-1"},                                         //lintignore:AWSAT003
+		{Service: ssm.ServiceID, Operation: "GetParameter", Region: "us-west-2", StatusCode: http.StatusBadRequest, RequestID: "request-1", ErrorCode: "ParameterNotFound"}, //lintignore:AWSAT003
+	}
+
+	for i, line := range lines {
+		var got requestTraceRecord
+
+		if err := json.Unmarshal([]byte(line), &got); err != nil {
+			t.Fatalf("error decoding record %d: %s", i, err)
+		}
+
+		if got.Service != expected[i].Service || got.Operation != expected[i].Operation || got.Region != expected[i].Region ||
+			got.StatusCode != expected[i].StatusCode || got.RequestID != expected[i].RequestID || got.ErrorCode != expected[i].ErrorCode {
+			t.Errorf("record %d: got %s", i, line)
+		}
+	}
+}
+
+func TestConfigClientRequestTraceSDKLogging(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Amzn-Requestid", "request-1")
+		w.Write([]byte(`{"Name":"example","SecretString":"s3cr3t"}`)) //nolint:errcheck
+	}))
+	defer ts.Close()
+
+	// Isolate the test from any AWS shared configuration in the environment.
+	for _, k := range []string{"AWS_CA_BUNDLE", "AWS_CONFIG_FILE", "AWS_PROFILE", "AWS_SHARED_CREDENTIALS_FILE"} {
+		t.Setenv(k, "")
+	}
+
+	testCases := []struct {
+		Name             string
+		RequestTraceFile string
+		ExpectLogged     bool
+	}{
+		{
+			Name:         "no request trace file",
+			ExpectLogged: true,
+		},
+		{
+			Name:             "request trace file",
+			RequestTraceFile: filepath.Join(t.TempDir(), "trace.json"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := &Config{
+				AccessKey:               "AKID",
+				Endpoints:               map[string]string{SecretsManager: ts.URL},
+				Region:                  "us-west-2", //lintignore:AWSAT003
+				RequestTraceFile:        testCase.RequestTraceFile,
+				SecretKey:               "SECRET",
+				SkipCredsValidation:     true,
+				SkipGetEC2Platforms:     true,
+				SkipMetadataApiCheck:    true,
+				SkipRequestingAccountId: true,
+			}
+
+			var buf bytes.Buffer
+			writer := log.Writer()
+			log.SetOutput(&buf)
+			defer log.SetOutput(writer)
+			defer CloseRequestTraceFiles()
+
+			v, err := config.Client()
+
+			if err != nil {
+				t.Fatalf("error configuring client: %s", err)
+			}
+
+			if _, err := v.(*AWSClient).SecretsManagerConn().GetSecretValue(&secretsmanager.GetSecretValueInput{SecretId: aws.String("example")}); err != nil {
+				t.Fatalf("error calling GetSecretValue: %s", err)
+			}
+
+			if got := strings.Contains(buf.String(), "s3cr3t"); got != testCase.ExpectLogged {
+				t.Errorf("secret value logged: got %t, expected %t", got, testCase.ExpectLogged)
+			}
+
+			if testCase.RequestTraceFile == "" {
+				return
+			}
+
+			CloseRequestTraceFiles()
+
+			b, err := os.ReadFile(testCase.RequestTraceFile)
+
+			if err != nil {
+				t.Fatalf("error reading request trace file: %s", err)
+			}
+
+			if !strings.Contains(string(b), "GetSecretValue") || strings.Contains(string(b), "s3cr3t") {
+				t.Errorf("unexpected request trace: %s", b)
+			}
+		})
+	}
+}
+
+func TestCloseRequestTraceFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.json")
+
+	file, err := openRequestTraceFile(path)
+
+	if err != nil {
+		t.Fatalf("error opening request trace file: %s", err)
+	}
+
+	if other, err := openRequestTraceFile(path); err != nil || other != file {
+		t.Fatalf("expected the open request trace file to be shared, got %v, %v", other, err)
+	}
+
+	CloseRequestTraceFiles()
+
+	if _, err := file.Write([]byte("{}\n")); err == nil {
+		t.Error("expected request trace file to be closed")
+	}
+
+	if len(requestTraceFilesByPath) != 0 {
+		t.Errorf("expected no open request trace files, got %d", len(requestTraceFilesByPath))
+	}
+}
@@ -20,6 +20,7 @@ const filename = `tags_gen.go`
 var (
 	getTag             = flag.Bool("GetTag", false, "whether to generate GetTag")
 	listTags           = flag.Bool("ListTags", false, "whether to generate ListTags")
+	listResourcesTags  = flag.Bool("ListResourcesTags", false, "whether to generate ListResourcesTags")
 	serviceTagsMap     = flag.Bool("ServiceTagsMap", false, "whether to generate service tags for map")
 	serviceTagsSlice   = flag.Bool("ServiceTagsSlice", false, "whether to generate service tags for slice")
 	untagInNeedTagType = flag.Bool("UntagInNeedTagType", false, "whether Untag input needs tag type")
@@ -29,6 +30,9 @@ var (
 	listTagsInIDElem      = flag.String("ListTagsInIDElem", "ResourceArn", "listTagsInIDElem")
 	listTagsInIDNeedSlice = flag.String("ListTagsInIDNeedSlice", "", "listTagsInIDNeedSlice")
 	listTagsOp            = flag.String("ListTagsOp", "ListTagsForResource", "listTagsOp")
+	listTagsOpBatchSize   = flag.String("ListTagsOpBatchSize", "", "listTagsOpBatchSize")
+	listTagsOutIDElem     = flag.String("ListTagsOutIDElem", "ResourceARN", "listTagsOutIDElem")
+	listTagsOutResElem    = flag.String("ListTagsOutResElem", "", "listTagsOutResElem")
 	listTagsOutTagsElem   = flag.String("ListTagsOutTagsElem", "Tags", "listTagsOutTagsElem")
 	tagInCustomVal        = flag.String("TagInCustomVal", "", "tagInCustomVal")
 	tagInIDElem           = flag.String("TagInIDElem", "ResourceArn", "tagInIDElem")
@@ -65,10 +69,14 @@ type TemplateData struct {
 	ClientType     string
 	ServicePackage string
 
+	ListResourcesTags       bool
 	ListTagsInFiltIDName    string
 	ListTagsInIDElem        string
 	ListTagsInIDNeedSlice   string
 	ListTagsOp              string
+	ListTagsOpBatchSize     string
+	ListTagsOutIDElem       string
+	ListTagsOutResElem      string
 	ListTagsOutTagsElem     string
 	ParentNotFoundErrCode   string
 	ParentNotFoundErrMsg    string
@@ -139,10 +147,14 @@ func main() {
 		StrConvPkg:      awsService == "autoscaling",
 		TfResourcePkg:   *getTag,
 
+		ListResourcesTags:       *listResourcesTags,
 		ListTagsInFiltIDName:    *listTagsInFiltIDName,
 		ListTagsInIDElem:        *listTagsInIDElem,
 		ListTagsInIDNeedSlice:   *listTagsInIDNeedSlice,
 		ListTagsOp:              *listTagsOp,
+		ListTagsOpBatchSize:     *listTagsOpBatchSize,
+		ListTagsOutIDElem:       *listTagsOutIDElem,
+		ListTagsOutResElem:      *listTagsOutResElem,
 		ListTagsOutTagsElem:     *listTagsOutTagsElem,
 		ParentNotFoundErrCode:   *parentNotFoundErrCode,
 		ParentNotFoundErrMsg:    *parentNotFoundErrMsg,
@@ -169,10 +181,10 @@ func main() {
 		UntagOp:                 *untagOp,
 	}
 
-	if *getTag || *listTags || *serviceTagsMap || *serviceTagsSlice || *updateTags {
+	if *getTag || *listTags || *listResourcesTags || *serviceTagsMap || *serviceTagsSlice || *updateTags {
 		// If you intend to only generate Tags and KeyValueTags helper methods,
 		// the corresponding aws-sdk-go	 service package does not need to be imported
-		if !*getTag && !*listTags && !*serviceTagsSlice && !*updateTags {
+		if !*getTag && !*listTags && !*listResourcesTags && !*serviceTagsSlice && !*updateTags {
 			templateData.AWSService = ""
 		}
 		writeTemplate(headerBody, "header", templateData)
@@ -186,6 +198,10 @@ func main() {
 		writeTemplate(listtagsBody, "listtags", templateData)
 	}
 
+	if *listResourcesTags {
+		writeTemplate(listresourcestagsBody, "listresourcestags", templateData)
+	}
+
 	if *serviceTagsMap {
 		writeTemplate(servicetagsmapBody, "servicetagsmap", templateData)
 	}
@@ -323,6 +339,15 @@ var listtagsBody = `
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
 func ListTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }}, resourceType string{{ end }}) (tftags.KeyValueTags, error) {
+	{{- if .ListResourcesTags }}
+	tags, err := ListResourcesTags(conn, []string{identifier})
+
+	if err != nil {
+		return tftags.New(nil), err
+	}
+
+	return tags[identifier], nil
+	{{- else }}
 	input := &{{ .TagPackage  }}.{{ .ListTagsOp }}Input{
 		{{- if .ListTagsInFiltIDName }}
 		Filters: []*{{ .AWSService  }}.Filter{
@@ -366,6 +391,55 @@ func ListTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }},
 	}
 
 	return KeyValueTags(output.{{ .ListTagsOutTagsElem }}{{ if .TagTypeIDElem }}, identifier{{ if .TagResTypeElem }}, resourceType{{ end }}{{ end }}), nil
+	{{- end }}
+}
+`
+
+var listresourcestagsBody = `
+// ListResourcesTags lists {{ .ServicePackage }} service tags for multiple resources.
+// Resources without tags are omitted from the result.
+func ListResourcesTags(conn {{ .ClientType }}, identifiers []string) (map[string]tftags.KeyValueTags, error) {
+	result := make(map[string]tftags.KeyValueTags)
+	{{ if .ListTagsOpBatchSize }}
+	for i := 0; i < len(identifiers); i += {{ .ListTagsOpBatchSize }} {
+		j := i + {{ .ListTagsOpBatchSize }}
+
+		if j > len(identifiers) {
+			j = len(identifiers)
+		}
+
+		input := &{{ .TagPackage }}.{{ .ListTagsOp }}Input{
+			{{ .ListTagsInIDElem }}: aws.StringSlice(identifiers[i:j]),
+		}
+	{{- else }}
+	{
+		input := &{{ .TagPackage }}.{{ .ListTagsOp }}Input{
+			{{ .ListTagsInIDElem }}: aws.StringSlice(identifiers),
+		}
+	{{- end }}
+
+		err := conn.{{ .ListTagsOp }}Pages(input, func(page *{{ .TagPackage }}.{{ .ListTagsOp }}Output, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, resource := range page.{{ .ListTagsOutResElem }} {
+				if resource == nil {
+					continue
+				}
+
+				result[aws.StringValue(resource.{{ .ListTagsOutIDElem }})] = KeyValueTags(resource.{{ .ListTagsOutTagsElem }})
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
 }
 `
 
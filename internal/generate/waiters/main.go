@@ -0,0 +1,898 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"golang.org/x/tools/go/packages"
+)
+
+var (
+	resourceName  = flag.String("Resource", "", "name of the resource, e.g. Cluster")
+	op            = flag.String("Op", "", "name of the AWS Go SDK operation that describes the resource, e.g. DescribeCluster")
+	idElem        = flag.String("IDElem", "", "name of the operation input element that identifies the resource, e.g. ClusterArn")
+	idName        = flag.String("IDName", "ID", "suffix of the finder function name, e.g. ARN for FindClusterByARN")
+	outputElem    = flag.String("OutputElem", "", "dot-separated path of the resource in the operation output, e.g. ClusterInfo; defaults to the output itself")
+	statusElem    = flag.String("StatusElem", "", "dot-separated path of the status in the resource, e.g. State")
+	notFoundCodes = flag.String("NotFoundCodes", "", "comma-separated error codes returned when the resource does not exist")
+	createPending = flag.String("CreatePending", "", "comma-separated pending states of the created waiter")
+	createTarget  = flag.String("CreateTarget", "", "comma-separated target states of the created waiter")
+	updatePending = flag.String("UpdatePending", "", "comma-separated pending states of the updated waiter")
+	updateTarget  = flag.String("UpdateTarget", "", "comma-separated target states of the updated waiter")
+	deletePending = flag.String("DeletePending", "", "comma-separated pending states of the deleted waiter")
+	deleteTarget  = flag.String("DeleteTarget", "", "comma-separated target states of the deleted waiter; defaults to the resource not being found")
+	withContext   = flag.Bool("Context", false, "whether the generated functions take a context.Context")
+	output        = flag.String("Output", "", "name of the generated file; defaults to <resource>_waiters_gen.go")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type TemplateData struct {
+	Parameters     string
+	ServicePackage string
+	SourcePackage  string
+
+	Context bool
+
+	Resource   string
+	IDName     string
+	ClientType string
+	Op         string
+	InputType  string
+	IDElem     string
+	IDValue    string
+
+	// NeedAWS is whether the generated code uses the aws package.
+	NeedAWS bool
+
+	NotFoundCodes []string
+
+	// OutputPath is the expression of the resource in the operation output, e.g. output.ClusterInfo.
+	OutputPath string
+	// OutputNilCheck is the condition under which the operation output contains no resource.
+	OutputNilCheck string
+	// OutputSlice is whether the operation output contains a list of resources.
+	OutputSlice bool
+	ResultType  string
+
+	// StatusNilChecks are the conditions under which the resource has no status.
+	StatusNilChecks []string
+	StatusValue     string
+
+	Waiters []Waiter
+}
+
+type Waiter struct {
+	Name    string
+	Pending []string
+	Target  []string
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *resourceName == "" || *op == "" || *idElem == "" || *statusElem == "" {
+		usage()
+		os.Exit(2)
+	}
+
+	wd, err := os.Getwd()
+
+	if err != nil {
+		log.Fatalf("unable to get working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+	awsService, err := awsServiceName(servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", awsService)
+	pkg := loadPackage(sourcePackage)
+
+	templateData, err := newTemplateData(pkg)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	templateData.Parameters = strings.Join(os.Args[1:], " ")
+	templateData.ServicePackage = servicePackage
+	templateData.SourcePackage = sourcePackage
+
+	filename := *output
+
+	if filename == "" {
+		filename = fmt.Sprintf("%s_waiters_gen.go", snakeCase(*resourceName))
+	}
+
+	if err := generateTemplateFile(filename, templateBody, templateData); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Package holds the declarations of an AWS Go SDK service package.
+type Package struct {
+	name string
+	// consts are the names of the package's exported constants.
+	consts map[string]bool
+	// methods are the package's methods by name.
+	methods map[string]*ast.FuncDecl
+	// types are the package's type declarations by name.
+	types map[string]*ast.TypeSpec
+}
+
+func loadPackage(sourcePackage string) *Package {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedSyntax,
+	}
+	pkgs, err := packages.Load(cfg, sourcePackage)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(pkgs) != 1 {
+		log.Fatalf("error: %d packages found", len(pkgs))
+	}
+
+	pkg := &Package{
+		name:    pkgs[0].Name,
+		consts:  make(map[string]bool),
+		methods: make(map[string]*ast.FuncDecl),
+		types:   make(map[string]*ast.TypeSpec),
+	}
+
+	for _, file := range pkgs[0].Syntax {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv != nil {
+					pkg.methods[decl.Name.Name] = decl
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						pkg.types[spec.Name.Name] = spec
+					case *ast.ValueSpec:
+						if decl.Tok == token.CONST {
+							for _, name := range spec.Names {
+								if name.IsExported() {
+									pkg.consts[name.Name] = true
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return pkg
+}
+
+// newTemplateData resolves the flags against the declarations of the AWS Go SDK service package.
+func newTemplateData(pkg *Package) (*TemplateData, error) {
+	method, ok := pkg.methods[*op]
+
+	if !ok {
+		return nil, fmt.Errorf("operation %s not found in package %s", *op, pkg.name)
+	}
+
+	if method.Type.Params.NumFields() != 1 || method.Type.Results.NumFields() != 2 {
+		return nil, fmt.Errorf("unexpected signature for %s", *op)
+	}
+
+	clientType := method.Recv.List[0].Type
+	inputType := method.Type.Params.List[0].Type
+	outputType := method.Type.Results.List[0].Type
+
+	idField, err := pkg.fieldType(inputType, *idElem)
+
+	if err != nil {
+		return nil, fmt.Errorf("input element %s: %w", *idElem, err)
+	}
+
+	var idValue string
+	var needAWS bool
+
+	switch pkg.typeString(idField) {
+	case "string":
+		idValue = "id"
+	case "*string":
+		idValue = "aws.String(id)"
+		needAWS = true
+	case "[]*string":
+		idValue = "aws.StringSlice([]string{id})"
+		needAWS = true
+	default:
+		return nil, fmt.Errorf("unsupported input element %s type: %s", *idElem, pkg.typeString(idField))
+	}
+
+	data := &TemplateData{
+		Context:    *withContext,
+		Resource:   *resourceName,
+		IDName:     *idName,
+		ClientType: pkg.typeString(clientType),
+		Op:         *op,
+		InputType:  strings.TrimPrefix(pkg.typeString(inputType), "*"),
+		IDElem:     *idElem,
+		IDValue:    idValue,
+		NeedAWS:    needAWS,
+		OutputPath: "output",
+	}
+
+	for _, code := range splitList(*notFoundCodes) {
+		data.NotFoundCodes = append(data.NotFoundCodes, pkg.constantOrLiteral(code))
+	}
+
+	nilChecks := []string{"output == nil"}
+	resultType := outputType
+
+	for _, elem := range splitPath(*outputElem) {
+		if data.OutputSlice {
+			return nil, fmt.Errorf("output element %s: path continues beyond a list", *outputElem)
+		}
+
+		if resultType, err = pkg.fieldType(resultType, elem); err != nil {
+			return nil, fmt.Errorf("output element %s: %w", *outputElem, err)
+		}
+
+		data.OutputPath = fmt.Sprintf("%s.%s", data.OutputPath, elem)
+
+		if slice, ok := resultType.(*ast.ArrayType); ok {
+			data.OutputSlice = true
+			resultType = slice.Elt
+			nilChecks = append(nilChecks, fmt.Sprintf("len(%[1]s) == 0 || %[1]s[0] == nil", data.OutputPath))
+			continue
+		}
+
+		if _, ok := resultType.(*ast.StarExpr); !ok {
+			return nil, fmt.Errorf("output element %s: unsupported type: %s", *outputElem, pkg.typeString(resultType))
+		}
+
+		nilChecks = append(nilChecks, fmt.Sprintf("%s == nil", data.OutputPath))
+	}
+
+	data.OutputNilCheck = strings.Join(nilChecks, " || ")
+	data.ResultType = pkg.typeString(resultType)
+
+	statusPath := "output"
+	statusType := resultType
+	statusElems := splitPath(*statusElem)
+
+	for i, elem := range statusElems {
+		if statusType, err = pkg.fieldType(statusType, elem); err != nil {
+			return nil, fmt.Errorf("status element %s: %w", *statusElem, err)
+		}
+
+		statusPath = fmt.Sprintf("%s.%s", statusPath, elem)
+
+		if _, ok := statusType.(*ast.StarExpr); ok && i < len(statusElems)-1 {
+			data.StatusNilChecks = append(data.StatusNilChecks, fmt.Sprintf("%s == nil", statusPath))
+		}
+	}
+
+	switch pkg.typeString(statusType) {
+	case "string":
+		data.StatusValue = statusPath
+	case "*string":
+		data.StatusValue = fmt.Sprintf("aws.StringValue(%s)", statusPath)
+		data.NeedAWS = true
+	default:
+		return nil, fmt.Errorf("unsupported status element %s type: %s", *statusElem, pkg.typeString(statusType))
+	}
+
+	for _, w := range []struct {
+		name            string
+		pending, target string
+	}{
+		{"Created", *createPending, *createTarget},
+		{"Updated", *updatePending, *updateTarget},
+		{"Deleted", *deletePending, *deleteTarget},
+	} {
+		if w.pending == "" && w.target == "" {
+			continue
+		}
+
+		if w.target == "" && w.name != "Deleted" {
+			return nil, fmt.Errorf("%s waiter: target states are required", strings.ToLower(w.name))
+		}
+
+		waiter := Waiter{
+			Name:    w.name,
+			Pending: []string{},
+			Target:  []string{},
+		}
+
+		for _, state := range splitList(w.pending) {
+			waiter.Pending = append(waiter.Pending, pkg.constantOrLiteral(state))
+		}
+
+		for _, state := range splitList(w.target) {
+			waiter.Target = append(waiter.Target, pkg.constantOrLiteral(state))
+		}
+
+		data.Waiters = append(data.Waiters, waiter)
+	}
+
+	return data, nil
+}
+
+// fieldType returns the type of the named field of a struct or pointer to struct declared in the package.
+func (p *Package) fieldType(expr ast.Expr, name string) (ast.Expr, error) {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	ident, ok := expr.(*ast.Ident)
+
+	if !ok {
+		return nil, fmt.Errorf("%s is not a struct", p.typeString(expr))
+	}
+
+	spec, ok := p.types[ident.Name]
+
+	if !ok {
+		return nil, fmt.Errorf("%s is not a struct", ident.Name)
+	}
+
+	s, ok := spec.Type.(*ast.StructType)
+
+	if !ok {
+		return nil, fmt.Errorf("%s is not a struct", ident.Name)
+	}
+
+	for _, field := range s.Fields.List {
+		for _, fieldName := range field.Names {
+			if fieldName.Name == name {
+				return field.Type, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("field %s not found in %s", name, ident.Name)
+}
+
+// typeString returns the type expression, qualifying types declared in the package.
+func (p *Package) typeString(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		if _, ok := p.types[expr.Name]; ok {
+			return fmt.Sprintf("%s.%s", p.name, expr.Name)
+		}
+
+		return expr.Name
+	case *ast.StarExpr:
+		return fmt.Sprintf("*%s", p.typeString(expr.X))
+	case *ast.ArrayType:
+		return fmt.Sprintf("[]%s", p.typeString(expr.Elt))
+	case *ast.MapType:
+		return fmt.Sprintf("map[%s]%s", p.typeString(expr.Key), p.typeString(expr.Value))
+	default:
+		return fmt.Sprintf("%T", expr)
+	}
+}
+
+// constantOrLiteral returns a reference to the AWS Go SDK constant with the specified name
+// or, if the package has no such constant, the value as a string literal.
+func (p *Package) constantOrLiteral(v string) string {
+	if p.consts[v] {
+		return fmt.Sprintf("%s.%s", p.name, v)
+	}
+
+	return strconv.Quote(v)
+}
+
+func splitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, ",")
+}
+
+func splitPath(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, ".")
+}
+
+func snakeCase(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// Start a new word at an upper case letter following a lower case letter,
+			// or at the last upper case letter of an initialism, e.g. VPCEndpoint.
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+				b.WriteRune('_')
+			}
+
+			r = unicode.ToLower(r)
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+func generateTemplateFile(filename string, templateBody string, templateData interface{}) error {
+	tmpl, err := template.New(filename).Parse(templateBody)
+
+	if err != nil {
+		return fmt.Errorf("error parsing template: %w", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tmpl.Execute(&buffer, templateData)
+
+	if err != nil {
+		return fmt.Errorf("error executing template: %w", err)
+	}
+
+	generatedFileContents, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		return fmt.Errorf("error formatting generated file: %w", err)
+	}
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		return fmt.Errorf("error creating file (%s): %w", filename, err)
+	}
+
+	defer f.Close()
+
+	_, err = f.Write(generatedFileContents)
+
+	if err != nil {
+		return fmt.Errorf("error writing to file (%s): %w", filename, err)
+	}
+
+	return nil
+}
+
+const templateBody = `
+// Code generated by "internal/generate/waiters/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .ServicePackage }}
+
+import (
+{{- if .Context }}
+	"context"
+{{- end }}
+{{- if .Waiters }}
+	"time"
+{{- end }}
+{{- if or .Context .Waiters }}{{ "\n" }}{{ end }}
+{{- if .NeedAWS }}
+	"github.com/aws/aws-sdk-go/aws"
+{{- end }}
+	"{{ .SourcePackage }}"
+	{{- if .NotFoundCodes }}
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	{{- end }}
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func Find{{ .Resource }}By{{ .IDName }}({{ if .Context }}ctx context.Context, {{ end }}conn {{ .ClientType }}, id string) ({{ .ResultType }}, error) {
+	input := &{{ .InputType }}{
+		{{ .IDElem }}: {{ .IDValue }},
+	}
+
+	output, err := conn.{{ .Op }}{{ if .Context }}WithContext(ctx, input){{ else }}(input){{ end }}
+{{ if .NotFoundCodes }}
+	if tfawserr.ErrCodeEquals(err, {{ range $i, $code := .NotFoundCodes }}{{ if $i }}, {{ end }}{{ $code }}{{ end }}) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+{{ end }}
+	if err != nil {
+		return nil, err
+	}
+
+	if {{ .OutputNilCheck }} {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+{{ if .OutputSlice }}
+	if count := len({{ .OutputPath }}); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return {{ .OutputPath }}[0], nil
+{{- else }}
+	return {{ .OutputPath }}, nil
+{{- end }}
+}
+
+func status{{ .Resource }}({{ if .Context }}ctx context.Context, {{ end }}conn {{ .ClientType }}, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := Find{{ .Resource }}By{{ .IDName }}({{ if .Context }}ctx, {{ end }}conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+{{ range .StatusNilChecks }}
+		if {{ . }} {
+			return output, "", nil
+		}
+{{ end }}
+		return output, {{ .StatusValue }}, nil
+	}
+}
+{{- $data := . }}
+{{- range .Waiters }}
+
+func wait{{ $data.Resource }}{{ .Name }}({{ if $data.Context }}ctx context.Context, {{ end }}conn {{ $data.ClientType }}, id string, timeout time.Duration) ({{ $data.ResultType }}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ {{- range $i, $state := .Pending }}{{ if $i }}, {{ end }}{{ $state }}{{ end -}} },
+		Target:  []string{ {{- range $i, $state := .Target }}{{ if $i }}, {{ end }}{{ $state }}{{ end -}} },
+		Refresh: status{{ $data.Resource }}({{ if $data.Context }}ctx, {{ end }}conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.{{ if $data.Context }}WaitForStateContext(ctx){{ else }}WaitForState(){{ end }}
+
+	if output, ok := outputRaw.({{ $data.ResultType }}); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+{{- end }}
+`
+
+func awsServiceName(s string) (string, error) {
+	s = strings.ToLower(s)
+
+	if _, ok := awsServiceNames[s]; ok {
+		return s, nil
+	}
+
+	switch s {
+	case "amp":
+		return "prometheusservice", nil
+	case "cloudcontrol":
+		return "cloudcontrolapi", nil
+	case "cognitoidp":
+		return "cognitoidentityprovider", nil
+	case "dms":
+		return "databasemigrationservice", nil
+	case "ds":
+		return "directoryservice", nil
+	case "events":
+		return "eventbridge", nil
+	case "lexmodels":
+		return "lexmodelbuildingservice", nil
+	case "serverlessrepo":
+		return "serverlessapplicationrepository", nil
+	}
+
+	if _, ok := awsServiceNames[fmt.Sprintf("%sservice", s)]; ok {
+		return fmt.Sprintf("%sservice", s), nil
+	}
+
+	return "", fmt.Errorf("unable to find AWS service name for %s", s)
+}
+
+var awsServiceNames map[string]string
+
+func init() {
+	awsServiceNames = make(map[string]string)
+
+	awsServiceNames["accessanalyzer"] = "AccessAnalyzer"
+	awsServiceNames["acm"] = "ACM"
+	awsServiceNames["acmpca"] = "ACMPCA"
+	awsServiceNames["alexaforbusiness"] = "AlexaForBusiness"
+	awsServiceNames["amplify"] = "Amplify"
+	awsServiceNames["amplifybackend"] = "AmplifyBackend"
+	awsServiceNames["apigateway"] = "APIGateway"
+	awsServiceNames["apigatewaymanagement"] = "APIGatewayManagement"
+	awsServiceNames["apigatewayv2"] = "APIGatewayV2"
+	awsServiceNames["appconfig"] = "AppConfig"
+	awsServiceNames["appflow"] = "AppFlow"
+	awsServiceNames["appintegrations"] = "AppIntegrations"
+	awsServiceNames["applicationautoscaling"] = "ApplicationAutoScaling"
+	awsServiceNames["applicationcostprofiler"] = "ApplicationCostProfiler"
+	awsServiceNames["applicationdiscovery"] = "ApplicationDiscovery"
+	awsServiceNames["applicationinsights"] = "ApplicationInsights"
+	awsServiceNames["appmesh"] = "AppMesh"
+	awsServiceNames["appregistry"] = "AppRegistry"
+	awsServiceNames["apprunner"] = "AppRunner"
+	awsServiceNames["appstream"] = "AppStream"
+	awsServiceNames["appsync"] = "AppSync"
+	awsServiceNames["athena"] = "Athena"
+	awsServiceNames["auditmanager"] = "AuditManager"
+	awsServiceNames["augmentedairuntime"] = "AugmentedAiruntime"
+	awsServiceNames["autoscaling"] = "AutoScaling"
+	awsServiceNames["autoscalingplans"] = "AutoScalingPlans"
+	awsServiceNames["backup"] = "Backup"
+	awsServiceNames["batch"] = "Batch"
+	awsServiceNames["braket"] = "Braket"
+	awsServiceNames["budgets"] = "Budgets"
+	awsServiceNames["chime"] = "Chime"
+	awsServiceNames["cloud9"] = "Cloud9"
+	awsServiceNames["cloudcontrolapi"] = "CloudControlApi"
+	awsServiceNames["clouddirectory"] = "CloudDirectory"
+	awsServiceNames["cloudformation"] = "CloudFormation"
+	awsServiceNames["cloudfront"] = "CloudFront"
+	awsServiceNames["cloudhsm"] = "CloudHSM"
+	awsServiceNames["cloudhsmv2"] = "CloudHSMV2"
+	awsServiceNames["cloudsearch"] = "CloudSearch"
+	awsServiceNames["cloudsearchdomain"] = "CloudSearchDomain"
+	awsServiceNames["cloudtrail"] = "CloudTrail"
+	awsServiceNames["cloudwatch"] = "CloudWatch"
+	awsServiceNames["cloudwatchlogs"] = "CloudWatchLogs"
+	awsServiceNames["codeartifact"] = "CodeArtifact"
+	awsServiceNames["codebuild"] = "CodeBuild"
+	awsServiceNames["codecommit"] = "CodeCommit"
+	awsServiceNames["codedeploy"] = "CodeDeploy"
+	awsServiceNames["codeguruprofiler"] = "CodeGuruProfiler"
+	awsServiceNames["codegurureviewer"] = "CodeGuruReviewer"
+	awsServiceNames["codepipeline"] = "CodePipeline"
+	awsServiceNames["codestar"] = "CodeStar"
+	awsServiceNames["codestarconnections"] = "CodeStarConnections"
+	awsServiceNames["codestarnotifications"] = "CodeStarNotifications"
+	awsServiceNames["cognitoidentity"] = "CognitoIdentity"
+	awsServiceNames["cognitoidentityprovider"] = "CognitoIdentityProvider"
+	awsServiceNames["cognitosync"] = "CognitoSync"
+	awsServiceNames["comprehend"] = "Comprehend"
+	awsServiceNames["comprehendmedical"] = "ComprehendMedical"
+	awsServiceNames["computeoptimizer"] = "ComputeOptimizer"
+	awsServiceNames["configservice"] = "ConfigService"
+	awsServiceNames["connect"] = "Connect"
+	awsServiceNames["connectcontactlens"] = "ConnectContactLens"
+	awsServiceNames["connectparticipant"] = "ConnectParticipant"
+	awsServiceNames["costexplorer"] = "CostExplorer"
+	awsServiceNames["cur"] = "CUR"
+	awsServiceNames["customerprofiles"] = "CustomerProfiles"
+	awsServiceNames["databasemigrationservice"] = "DatabaseMigrationService"
+	awsServiceNames["dataexchange"] = "DataExchange"
+	awsServiceNames["datapipeline"] = "DataPipeline"
+	awsServiceNames["datasync"] = "DataSync"
+	awsServiceNames["dax"] = "DAX"
+	awsServiceNames["detective"] = "Detective"
+	awsServiceNames["devicefarm"] = "DeviceFarm"
+	awsServiceNames["devopsguru"] = "DevOpsGuru"
+	awsServiceNames["directconnect"] = "DirectConnect"
+	awsServiceNames["directoryservice"] = "DirectoryService"
+	awsServiceNames["dlm"] = "DLM"
+	awsServiceNames["docdb"] = "DocDB"
+	awsServiceNames["dynamodb"] = "DynamoDB"
+	awsServiceNames["dynamodbattribute"] = "DynamoDBAttribute"
+	awsServiceNames["dynamodbstreams"] = "DynamoDBStreams"
+	awsServiceNames["ec2"] = "EC2"
+	awsServiceNames["ec2instanceconnect"] = "EC2InstanceConnect"
+	awsServiceNames["ecr"] = "ECR"
+	awsServiceNames["ecrpublic"] = "ECRPublic"
+	awsServiceNames["ecs"] = "ECS"
+	awsServiceNames["efs"] = "EFS"
+	awsServiceNames["eks"] = "EKS"
+	awsServiceNames["elasticache"] = "ElastiCache"
+	awsServiceNames["elasticbeanstalk"] = "ElasticBeanstalk"
+	awsServiceNames["elasticinference"] = "ElasticInference"
+	awsServiceNames["elasticsearchservice"] = "ElasticsearchService"
+	awsServiceNames["elastictranscoder"] = "ElasticTranscoder"
+	awsServiceNames["elb"] = "ELB"
+	awsServiceNames["elbv2"] = "ELBV2"
+	awsServiceNames["emr"] = "EMR"
+	awsServiceNames["emrcontainers"] = "EMRContainers"
+	awsServiceNames["eventbridge"] = "EventBridge"
+	awsServiceNames["expression"] = "Expression"
+	awsServiceNames["finspace"] = "FinSpace"
+	awsServiceNames["finspacedata"] = "FinSpaceData"
+	awsServiceNames["firehose"] = "Firehose"
+	awsServiceNames["fis"] = "FIS"
+	awsServiceNames["fms"] = "FMS"
+	awsServiceNames["forecast"] = "Forecast"
+	awsServiceNames["forecastquery"] = "ForecastQuery"
+	awsServiceNames["frauddetector"] = "FraudDetector"
+	awsServiceNames["fsx"] = "FSx"
+	awsServiceNames["gamelift"] = "GameLift"
+	awsServiceNames["glacier"] = "Glacier"
+	awsServiceNames["globalaccelerator"] = "GlobalAccelerator"
+	awsServiceNames["glue"] = "Glue"
+	awsServiceNames["gluedatabrew"] = "GlueDataBrew"
+	awsServiceNames["greengrass"] = "Greengrass"
+	awsServiceNames["greengrassv2"] = "GreengrassV2"
+	awsServiceNames["groundstation"] = "GroundStation"
+	awsServiceNames["guardduty"] = "GuardDuty"
+	awsServiceNames["health"] = "Health"
+	awsServiceNames["healthlake"] = "HealthLake"
+	awsServiceNames["honeycode"] = "HoneyCode"
+	awsServiceNames["iam"] = "IAM"
+	awsServiceNames["identitystore"] = "IdentityStore"
+	awsServiceNames["imagebuilder"] = "ImageBuilder"
+	awsServiceNames["imagebuilder"] = "Imagebuilder"
+	awsServiceNames["inspector"] = "Inspector"
+	awsServiceNames["iot"] = "IoT"
+	awsServiceNames["iot1clickdevices"] = "IoT1ClickDevices"
+	awsServiceNames["iot1clickprojects"] = "IoT1ClickProjects"
+	awsServiceNames["iotanalytics"] = "IoTAnalytics"
+	awsServiceNames["iotdataplane"] = "IoTDataPlane"
+	awsServiceNames["iotdeviceadvisor"] = "IoTDeviceAdvisor"
+	awsServiceNames["iotevents"] = "IoTEvents"
+	awsServiceNames["ioteventsdata"] = "IoTEventsData"
+	awsServiceNames["iotfleethub"] = "IoTFleetHub"
+	awsServiceNames["iotjobsdataplane"] = "IoTJobsDataPlane"
+	awsServiceNames["iotsecuretunneling"] = "IoTSecureTunneling"
+	awsServiceNames["iotsitewise"] = "IoTSiteWise"
+	awsServiceNames["iotthingsgraph"] = "IoTThingsGraph"
+	awsServiceNames["iotwireless"] = "IoTWireless"
+	awsServiceNames["ivs"] = "IVS"
+	awsServiceNames["kafka"] = "Kafka"
+	awsServiceNames["kendra"] = "Kendra"
+	awsServiceNames["kinesis"] = "Kinesis"
+	awsServiceNames["kinesisanalytics"] = "KinesisAnalytics"
+	awsServiceNames["kinesisanalyticsv2"] = "KinesisAnalyticsV2"
+	awsServiceNames["kinesisvideo"] = "KinesisVideo"
+	awsServiceNames["kinesisvideoarchivedmedia"] = "KinesisVideoArchivedMedia"
+	awsServiceNames["kinesisvideomedia"] = "KinesisVideoMedia"
+	awsServiceNames["kinesisvideosignalingchannels"] = "KinesisVideoSignalingChannels"
+	awsServiceNames["kms"] = "KMS"
+	awsServiceNames["lakeformation"] = "LakeFormation"
+	awsServiceNames["lambda"] = "Lambda"
+	awsServiceNames["lexmodelbuildingservice"] = "LexModelBuildingService"
+	awsServiceNames["lexmodelsv2"] = "LexModelsV2"
+	awsServiceNames["lexruntime"] = "LexRuntime"
+	awsServiceNames["lexruntimev2"] = "LexRuntimeV2"
+	awsServiceNames["licensemanager"] = "LicenseManager"
+	awsServiceNames["lightsail"] = "Lightsail"
+	awsServiceNames["location"] = "Location"
+	awsServiceNames["lookoutequipment"] = "LookoutEquipment"
+	awsServiceNames["lookoutforvision"] = "LookoutForVision"
+	awsServiceNames["lookoutmetrics"] = "LookoutMetrics"
+	awsServiceNames["machinelearning"] = "MachineLearning"
+	awsServiceNames["macie"] = "Macie"
+	awsServiceNames["macie2"] = "Macie2"
+	awsServiceNames["managedblockchain"] = "ManagedBlockchain"
+	awsServiceNames["marketplacecatalog"] = "MarketplaceCatalog"
+	awsServiceNames["marketplacecommerceanalytics"] = "MarketplaceCommerceAnalytics"
+	awsServiceNames["marketplaceentitlement"] = "MarketplaceEntitlement"
+	awsServiceNames["marketplacemetering"] = "MarketplaceMetering"
+	awsServiceNames["mediaconnect"] = "MediaConnect"
+	awsServiceNames["mediaconvert"] = "MediaConvert"
+	awsServiceNames["medialive"] = "MediaLive"
+	awsServiceNames["mediapackage"] = "MediaPackage"
+	awsServiceNames["mediapackagevod"] = "MediaPackageVOD"
+	awsServiceNames["mediastore"] = "MediaStore"
+	awsServiceNames["mediastoredata"] = "MediaStoreData"
+	awsServiceNames["mediatailor"] = "MediaTailor"
+	awsServiceNames["memorydb"] = "MemoryDB"
+	awsServiceNames["mgn"] = "Mgn"
+	awsServiceNames["migrationhub"] = "MigrationHub"
+	awsServiceNames["migrationhubconfig"] = "MigrationHubConfig"
+	awsServiceNames["mobile"] = "Mobile"
+	awsServiceNames["mobileanalytics"] = "MobileAnalytics"
+	awsServiceNames["mq"] = "MQ"
+	awsServiceNames["mturk"] = "MTurk"
+	awsServiceNames["mwaa"] = "MWAA"
+	awsServiceNames["neptune"] = "Neptune"
+	awsServiceNames["networkfirewall"] = "NetworkFirewall"
+	awsServiceNames["networkmanager"] = "NetworkManager"
+	awsServiceNames["nimblestudio"] = "NimbleStudio"
+	awsServiceNames["opsworks"] = "OpsWorks"
+	awsServiceNames["opsworkscm"] = "OpsWorksCM"
+	awsServiceNames["organizations"] = "Organizations"
+	awsServiceNames["outposts"] = "Outposts"
+	awsServiceNames["personalize"] = "Personalize"
+	awsServiceNames["personalizeevents"] = "PersonalizeEvents"
+	awsServiceNames["personalizeruntime"] = "PersonalizeRuntime"
+	awsServiceNames["pi"] = "PI"
+	awsServiceNames["pinpoint"] = "Pinpoint"
+	awsServiceNames["pinpointemail"] = "PinpointEmail"
+	awsServiceNames["pinpointsmsvoice"] = "PinpointSMSVoice"
+	awsServiceNames["polly"] = "Polly"
+	awsServiceNames["pricing"] = "Pricing"
+	awsServiceNames["prometheusservice"] = "PrometheusService"
+	awsServiceNames["proton"] = "Proton"
+	awsServiceNames["qldb"] = "QLDB"
+	awsServiceNames["qldbsession"] = "QLDBSession"
+	awsServiceNames["quicksight"] = "QuickSight"
+	awsServiceNames["ram"] = "RAM"
+	awsServiceNames["rds"] = "RDS"
+	awsServiceNames["rdsdata"] = "RDSData"
+	awsServiceNames["rdsutils"] = "RDSUtils"
+	awsServiceNames["redshift"] = "Redshift"
+	awsServiceNames["redshiftdata"] = "RedshiftData"
+	awsServiceNames["rekognition"] = "Rekognition"
+	awsServiceNames["resourcegroups"] = "ResourceGroups"
+	awsServiceNames["resourcegroupstaggingapi"] = "ResourceGroupsTaggingAPI"
+	awsServiceNames["robomaker"] = "RoboMaker"
+	awsServiceNames["route53"] = "Route53"
+	awsServiceNames["route53domains"] = "Route53Domains"
+	awsServiceNames["route53recoverycontrolconfig"] = "Route53RecoveryControlConfig"
+	awsServiceNames["route53recoveryreadiness"] = "Route53RecoveryReadiness"
+	awsServiceNames["route53resolver"] = "Route53Resolver"
+	awsServiceNames["s3"] = "S3"
+	awsServiceNames["s3control"] = "S3Control"
+	awsServiceNames["s3crypto"] = "S3Crypto"
+	awsServiceNames["s3manager"] = "S3Manager"
+	awsServiceNames["s3outposts"] = "S3Outposts"
+	awsServiceNames["sagemaker"] = "SageMaker"
+	awsServiceNames["sagemakeredgemanager"] = "SageMakerEdgeManager"
+	awsServiceNames["sagemakerfeaturestoreruntime"] = "SageMakerFeatureStoreRuntime"
+	awsServiceNames["sagemakerruntime"] = "SageMakerRuntime"
+	awsServiceNames["savingsplans"] = "SavingsPlans"
+	awsServiceNames["schemas"] = "Schemas"
+	awsServiceNames["secretsmanager"] = "SecretsManager"
+	awsServiceNames["securityhub"] = "SecurityHub"
+	awsServiceNames["serverlessapplicationrepository"] = "ServerlessApplicationRepository"
+	awsServiceNames["servicecatalog"] = "ServiceCatalog"
+	awsServiceNames["servicediscovery"] = "ServiceDiscovery"
+	awsServiceNames["servicequotas"] = "ServiceQuotas"
+	awsServiceNames["ses"] = "SES"
+	awsServiceNames["sesv2"] = "SESV2"
+	awsServiceNames["sfn"] = "SFN"
+	awsServiceNames["shield"] = "Shield"
+	awsServiceNames["sign"] = "Sign"
+	awsServiceNames["signer"] = "Signer"
+	awsServiceNames["simpledb"] = "SimpleDB"
+	awsServiceNames["sms"] = "SMS"
+	awsServiceNames["snowball"] = "Snowball"
+	awsServiceNames["sns"] = "SNS"
+	awsServiceNames["sqs"] = "SQS"
+	awsServiceNames["ssm"] = "SSM"
+	awsServiceNames["ssmcontacts"] = "SSMContacts"
+	awsServiceNames["ssmincidents"] = "SSMIncidents"
+	awsServiceNames["sso"] = "SSO"
+	awsServiceNames["ssoadmin"] = "SSOAdmin"
+	awsServiceNames["ssooidc"] = "SSOOIDC"
+	awsServiceNames["storagegateway"] = "StorageGateway"
+	awsServiceNames["sts"] = "STS"
+	awsServiceNames["support"] = "Support"
+	awsServiceNames["swf"] = "SWF"
+	awsServiceNames["synthetics"] = "Synthetics"
+	awsServiceNames["textract"] = "Textract"
+	awsServiceNames["timestreamquery"] = "TimestreamQuery"
+	awsServiceNames["timestreamwrite"] = "TimestreamWrite"
+	awsServiceNames["transcribe"] = "Transcribe"
+	awsServiceNames["transcribestreaming"] = "TranscribeStreaming"
+	awsServiceNames["transfer"] = "Transfer"
+	awsServiceNames["translate"] = "Translate"
+	awsServiceNames["waf"] = "WAF"
+	awsServiceNames["wafregional"] = "WAFRegional"
+	awsServiceNames["wafv2"] = "WAFV2"
+	awsServiceNames["wellarchitected"] = "WellArchitected"
+	awsServiceNames["workdocs"] = "WorkDocs"
+	awsServiceNames["worklink"] = "WorkLink"
+	awsServiceNames["workmail"] = "WorkMail"
+	awsServiceNames["workmailmessageflow"] = "WorkMailMessageFlow"
+	awsServiceNames["workspaces"] = "WorkSpaces"
+	awsServiceNames["xray"] = "XRay"
+}
@@ -1,15 +1,20 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/account"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/acm"
@@ -188,6 +193,47 @@ func Provider() *schema.Provider {
 				ConflictsWith: []string{"forbidden_account_ids"},
 				Set:           schema.HashString,
 			},
+			"allowed_organization_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "AWS Organization IDs the caller's account must be a member of.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^o-[a-z0-9]{10,32}$`), "must be an AWS Organization ID"),
+				},
+				Set: schema.HashString,
+			},
+			"allowed_organizational_units": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "AWS Organizations organizational unit or root IDs the caller's account must be contained in, directly or indirectly.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(ou-[a-z0-9]{4,32}-[a-z0-9]{8,32}|r-[a-z0-9]{4,32})$`), "must be an AWS Organizations organizational unit or root ID"),
+				},
+				Set: schema.HashString,
+			},
+			"allowed_partitions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "AWS partitions, e.g. `aws`, the caller's account may be in.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+			"allowed_regions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "AWS regions the provider may be configured with.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+			"api_metrics_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TF_AWS_API_METRICS_FILE", ""),
+				Description: "The path of a file to which a JSON summary of AWS API calls is appended when the provider stops. " +
+					"Can also be configured using the `TF_AWS_API_METRICS_FILE` environment variable.",
+			},
 			"assume_role": assumeRoleSchema(),
 			"default_tags": {
 				Type:        schema.TypeList,
@@ -196,6 +242,40 @@ func Provider() *schema.Provider {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"precedence": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      tftags.DefaultTagsPrecedenceResource,
+							Description:  "Whether resource tags (`resource`) or default tags (`provider`) take precedence when both configure the same tag key.",
+							ValidateFunc: validation.StringInSlice(tftags.DefaultTagsPrecedence_Values(), false),
+						},
+						"resource_type_scope": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration blocks restricting the resource types to which default tags are applied.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types to which the default tags are not applied. A trailing `*` matches any suffix.",
+									},
+									"include_resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types to which the default tags are only applied. A trailing `*` matches any suffix.",
+									},
+									"keys": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Default tag keys in scope. If omitted, all default tags are in scope.",
+									},
+								},
+							},
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -277,6 +357,33 @@ func Provider() *schema.Provider {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limits": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Configuration blocks with settings to limit the rate of API requests made to a service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The maximum number of requests that can be made at once. Defaults to requests_per_second rounded up.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							Description:  "The sustained number of requests per second.",
+							ValidateFunc: validation.FloatAtLeast(0.01),
+						},
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The service name, as used in the endpoints configuration block, e.g. `route53`.",
+							ValidateFunc: validation.StringInSlice(conns.HCLKeys(), false),
+						},
+					},
+				},
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -288,6 +395,21 @@ func Provider() *schema.Provider {
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 				InputDefault: "us-east-1", // lintignore:AWSAT003
 			},
+			"request_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TF_AWS_REQUEST_TRACE_FILE", ""),
+				Description: "The path of a file to which a JSON record of each AWS API call is appended. " +
+					"Can also be configured using the `TF_AWS_REQUEST_TRACE_FILE` environment variable.",
+			},
+			"request_trace_redacted_fields": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Additional AWS API request and response fields to redact from request traces.",
+			},
+			"retry": retrySchema(),
 			"s3_force_path_style": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -352,6 +474,29 @@ func Provider() *schema.Provider {
 				Description: "Skip requesting the account ID. " +
 					"Used for AWS API implementations that do not have IAM/STS API and/or metadata API.",
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to validate resource tags against a tag policy during planning.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The tag policy document, in the AWS Organizations tag policy JSON syntax.",
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag keys that must be present on all resources.",
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -596,6 +741,7 @@ func Provider() *schema.Provider {
 			"aws_iam_instance_profile":   iam.DataSourceInstanceProfile(),
 			"aws_iam_policy":             iam.DataSourcePolicy(),
 			"aws_iam_policy_document":    iam.DataSourcePolicyDocument(),
+			"aws_iam_policy_evaluation":  iam.DataSourcePolicyEvaluation(),
 			"aws_iam_role":               iam.DataSourceRole(),
 			"aws_iam_roles":              iam.DataSourceRoles(),
 			"aws_iam_server_certificate": iam.DataSourceServerCertificate(),
@@ -1119,7 +1265,9 @@ func Provider() *schema.Provider {
 			"aws_dynamodb_global_table":                  dynamodb.ResourceGlobalTable(),
 			"aws_dynamodb_kinesis_streaming_destination": dynamodb.ResourceKinesisStreamingDestination(),
 			"aws_dynamodb_table":                         dynamodb.ResourceTable(),
+			"aws_dynamodb_table_export":                  dynamodb.ResourceTableExport(),
 			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
+			"aws_dynamodb_table_items":                   dynamodb.ResourceTableItems(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
 
 			"aws_ami":                                             ec2.ResourceAMI(),
@@ -1643,6 +1791,7 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(),
 			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),
 			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_directory_objects":                           s3.ResourceDirectoryObjects(),
 			"aws_s3_object":                                      s3.ResourceObject(),
 			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),
 			"aws_s3_bucket_object":                               s3.ResourceBucketObject(), // DEPRECATED: use aws_s3_object instead
@@ -1852,6 +2001,20 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	// Default tags may be scoped by resource type.
+	for resourceType, r := range provider.ResourcesMap {
+		if _, ok := r.Schema["tags_all"]; ok {
+			scopeDefaultTagsToResourceType(resourceType, r)
+		}
+	}
+
+	// Regional resources may be managed in a region other than the provider's.
+	for resourceType, r := range provider.ResourcesMap {
+		if isRegionalResourceType(resourceType, r) {
+			regionalizeResource(r)
+		}
+	}
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -1868,6 +2031,7 @@ func Provider() *schema.Provider {
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		APIMetricsFile:                 d.Get("api_metrics_file").(string),
 		DefaultTagsConfig:              expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -1878,6 +2042,7 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		MaxRetries:                     d.Get("max_retries").(int),
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
+		RequestTraceFile:               d.Get("request_trace_file").(string),
 		S3ForcePathStyle:               d.Get("s3_force_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
 		SharedConfigFile:               d.Get("shared_config_file").(string),
@@ -1916,12 +2081,60 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
+	retryPolicy, err := expandProviderRetry(d.Get("retry").([]interface{}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	config.RetryPolicy = retryPolicy
+
+	if v, ok := d.GetOk("rate_limits"); ok {
+		rateLimits, err := expandProviderRateLimits(v.(*schema.Set).List())
+
+		if err != nil {
+			return nil, err
+		}
+
+		config.RateLimits = rateLimits
+	}
+
+	if v, ok := d.GetOk("request_trace_redacted_fields"); ok {
+		config.RequestTraceRedactedFields = aws.StringValueSlice(flex.ExpandStringSet(v.(*schema.Set)))
+	}
+
+	if l, ok := d.Get("tag_policy").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		tagPolicy, err := expandProviderTagPolicy(l[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, err
+		}
+
+		config.TagPolicy = tagPolicy
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.AllowedAccountIds = append(config.AllowedAccountIds, accountIDRaw.(string))
 		}
 	}
 
+	if v, ok := d.GetOk("allowed_organization_ids"); ok {
+		config.AllowedOrganizationIds = aws.StringValueSlice(flex.ExpandStringSet(v.(*schema.Set)))
+	}
+
+	if v, ok := d.GetOk("allowed_organizational_units"); ok {
+		config.AllowedOrganizationalUnits = aws.StringValueSlice(flex.ExpandStringSet(v.(*schema.Set)))
+	}
+
+	if v, ok := d.GetOk("allowed_partitions"); ok {
+		config.AllowedPartitions = aws.StringValueSlice(flex.ExpandStringSet(v.(*schema.Set)))
+	}
+
+	if v, ok := d.GetOk("allowed_regions"); ok {
+		config.AllowedRegions = aws.StringValueSlice(flex.ExpandStringSet(v.(*schema.Set)))
+	}
+
 	if v, ok := d.GetOk("forbidden_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.ForbiddenAccountIds = append(config.ForbiddenAccountIds, accountIDRaw.(string))
@@ -2021,6 +2234,66 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func retrySchema() *schema.Schema {
+	retryConfigSchema := func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"max_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The maximum number of attempts, including the initial request, made for an API call.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_backoff_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The maximum delay, in seconds, between retry attempts.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The retry mode. Valid values are `standard` and `adaptive`.",
+				ValidateFunc: validation.StringInSlice(conns.RetryMode_Values(), false),
+			},
+			"retryable_error_codes": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Additional AWS API error codes to retry.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		}
+	}
+
+	serviceSchemaMap := retryConfigSchema()
+	serviceSchemaMap["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The service name, as used in the endpoints configuration block, e.g. `route53`.",
+		ValidateFunc: validation.StringInSlice(conns.HCLKeys(), false),
+	}
+
+	schemaMap := retryConfigSchema()
+	schemaMap["mode"].Default = conns.RetryModeStandard
+	schemaMap["service"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Per-service retry configuration overrides.",
+		Elem: &schema.Resource{
+			Schema: serviceSchemaMap,
+		},
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to control API request retry behavior.",
+		Elem: &schema.Resource{
+			Schema: schemaMap,
+		},
+	}
+}
+
 func expandAssumeRole(m map[string]interface{}) *awsbase.AssumeRole {
 	assumeRole := awsbase.AssumeRole{}
 
@@ -2096,9 +2369,250 @@ func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	if v, ok := m["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(v)
 	}
+
+	if v, ok := m["precedence"].(string); ok && v != "" {
+		defaultConfig.Precedence = v
+	}
+
+	if v, ok := m["resource_type_scope"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			defaultConfig.ResourceTypeScopes = append(defaultConfig.ResourceTypeScopes, tftags.DefaultResourceTypeScope{
+				ExcludeResourceTypes: aws.StringValueSlice(flex.ExpandStringSet(tfMap["exclude_resource_types"].(*schema.Set))),
+				IncludeResourceTypes: aws.StringValueSlice(flex.ExpandStringSet(tfMap["include_resource_types"].(*schema.Set))),
+				Keys:                 aws.StringValueSlice(flex.ExpandStringSet(tfMap["keys"].(*schema.Set))),
+			})
+		}
+	}
+
 	return defaultConfig
 }
 
+// scopeDefaultTagsToResourceType wraps the resource's operations so that the provider
+// metadata passed to them carries the default tags in scope for the resource type.
+// Resource tags overridden by default tags which take precedence are kept in state as configured.
+func scopeDefaultTagsToResourceType(resourceType string, r *schema.Resource) {
+	scopedMeta := func(meta interface{}) interface{} {
+		client, ok := meta.(*conns.AWSClient)
+
+		if !ok || !client.DefaultTagsConfig.HasResourceTypeScopes() {
+			return meta
+		}
+
+		scopedClient := *client
+		scopedClient.DefaultTagsConfig = client.DefaultTagsConfig.ForResourceType(resourceType)
+
+		return &scopedClient
+	}
+
+	_, hasTags := r.Schema["tags"]
+
+	wrap := func(fn func(*schema.ResourceData, interface{}) error, restoreTags bool) func(*schema.ResourceData, interface{}) error {
+		if fn == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, meta interface{}) error {
+			meta = scopedMeta(meta)
+
+			if !restoreTags {
+				return fn(d, meta)
+			}
+
+			priorTags := d.Get("tags").(map[string]interface{})
+
+			if err := fn(d, meta); err != nil {
+				return err
+			}
+
+			return restoreOverriddenResourceTags(d, meta, priorTags)
+		}
+	}
+
+	wrapContext := func(fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, restoreTags bool) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if fn == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			meta = scopedMeta(meta)
+
+			if !restoreTags {
+				return fn(ctx, d, meta)
+			}
+
+			priorTags := d.Get("tags").(map[string]interface{})
+
+			if diags := fn(ctx, d, meta); diags.HasError() {
+				return diags
+			} else if err := restoreOverriddenResourceTags(d, meta, priorTags); err != nil {
+				return append(diags, diag.FromErr(err)...)
+			} else {
+				return diags
+			}
+		}
+	}
+
+	r.Create = wrap(r.Create, hasTags)
+	r.Read = wrap(r.Read, hasTags)
+	r.Update = wrap(r.Update, hasTags)
+	r.Delete = wrap(r.Delete, false)
+	r.CreateContext = wrapContext(r.CreateContext, hasTags)
+	r.ReadContext = wrapContext(r.ReadContext, hasTags)
+	r.UpdateContext = wrapContext(r.UpdateContext, hasTags)
+	r.DeleteContext = wrapContext(r.DeleteContext, false)
+	r.CreateWithoutTimeout = wrapContext(r.CreateWithoutTimeout, hasTags)
+	r.ReadWithoutTimeout = wrapContext(r.ReadWithoutTimeout, hasTags)
+	r.UpdateWithoutTimeout = wrapContext(r.UpdateWithoutTimeout, hasTags)
+	r.DeleteWithoutTimeout = wrapContext(r.DeleteWithoutTimeout, false)
+
+	if fn := r.CustomizeDiff; fn != nil {
+		r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return fn(ctx, diff, scopedMeta(meta))
+		}
+	}
+}
+
+// restoreOverriddenResourceTags restores the resource tags overridden by default tags which take precedence.
+// Reading a resource removes them from "tags" as their values are those of the default tags,
+// which would otherwise cause a perpetual difference with the configured "tags".
+func restoreOverriddenResourceTags(d *schema.ResourceData, meta interface{}, priorTags map[string]interface{}) error {
+	client, ok := meta.(*conns.AWSClient)
+
+	if !ok || d.Id() == "" || !client.DefaultTagsConfig.ProviderPrecedence() || len(priorTags) == 0 {
+		return nil
+	}
+
+	tags := tftags.New(d.Get("tags").(map[string]interface{}))
+	allTags := tftags.New(d.Get("tags_all").(map[string]interface{}))
+	restoredTags := client.DefaultTagsConfig.RestoreOverriddenTags(tags, allTags, tftags.New(priorTags))
+
+	if len(restoredTags) == len(tags) {
+		return nil
+	}
+
+	return d.Set("tags", restoredTags.Map())
+}
+
+func expandProviderRateLimits(tfList []interface{}) (map[string]*conns.RateLimit, error) {
+	rateLimits := make(map[string]*conns.RateLimit)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		hclKey := tfMap["service"].(string)
+		serviceKey, err := conns.ServiceForHCLKey(hclKey)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign rate limit (%s): %w", hclKey, err)
+		}
+
+		if _, ok := rateLimits[serviceKey]; ok {
+			return nil, fmt.Errorf("duplicate rate limit configured for service (%s)", hclKey)
+		}
+
+		rateLimit := &conns.RateLimit{
+			RequestsPerSecond: tfMap["requests_per_second"].(float64),
+		}
+
+		if v, ok := tfMap["burst"].(int); ok && v != 0 {
+			rateLimit.Burst = v
+		}
+
+		rateLimits[serviceKey] = rateLimit
+	}
+
+	return rateLimits, nil
+}
+
+func expandProviderRetry(l []interface{}) (*conns.RetryPolicy, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	retryPolicy := &conns.RetryPolicy{
+		RetryConfig: expandProviderRetryConfig(m),
+	}
+
+	if v, ok := m["service"].(*schema.Set); ok && v.Len() > 0 {
+		retryPolicy.ServiceOverrides = make(map[string]*conns.RetryConfig)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			hclKey := tfMap["name"].(string)
+			serviceKey, err := conns.ServiceForHCLKey(hclKey)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to assign retry configuration (%s): %w", hclKey, err)
+			}
+
+			if _, ok := retryPolicy.ServiceOverrides[serviceKey]; ok {
+				return nil, fmt.Errorf("duplicate retry configuration for service (%s)", hclKey)
+			}
+
+			retryConfig := expandProviderRetryConfig(tfMap)
+			retryPolicy.ServiceOverrides[serviceKey] = &retryConfig
+		}
+	}
+
+	return retryPolicy, nil
+}
+
+func expandProviderRetryConfig(m map[string]interface{}) conns.RetryConfig {
+	retryConfig := conns.RetryConfig{}
+
+	if v, ok := m["max_attempts"].(int); ok && v != 0 {
+		retryConfig.MaxAttempts = v
+	}
+
+	if v, ok := m["max_backoff_seconds"].(int); ok && v != 0 {
+		retryConfig.MaxBackoff = time.Duration(v) * time.Second
+	}
+
+	if v, ok := m["mode"].(string); ok && v != "" {
+		retryConfig.Mode = v
+	}
+
+	if v, ok := m["retryable_error_codes"].(*schema.Set); ok && v.Len() > 0 {
+		for _, codeRaw := range v.List() {
+			retryConfig.RetryableErrorCodes = append(retryConfig.RetryableErrorCodes, codeRaw.(string))
+		}
+	}
+
+	return retryConfig
+}
+
+func expandProviderTagPolicy(m map[string]interface{}) (*tftags.Policy, error) {
+	tagPolicy, err := tftags.ParsePolicy(m["content"].(string))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := m["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		tagPolicy.RequiredKeys = aws.StringValueSlice(flex.ExpandStringSet(v))
+		sort.Strings(tagPolicy.RequiredKeys)
+	}
+
+	return tagPolicy, nil
+}
+
 func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
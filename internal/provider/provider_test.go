@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestExpandProviderRetry(t *testing.T) {
+	retry := func(services ...map[string]interface{}) []interface{} {
+		s := schema.NewSet(schema.HashResource(retrySchema().Elem.(*schema.Resource).Schema["service"].Elem.(*schema.Resource)), nil)
+
+		for _, service := range services {
+			s.Add(service)
+		}
+
+		return []interface{}{map[string]interface{}{"mode": conns.RetryModeStandard, "service": s}}
+	}
+
+	testCases := []struct {
+		Name          string
+		Retry         []interface{}
+		ExpectedKeys  []string
+		ExpectedError bool
+	}{
+		{
+			Name: "no retry",
+		},
+		{
+			Name:         "service",
+			Retry:        retry(map[string]interface{}{"name": "route53", "max_attempts": 50}),
+			ExpectedKeys: []string{conns.Route53},
+		},
+		{
+			Name:         "service alias",
+			Retry:        retry(map[string]interface{}{"name": "lexmodelbuilding", "max_attempts": 50}),
+			ExpectedKeys: []string{conns.LexModels},
+		},
+		{
+			Name:          "duplicate service",
+			Retry:         retry(map[string]interface{}{"name": "lexmodels", "max_attempts": 10}, map[string]interface{}{"name": "lexmodelbuilding", "max_attempts": 50}),
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := expandProviderRetry(testCase.Retry)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.Retry == nil {
+				if got != nil {
+					t.Errorf("got %#v, expected nil", got)
+				}
+
+				return
+			}
+
+			if len(got.ServiceOverrides) != len(testCase.ExpectedKeys) {
+				t.Fatalf("got %d service overrides, expected %d", len(got.ServiceOverrides), len(testCase.ExpectedKeys))
+			}
+
+			for _, key := range testCase.ExpectedKeys {
+				if v, ok := got.ServiceOverrides[key]; !ok || v.MaxAttempts != 50 {
+					t.Errorf("got service override %#v for %s, expected max attempts 50", v, key)
+				}
+			}
+		})
+	}
+}
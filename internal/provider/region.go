@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// regionalResourceTypePrefixes are the resource type prefixes of regional resources of global services.
+var regionalResourceTypePrefixes = []string{
+	"aws_route53_resolver_",
+}
+
+// regionalIDRegexp matches a resource or import ID with a region suffix, e.g. "vpc-12345678@us-west-2".
+var regionalIDRegexp = regexp.MustCompile(`^(.+)@([a-z]{2}(-[a-z]+)+-\d+)$`)
+
+// isRegionalResourceType returns whether the resource type can be managed in a region other than the provider's.
+// Resources already defining a "region" attribute are excluded.
+func isRegionalResourceType(resourceType string, r *schema.Resource) bool {
+	if _, ok := r.Schema["region"]; ok {
+		return false
+	}
+
+	for _, prefix := range regionalResourceTypePrefixes {
+		if strings.HasPrefix(resourceType, prefix) {
+			return true
+		}
+	}
+
+	if service, ok := resourceTypeService(resourceType); ok && conns.ServiceIsGlobal(service) {
+		return false
+	}
+
+	return true
+}
+
+// resourceTypeService returns the key of the service whose HCL key is the longest prefix of the resource type,
+// e.g. "route53" for "aws_route53_zone".
+func resourceTypeService(resourceType string) (string, bool) {
+	var hclKey string
+
+	for _, key := range conns.HCLKeys() {
+		if strings.HasPrefix(resourceType, "aws_"+key+"_") && len(key) > len(hclKey) {
+			hclKey = key
+		}
+	}
+
+	if hclKey == "" {
+		return "", false
+	}
+
+	service, err := conns.ServiceForHCLKey(hclKey)
+
+	if err != nil {
+		return "", false
+	}
+
+	return service, true
+}
+
+// regionalMeta returns the provider metadata for the specified region.
+func regionalMeta(region string, meta interface{}) (interface{}, error) {
+	client, ok := meta.(*conns.AWSClient)
+
+	if !ok {
+		return meta, nil
+	}
+
+	return client.RegionalClient(region)
+}
+
+// setResourceRegion records the region in which the resource is managed if it is not already set,
+// so that later operations use the same region even if the provider region changes.
+func setResourceRegion(d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(*conns.AWSClient)
+
+	if !ok || d.Get("region").(string) != "" {
+		return nil
+	}
+
+	return d.Set("region", client.Region)
+}
+
+// regionalID returns the ID of a resource managed in a region other than the provider's, e.g. "vpc-12345678@us-west-2".
+func regionalID(id, region string) string {
+	return fmt.Sprintf("%s@%s", id, region)
+}
+
+// unqualifyRegionalID sets the resource ID to the ID without its region suffix, as expected by the resource's operations,
+// and returns a function restoring the suffix. New resources in a region other than the provider's get a region suffix.
+func unqualifyRegionalID(d *schema.ResourceData, providerMeta interface{}) func() {
+	region := d.Get("region").(string)
+	qualified := false
+
+	if id := d.Id(); id == "" {
+		client, ok := providerMeta.(*conns.AWSClient)
+		qualified = ok && region != "" && region != client.Region
+	} else if m := regionalIDRegexp.FindStringSubmatch(id); m != nil && m[2] == region {
+		d.SetId(m[1])
+		qualified = true
+	}
+
+	return func() {
+		if qualified && d.Id() != "" {
+			d.SetId(regionalID(d.Id(), region))
+		}
+	}
+}
+
+// regionalizeResource adds an optional "region" argument to the resource and wraps its operations
+// so that the provider metadata passed to them is for the resource's region.
+// The IDs of resources in a region other than the provider's are suffixed by "@<region>", as are their import IDs.
+func regionalizeResource(r *schema.Resource) {
+	r.Schema["region"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		Description:  "The region in which to manage the resource. Defaults to the provider region.",
+		ValidateFunc: verify.ValidRegionName,
+	}
+
+	wrap := func(fn func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if fn == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, providerMeta interface{}) error {
+			meta, err := regionalMeta(d.Get("region").(string), providerMeta)
+
+			if err != nil {
+				return err
+			}
+
+			if err := setResourceRegion(d, meta); err != nil {
+				return err
+			}
+
+			defer unqualifyRegionalID(d, providerMeta)()
+
+			return fn(d, meta)
+		}
+	}
+
+	wrapContext := func(fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if fn == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, providerMeta interface{}) diag.Diagnostics {
+			meta, err := regionalMeta(d.Get("region").(string), providerMeta)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err := setResourceRegion(d, meta); err != nil {
+				return diag.FromErr(err)
+			}
+
+			defer unqualifyRegionalID(d, providerMeta)()
+
+			return fn(ctx, d, meta)
+		}
+	}
+
+	r.Create = wrap(r.Create)
+	r.Read = wrap(r.Read)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+	r.CreateContext = wrapContext(r.CreateContext)
+	r.ReadContext = wrapContext(r.ReadContext)
+	r.UpdateContext = wrapContext(r.UpdateContext)
+	r.DeleteContext = wrapContext(r.DeleteContext)
+	r.CreateWithoutTimeout = wrapContext(r.CreateWithoutTimeout)
+	r.ReadWithoutTimeout = wrapContext(r.ReadWithoutTimeout)
+	r.UpdateWithoutTimeout = wrapContext(r.UpdateWithoutTimeout)
+	r.DeleteWithoutTimeout = wrapContext(r.DeleteWithoutTimeout)
+
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		// New resources are planned in the provider's region unless configured otherwise.
+		if client, ok := meta.(*conns.AWSClient); ok && diff.Id() == "" && diff.NewValueKnown("region") && diff.Get("region").(string) == "" {
+			if err := diff.SetNew("region", client.Region); err != nil {
+				return err
+			}
+		}
+
+		if customizeDiff == nil {
+			return nil
+		}
+
+		meta, err := regionalMeta(diff.Get("region").(string), meta)
+
+		if err != nil {
+			return err
+		}
+
+		return customizeDiff(ctx, diff, meta)
+	}
+
+	if r.Importer == nil {
+		return
+	}
+
+	importer := *r.Importer
+	r.Importer = &importer
+
+	// importMeta sets the resource region from the import ID's region suffix, if any, and returns the provider metadata
+	// for the resource's region and a function restoring the region suffix.
+	importMeta := func(d *schema.ResourceData, providerMeta interface{}) (interface{}, func(), error) {
+		if m := regionalIDRegexp.FindStringSubmatch(d.Id()); m != nil {
+			if err := d.Set("region", m[2]); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		meta, err := regionalMeta(d.Get("region").(string), providerMeta)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err := setResourceRegion(d, meta); err != nil {
+			return nil, nil, err
+		}
+
+		// Imported resources in the provider's region are identified as if created.
+		if client, ok := providerMeta.(*conns.AWSClient); ok && d.Get("region").(string) == client.Region {
+			if m := regionalIDRegexp.FindStringSubmatch(d.Id()); m != nil {
+				d.SetId(m[1])
+			}
+
+			return meta, func() {}, nil
+		}
+
+		if regionalIDRegexp.FindStringSubmatch(d.Id()) == nil {
+			d.SetId(regionalID(d.Id(), d.Get("region").(string)))
+		}
+
+		return meta, unqualifyRegionalID(d, providerMeta), nil
+	}
+
+	if fn := importer.State; fn != nil {
+		importer.State = func(d *schema.ResourceData, providerMeta interface{}) ([]*schema.ResourceData, error) {
+			meta, restore, err := importMeta(d, providerMeta)
+
+			if err != nil {
+				return nil, err
+			}
+
+			defer restore()
+
+			return fn(d, meta)
+		}
+	}
+
+	if fn := importer.StateContext; fn != nil {
+		importer.StateContext = func(ctx context.Context, d *schema.ResourceData, providerMeta interface{}) ([]*schema.ResourceData, error) {
+			meta, restore, err := importMeta(d, providerMeta)
+
+			if err != nil {
+				return nil, err
+			}
+
+			defer restore()
+
+			return fn(ctx, d, meta)
+		}
+	}
+}
@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"io"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestIsRegionalResourceType(t *testing.T) {
+	testCases := []struct {
+		ResourceType string
+		Resource     *schema.Resource
+		Expected     bool
+	}{
+		{
+			ResourceType: "aws_vpc",
+			Resource:     &schema.Resource{Schema: map[string]*schema.Schema{}},
+			Expected:     true,
+		},
+		{
+			ResourceType: "aws_iam_role",
+			Resource:     &schema.Resource{Schema: map[string]*schema.Schema{}},
+			Expected:     false,
+		},
+		{
+			ResourceType: "aws_route53_zone",
+			Resource:     &schema.Resource{Schema: map[string]*schema.Schema{}},
+			Expected:     false,
+		},
+		{
+			ResourceType: "aws_route53_resolver_endpoint",
+			Resource:     &schema.Resource{Schema: map[string]*schema.Schema{}},
+			Expected:     true,
+		},
+		{
+			ResourceType: "aws_dynamodb_table_replica",
+			Resource:     &schema.Resource{Schema: map[string]*schema.Schema{"region": {Type: schema.TypeString, Optional: true}}},
+			Expected:     false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.ResourceType, func(t *testing.T) {
+			if got := isRegionalResourceType(testCase.ResourceType, testCase.Resource); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRegionalizeResource(t *testing.T) {
+	client := testRegionalClient(t)
+
+	var gotRegion string
+
+	r := &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			gotRegion = meta.(*conns.AWSClient).Region
+
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+
+	regionalizeResource(r)
+
+	if v, ok := r.Schema["region"]; !ok || !v.Optional || !v.Computed || !v.ForceNew {
+		t.Fatalf("expected optional, computed, force new region argument, got %#v", v)
+	}
+
+	testCases := []struct {
+		Name           string
+		Region         string
+		ExpectedRegion string
+	}{
+		{
+			Name:           "provider region",
+			ExpectedRegion: client.Region,
+		},
+		{
+			Name:           "other region",
+			Region:         "us-east-1", //lintignore:AWSAT003
+			ExpectedRegion: "us-east-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			gotRegion = ""
+			raw := map[string]interface{}{"name": "example"}
+
+			if testCase.Region != "" {
+				raw["region"] = testCase.Region
+			}
+
+			d := schema.TestResourceDataRaw(t, r.Schema, raw)
+			d.SetId("example")
+
+			if err := r.Read(d, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotRegion != testCase.ExpectedRegion {
+				t.Errorf("got client region %s, expected %s", gotRegion, testCase.ExpectedRegion)
+			}
+
+			// The region is recorded in state.
+			if got := d.Get("region").(string); got != testCase.ExpectedRegion {
+				t.Errorf("got region %s, expected %s", got, testCase.ExpectedRegion)
+			}
+		})
+	}
+}
+
+func TestRegionalizeResourceID(t *testing.T) {
+	client := testRegionalClient(t)
+
+	var gotID string
+
+	r := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			d.SetId("example")
+
+			return nil
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			gotID = d.Id()
+
+			return nil
+		},
+		Schema: map[string]*schema.Schema{},
+	}
+
+	regionalizeResource(r)
+
+	testCases := []struct {
+		Name       string
+		Region     string
+		ExpectedID string
+	}{
+		{
+			Name:       "no region",
+			ExpectedID: "example",
+		},
+		{
+			Name:       "provider region",
+			Region:     "us-west-2", //lintignore:AWSAT003
+			ExpectedID: "example",
+		},
+		{
+			Name:       "other region",
+			Region:     "us-east-1",         //lintignore:AWSAT003
+			ExpectedID: "example@us-east-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			raw := map[string]interface{}{}
+
+			if testCase.Region != "" {
+				raw["region"] = testCase.Region
+			}
+
+			d := schema.TestResourceDataRaw(t, r.Schema, raw)
+
+			if err := r.Create(d, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := d.Id(); got != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", got, testCase.ExpectedID)
+			}
+
+			gotID = ""
+
+			if err := r.Read(d, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			// Operations are passed the ID without its region suffix.
+			if gotID != "example" {
+				t.Errorf("got read ID %s, expected example", gotID)
+			}
+
+			if got := d.Id(); got != testCase.ExpectedID {
+				t.Errorf("got ID %s after read, expected %s", got, testCase.ExpectedID)
+			}
+		})
+	}
+}
+
+func TestRegionalizeResourceImport(t *testing.T) {
+	client := testRegionalClient(t)
+
+	var gotID, gotRegion string
+
+	r := &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				gotID = d.Id()
+				gotRegion = meta.(*conns.AWSClient).Region
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{},
+	}
+
+	regionalizeResource(r)
+
+	testCases := []struct {
+		Name           string
+		ImportID       string
+		ExpectedID     string
+		ExpectedStored string
+		ExpectedRegion string
+	}{
+		{
+			Name:           "no region",
+			ImportID:       "vpc-12345678",
+			ExpectedID:     "vpc-12345678",
+			ExpectedStored: "vpc-12345678",
+			ExpectedRegion: client.Region,
+		},
+		{
+			Name:           "provider region",
+			ImportID:       "vpc-12345678@us-west-2", //lintignore:AWSAT003
+			ExpectedID:     "vpc-12345678",
+			ExpectedStored: "vpc-12345678",
+			ExpectedRegion: client.Region,
+		},
+		{
+			Name:           "region",
+			ImportID:       "vpc-12345678@us-east-1", //lintignore:AWSAT003
+			ExpectedID:     "vpc-12345678",
+			ExpectedStored: "vpc-12345678@us-east-1", //lintignore:AWSAT003
+			ExpectedRegion: "us-east-1",              //lintignore:AWSAT003
+		},
+		{
+			Name:           "ID containing @",
+			ImportID:       "user@example.com",
+			ExpectedID:     "user@example.com",
+			ExpectedStored: "user@example.com",
+			ExpectedRegion: client.Region,
+		},
+		{
+			Name:           "URL with region",
+			ImportID:       "https://queue.amazonaws.com/123456789012/example@eu-west-1", //lintignore:AWSAT003
+			ExpectedID:     "https://queue.amazonaws.com/123456789012/example",
+			ExpectedStored: "https://queue.amazonaws.com/123456789012/example@eu-west-1", //lintignore:AWSAT003
+			ExpectedRegion: "eu-west-1",                                                  //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			gotID, gotRegion = "", ""
+			d := r.Data(nil)
+			d.SetId(testCase.ImportID)
+
+			if _, err := r.Importer.State(d, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			// The importer is passed the ID without its region suffix.
+			if gotID != testCase.ExpectedID {
+				t.Errorf("got importer ID %s, expected %s", gotID, testCase.ExpectedID)
+			}
+
+			if got := d.Id(); got != testCase.ExpectedStored {
+				t.Errorf("got ID %s, expected %s", got, testCase.ExpectedStored)
+			}
+
+			if gotRegion != testCase.ExpectedRegion {
+				t.Errorf("got client region %s, expected %s", gotRegion, testCase.ExpectedRegion)
+			}
+
+			if got := d.Get("region").(string); got != testCase.ExpectedRegion {
+				t.Errorf("got region %s, expected %s", got, testCase.ExpectedRegion)
+			}
+		})
+	}
+}
+
+// testRegionalClient returns an AWS client that supports other regions without calling AWS APIs.
+func testRegionalClient(t *testing.T) *conns.AWSClient {
+	t.Helper()
+
+	// Isolate the test from any AWS shared configuration in the environment.
+	for _, k := range []string{"AWS_CA_BUNDLE", "AWS_CONFIG_FILE", "AWS_PROFILE", "AWS_SHARED_CREDENTIALS_FILE"} {
+		t.Setenv(k, "")
+	}
+
+	writer := log.Writer()
+	log.SetOutput(io.Discard)
+	t.Cleanup(func() { log.SetOutput(writer) })
+
+	v, err := (&conns.Config{
+		AccessKey:               "AKID",
+		Region:                  "us-west-2", //lintignore:AWSAT003
+		SecretKey:               "SECRET",
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}).Client()
+
+	if err != nil {
+		t.Fatalf("error configuring client: %s", err)
+	}
+
+	return v.(*conns.AWSClient)
+}
@@ -100,7 +100,7 @@ func DataSourceCertificateAuthority() *schema.Resource {
 }
 
 func dataSourceCertificateAuthorityRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ACMPCAConn
+	conn := meta.(*conns.AWSClient).ACMPCAConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 	certificateAuthorityArn := d.Get("arn").(string)
 
@@ -28,7 +28,7 @@ func sweepCertificateAuthorities(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).ACMPCAConn
+	conn := client.(*conns.AWSClient).ACMPCAConn()
 
 	certificateAuthorities, err := listCertificateAuthorities(conn)
 	if err != nil {
@@ -153,7 +153,7 @@ func ResourceRestAPI() *schema.Resource {
 }
 
 func resourceRestAPICreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayConn
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	log.Printf("[DEBUG] Creating API Gateway")
@@ -344,7 +344,7 @@ func resourceRestAPICreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRestAPIRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayConn
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -581,7 +581,7 @@ func resourceRestAPIUpdateOperations(d *schema.ResourceData) []*apigateway.Patch
 }
 
 func resourceRestAPIUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayConn
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
 	log.Printf("[DEBUG] Updating API Gateway %s", d.Id())
 
 	if d.HasChange("tags_all") {
@@ -737,7 +737,7 @@ func resourceRestAPIUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRestAPIDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayConn
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
 
 	input := &apigateway.DeleteRestApiInput{
 		RestApiId: aws.String(d.Id()),
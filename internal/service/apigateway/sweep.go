@@ -34,7 +34,7 @@ func sweepRestAPIs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).APIGatewayConn
+	conn := client.(*conns.AWSClient).APIGatewayConn()
 
 	err = conn.GetRestApisPages(&apigateway.GetRestApisInput{}, func(page *apigateway.GetRestApisOutput, lastPage bool) bool {
 		for _, item := range page.Items {
@@ -76,7 +76,7 @@ func sweepVPCLinks(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).APIGatewayConn
+	conn := client.(*conns.AWSClient).APIGatewayConn()
 
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var sweeperErrs *multierror.Error
@@ -41,7 +41,7 @@ func sweepDirectoryConfigs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	input := &appstream.DescribeDirectoryConfigsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -84,7 +84,7 @@ func sweepFleets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	input := &appstream.DescribeFleetsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -127,7 +127,7 @@ func sweepImageBuilders(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	input := &appstream.DescribeImageBuildersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -170,7 +170,7 @@ func sweepStacks(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	input := &appstream.DescribeStacksInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -52,7 +52,7 @@ func ResourceUserStackAssociation() *schema.Resource {
 }
 
 func resourceUserStackAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AppStreamConn
+	conn := meta.(*conns.AWSClient).AppStreamConn()
 
 	input := &appstream.UserStackAssociation{
 		AuthenticationType: aws.String(d.Get("authentication_type").(string)),
@@ -89,7 +89,7 @@ func resourceUserStackAssociationCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceUserStackAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AppStreamConn
+	conn := meta.(*conns.AWSClient).AppStreamConn()
 
 	userName, authType, stackName, err := DecodeUserStackAssociationID(d.Id())
 	if err != nil {
@@ -125,7 +125,7 @@ func resourceUserStackAssociationRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceUserStackAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AppStreamConn
+	conn := meta.(*conns.AWSClient).AppStreamConn()
 
 	userName, authType, stackName, err := DecodeUserStackAssociationID(d.Id())
 	if err != nil {
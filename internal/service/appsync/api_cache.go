@@ -58,7 +58,7 @@ func ResourceAPICache() *schema.Resource {
 }
 
 func resourceAPICacheCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	apiID := d.Get("api_id").(string)
 
@@ -92,7 +92,7 @@ func resourceAPICacheCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAPICacheRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	cache, err := FindApiCacheByID(conn, d.Id())
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -116,7 +116,7 @@ func resourceAPICacheRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAPICacheUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	params := &appsync.UpdateApiCacheInput{
 		ApiId: aws.String(d.Id()),
@@ -148,7 +148,7 @@ func resourceAPICacheUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAPICacheDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	input := &appsync.DeleteApiCacheInput{
 		ApiId: aws.String(d.Id()),
@@ -67,7 +67,7 @@ func testAccAppSyncApiCache_disappears(t *testing.T) {
 }
 
 func testAccCheckApiCacheDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appsync_api_cache" {
 			continue
@@ -95,7 +95,7 @@ func testAccCheckApiCacheExists(resourceName string, apiCache *appsync.ApiCache)
 			return fmt.Errorf("Appsync Api Cache Not found in state: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 		cache, err := tfappsync.FindApiCacheByID(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -37,7 +37,7 @@ func ResourceDomainNameApiAssociation() *schema.Resource {
 }
 
 func resourceDomainNameApiAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	params := &appsync.AssociateApiInput{
 		ApiId:      aws.String(d.Get("api_id").(string)),
@@ -59,7 +59,7 @@ func resourceDomainNameApiAssociationCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceDomainNameApiAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	association, err := FindDomainNameApiAssociationByID(conn, d.Id())
 	if association == nil && !d.IsNewResource() {
@@ -79,7 +79,7 @@ func resourceDomainNameApiAssociationRead(d *schema.ResourceData, meta interface
 }
 
 func resourceDomainNameApiAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	params := &appsync.AssociateApiInput{
 		ApiId:      aws.String(d.Get("api_id").(string)),
@@ -99,7 +99,7 @@ func resourceDomainNameApiAssociationUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceDomainNameApiAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	input := &appsync.DisassociateApiInput{
 		DomainName: aws.String(d.Id()),
@@ -82,7 +82,7 @@ func testAccAppSyncDomainNameApiAssociation_disappears(t *testing.T) {
 }
 
 func testAccCheckDomainNameApiAssociationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appsync_domain_name" {
 			continue
@@ -113,7 +113,7 @@ func testAccCheckDomainNameApiAssociationExists(resourceName string, DomainNameA
 		if !ok {
 			return fmt.Errorf("Appsync Domain Name Not found in state: %s", resourceName)
 		}
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 
 		association, err := tfappsync.FindDomainNameApiAssociationByID(conn, rs.Primary.ID)
 		if err != nil {
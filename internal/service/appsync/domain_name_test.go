@@ -112,7 +112,7 @@ func testAccAppSyncDomainName_disappears(t *testing.T) {
 }
 
 func testAccCheckDomainNameDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appsync_domain_name" {
 			continue
@@ -143,7 +143,7 @@ func testAccCheckDomainNameExists(resourceName string, domainName *appsync.Domai
 		if !ok {
 			return fmt.Errorf("Appsync Domain Name Not found in state: %s", resourceName)
 		}
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 
 		domain, err := tfappsync.FindDomainNameByID(conn, rs.Primary.ID)
 		if err != nil {
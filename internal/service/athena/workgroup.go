@@ -94,7 +94,7 @@ func ResourceWorkGroup() *schema.Resource {
 												"kms_key_arn": {
 													Type:         schema.TypeString,
 													Optional:     true,
-													ValidateFunc: verify.ValidARN,
+													ValidateFunc: verify.ValidARNForService("kms", "key", "alias"),
 												},
 											},
 										},
@@ -148,7 +148,7 @@ func ResourceWorkGroup() *schema.Resource {
 }
 
 func resourceWorkGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AthenaConn
+	conn := meta.(*conns.AWSClient).AthenaConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -192,7 +192,7 @@ func resourceWorkGroupCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceWorkGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AthenaConn
+	conn := meta.(*conns.AWSClient).AthenaConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -257,7 +257,7 @@ func resourceWorkGroupRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceWorkGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AthenaConn
+	conn := meta.(*conns.AWSClient).AthenaConn()
 
 	input := &athena.DeleteWorkGroupInput{
 		WorkGroup: aws.String(d.Id()),
@@ -276,7 +276,7 @@ func resourceWorkGroupDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceWorkGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AthenaConn
+	conn := meta.(*conns.AWSClient).AthenaConn()
 
 	workGroupUpdate := false
 
@@ -596,7 +596,7 @@ func TestAccAthenaWorkGroup_tags(t *testing.T) {
 
 func testAccCheckCreateNamedQuery(workGroup *athena.WorkGroup, databaseName, queryName, query string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
 
 		input := &athena.CreateNamedQueryInput{
 			Name:        aws.String(queryName),
@@ -615,7 +615,7 @@ func testAccCheckCreateNamedQuery(workGroup *athena.WorkGroup, databaseName, que
 }
 
 func testAccCheckWorkGroupDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_athena_workgroup" {
 			continue
@@ -649,7 +649,7 @@ func testAccCheckWorkGroupExists(name string, workgroup *athena.WorkGroup) resou
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
 
 		input := &athena.GetWorkGroupInput{
 			WorkGroup: aws.String(rs.Primary.ID),
@@ -669,7 +669,7 @@ func testAccCheckWorkGroupExists(name string, workgroup *athena.WorkGroup) resou
 
 func testAccCheckWorkGroupDisappears(workgroup *athena.WorkGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
 
 		input := &athena.DeleteWorkGroupInput{
 			WorkGroup: workgroup.Name,
@@ -49,7 +49,7 @@ func ResourceAttachment() *schema.Resource {
 }
 
 func resourceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 	asgName := d.Get("autoscaling_group_name").(string)
 
 	if v, ok := d.GetOk("elb"); ok {
@@ -98,7 +98,7 @@ func resourceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 	asgName := d.Get("autoscaling_group_name").(string)
 
 	// Retrieve the ASG properties to get list of associated ELBs
@@ -165,7 +165,7 @@ func resourceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 	asgName := d.Get("autoscaling_group_name").(string)
 
 	if v, ok := d.GetOk("elb"); ok {
@@ -655,7 +655,7 @@ func generatePutLifecycleHookInputs(asgName string, cfgs []interface{}) []autosc
 }
 
 func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
 	asgName := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
 
@@ -832,7 +832,7 @@ func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
 
 // TODO: wrap all top-level error returns
 func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	g, err := getGroup(d.Id(), conn)
@@ -1024,7 +1024,7 @@ func waitUntilAutoscalingGroupLoadBalancerTargetGroupsAdded(conn *autoscaling.Au
 }
 
 func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 	shouldWaitForCapacity := false
 	shouldRefreshInstances := false
 
@@ -1359,7 +1359,7 @@ func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
 	// Read the Auto Scaling Group first. If it doesn't exist, we're done.
 	// We need the group in order to check if there are instances attached.
@@ -1443,7 +1443,7 @@ func resourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAutoScalingGroupWarmPoolDelete(g *autoscaling.Group, d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
 	if g.WarmPoolConfiguration == nil {
 		// No warm pool configured. Skipping deletion.
@@ -1552,7 +1552,7 @@ func getGroupWarmPool(asgName string, conn *autoscaling.AutoScaling) (*autoscali
 }
 
 func resourceGroupWarmPoolDrain(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
 	if d.Get("force_delete").(bool) || d.Get("force_delete_warm_pool").(bool) {
 		log.Printf("[DEBUG] Skipping Warm pool drain, force delete was set.")
@@ -1635,7 +1635,7 @@ func getGroup(asgName string, conn *autoscaling.AutoScaling) (*autoscaling.Group
 }
 
 func resourceGroupDrain(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
 	if d.Get("force_delete").(bool) {
 		log.Printf("[DEBUG] Skipping Auto Scaling Group drain, force_delete was set.")
@@ -1804,7 +1804,7 @@ func updateASGMetricsCollection(d *schema.ResourceData, conn *autoscaling.AutoSc
 // Nested like: lbName -> instanceId -> instanceState
 func getELBInstanceStates(g *autoscaling.Group, meta interface{}) (map[string]map[string]string, error) {
 	lbInstanceStates := make(map[string]map[string]string)
-	conn := meta.(*conns.AWSClient).ELBConn
+	conn := meta.(*conns.AWSClient).ELBConn()
 
 	for _, lbName := range g.LoadBalancerNames {
 		lbInstanceStates[aws.StringValue(lbName)] = make(map[string]string)
@@ -1833,7 +1833,7 @@ func getELBInstanceStates(g *autoscaling.Group, meta interface{}) (map[string]ma
 // Nested like: targetGroupARN -> instanceId -> instanceState
 func getTargetGroupInstanceStates(g *autoscaling.Group, meta interface{}) (map[string]map[string]string, error) {
 	targetInstanceStates := make(map[string]map[string]string)
-	conn := meta.(*conns.AWSClient).ELBV2Conn
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
 
 	for _, targetGroupARN := range g.TargetGroupARNs {
 		targetInstanceStates[aws.StringValue(targetGroupARN)] = make(map[string]string)
@@ -225,8 +225,8 @@ func DataSourceLaunchConfiguration() *schema.Resource {
 }
 
 func dataSourceLaunchConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*conns.AWSClient).AutoScalingConn
-	ec2conn := meta.(*conns.AWSClient).EC2Conn
+	autoscalingconn := meta.(*conns.AWSClient).AutoScalingConn()
+	ec2conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if v, ok := d.GetOk("name"); ok {
 		d.SetId(v.(string))
@@ -135,7 +135,7 @@ func ResourceSelection() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"selection_tag": {
 				Type:     schema.TypeSet,
@@ -182,7 +182,7 @@ func ResourceSelection() *schema.Resource {
 }
 
 func resourceSelectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BackupConn
+	conn := meta.(*conns.AWSClient).BackupConn()
 
 	selection := &backup.Selection{
 		Conditions:    expandBackupConditions(d.Get("condition").(*schema.Set).List()),
@@ -239,7 +239,7 @@ func resourceSelectionCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSelectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BackupConn
+	conn := meta.(*conns.AWSClient).BackupConn()
 
 	input := &backup.GetBackupSelectionInput{
 		BackupPlanId: aws.String(d.Get("plan_id").(string)),
@@ -336,7 +336,7 @@ func resourceSelectionRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSelectionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BackupConn
+	conn := meta.(*conns.AWSClient).BackupConn()
 
 	input := &backup.DeleteBackupSelectionInput{
 		BackupPlanId: aws.String(d.Get("plan_id").(string)),
@@ -50,7 +50,7 @@ func ResourceVaultPolicy() *schema.Resource {
 }
 
 func resourceVaultPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BackupConn
+	conn := meta.(*conns.AWSClient).BackupConn()
 
 	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
 
@@ -76,7 +76,7 @@ func resourceVaultPolicyPut(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVaultPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BackupConn
+	conn := meta.(*conns.AWSClient).BackupConn()
 
 	output, err := FindBackupVaultAccessPolicyByName(conn, d.Id())
 
@@ -111,7 +111,7 @@ func resourceVaultPolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVaultPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BackupConn
+	conn := meta.(*conns.AWSClient).BackupConn()
 
 	log.Printf("[DEBUG] Deleting Backup Vault Policy (%s)", d.Id())
 	_, err := conn.DeleteBackupVaultAccessPolicy(&backup.DeleteBackupVaultAccessPolicyInput{
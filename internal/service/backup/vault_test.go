@@ -140,7 +140,7 @@ func TestAccBackupVault_disappears(t *testing.T) {
 }
 
 func testAccCheckVaultDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_backup_vault" {
 			continue
@@ -173,7 +173,7 @@ func testAccCheckVaultExists(name string, vault *backup.DescribeBackupVaultOutpu
 			return fmt.Errorf("No Backup Vault ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn()
 
 		output, err := tfbackup.FindBackupVaultByName(conn, rs.Primary.ID)
 
@@ -188,7 +188,7 @@ func testAccCheckVaultExists(name string, vault *backup.DescribeBackupVaultOutpu
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn()
 
 	input := &backup.ListBackupVaultsInput{}
 
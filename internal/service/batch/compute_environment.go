@@ -235,7 +235,7 @@ func ResourceComputeEnvironment() *schema.Resource {
 }
 
 func resourceComputeEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BatchConn
+	conn := meta.(*conns.AWSClient).BatchConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -277,7 +277,7 @@ func resourceComputeEnvironmentCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceComputeEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BatchConn
+	conn := meta.(*conns.AWSClient).BatchConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -328,7 +328,7 @@ func resourceComputeEnvironmentRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceComputeEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BatchConn
+	conn := meta.(*conns.AWSClient).BatchConn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		input := &batch.UpdateComputeEnvironmentInput{
@@ -390,7 +390,7 @@ func resourceComputeEnvironmentUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceComputeEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BatchConn
+	conn := meta.(*conns.AWSClient).BatchConn()
 
 	log.Printf("[DEBUG] Disabling Batch Compute Environment (%s)", d.Id())
 	{
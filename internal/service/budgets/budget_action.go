@@ -171,7 +171,7 @@ func ResourceBudgetAction() *schema.Resource {
 			"execution_role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"notification_type": {
 				Type:         schema.TypeString,
@@ -208,7 +208,7 @@ func ResourceBudgetAction() *schema.Resource {
 }
 
 func resourceBudgetActionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BudgetsConn
+	conn := meta.(*conns.AWSClient).BudgetsConn()
 
 	accountID := d.Get("account_id").(string)
 	if accountID == "" {
@@ -250,7 +250,7 @@ func resourceBudgetActionCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceBudgetActionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BudgetsConn
+	conn := meta.(*conns.AWSClient).BudgetsConn()
 
 	accountID, actionID, budgetName, err := BudgetActionParseResourceID(d.Id())
 
@@ -305,7 +305,7 @@ func resourceBudgetActionRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBudgetActionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BudgetsConn
+	conn := meta.(*conns.AWSClient).BudgetsConn()
 
 	accountID, actionID, budgetName, err := BudgetActionParseResourceID(d.Id())
 
@@ -358,7 +358,7 @@ func resourceBudgetActionUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceBudgetActionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BudgetsConn
+	conn := meta.(*conns.AWSClient).BudgetsConn()
 
 	accountID, actionID, budgetName, err := BudgetActionParseResourceID(d.Id())
 
@@ -191,7 +191,7 @@ func testAccCheckEnvironmentEC2Exists(n string, res *cloud9.Environment) resourc
 			return fmt.Errorf("No Cloud9 Environment EC2 ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Cloud9Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Cloud9Conn()
 
 		out, err := tfcloud9.FindEnvironmentByID(conn, rs.Primary.ID)
 		if err != nil {
@@ -205,7 +205,7 @@ func testAccCheckEnvironmentEC2Exists(n string, res *cloud9.Environment) resourc
 }
 
 func testAccCheckEnvironmentEC2Destroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).Cloud9Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Cloud9Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloud9_environment_ec2" {
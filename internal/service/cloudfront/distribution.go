@@ -138,7 +138,7 @@ func ResourceDistribution() *schema.Resource {
 									"lambda_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("lambda", "function"),
 									},
 									"include_body": {
 										Type:     schema.TypeBool,
@@ -344,7 +344,7 @@ func ResourceDistribution() *schema.Resource {
 									"lambda_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("lambda", "function"),
 									},
 									"include_body": {
 										Type:     schema.TypeBool,
@@ -818,7 +818,7 @@ func ResourceDistribution() *schema.Resource {
 }
 
 func resourceDistributionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -870,7 +870,7 @@ func resourceDistributionCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceDistributionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -936,7 +936,7 @@ func resourceDistributionRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDistributionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 	params := &cloudfront.UpdateDistributionInput{
 		Id:                 aws.String(d.Id()),
 		DistributionConfig: expandDistributionConfig(d),
@@ -987,7 +987,7 @@ func resourceDistributionUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	if d.Get("retain_on_delete").(bool) {
 		// Check if we need to disable first
@@ -1165,14 +1165,14 @@ func DistributionWaitUntilDeployed(id string, meta interface{}) error {
 		Delay:      1 * time.Minute,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForStateWithProgress(stateConf, fmt.Sprintf("CloudFront Distribution (%s) deployment", id))
 	return err
 }
 
 // The refresh function for resourceAwsCloudFrontWebDistributionWaitUntilDeployed.
 func resourceWebDistributionStateRefreshFunc(id string, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		conn := meta.(*conns.AWSClient).CloudFrontConn
+		conn := meta.(*conns.AWSClient).CloudFrontConn()
 		params := &cloudfront.GetDistributionInput{
 			Id: aws.String(id),
 		}
@@ -85,7 +85,7 @@ func sweepCachePolicies(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	input := &cloudfront.ListCachePoliciesInput{
 		Type: aws.String(cloudfront.ResponseHeadersPolicyTypeCustom),
 	}
@@ -144,7 +144,7 @@ func sweepDistributions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	input := &cloudfront.ListDistributionsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -201,7 +201,7 @@ func sweepFunctions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	input := &cloudfront.ListFunctionsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -260,7 +260,7 @@ func sweepKeyGroup(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	var sweeperErrs *multierror.Error
 
 	input := &cloudfront.ListKeyGroupsInput{}
@@ -309,7 +309,7 @@ func sweepMonitoringSubscriptions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	var sweeperErrs *multierror.Error
 
 	distributionSummaries := make([]*cloudfront.DistributionSummary, 0)
@@ -357,7 +357,7 @@ func sweepRealtimeLogsConfig(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	input := &cloudfront.ListRealtimeLogConfigsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -404,7 +404,7 @@ func sweepFieldLevelEncryptionConfigs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	input := &cloudfront.ListFieldLevelEncryptionConfigsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -461,7 +461,7 @@ func sweepFieldLevelEncryptionProfiles(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	input := &cloudfront.ListFieldLevelEncryptionProfilesInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -518,7 +518,7 @@ func sweepOriginRequestPolicies(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	input := &cloudfront.ListOriginRequestPoliciesInput{
 		Type: aws.String(cloudfront.ResponseHeadersPolicyTypeCustom),
 	}
@@ -577,7 +577,7 @@ func sweepResponseHeadersPolicies(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	input := &cloudfront.ListResponseHeadersPoliciesInput{
 		Type: aws.String(cloudfront.ResponseHeadersPolicyTypeCustom),
 	}
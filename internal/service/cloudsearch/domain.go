@@ -160,7 +160,7 @@ func ResourceDomain() *schema.Resource {
 }
 
 func resourceCloudSearchDomainCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudSearchConn
+	conn := meta.(*conns.AWSClient).CloudSearchConn()
 
 	name := d.Get("name").(string)
 	input := cloudsearch.CreateDomainInput{
@@ -246,7 +246,7 @@ func resourceCloudSearchDomainCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceCloudSearchDomainRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudSearchConn
+	conn := meta.(*conns.AWSClient).CloudSearchConn()
 
 	domainStatus, err := FindDomainStatusByName(conn, d.Id())
 
@@ -321,7 +321,7 @@ func resourceCloudSearchDomainRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceCloudSearchDomainUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudSearchConn
+	conn := meta.(*conns.AWSClient).CloudSearchConn()
 	requiresIndexDocuments := false
 
 	if d.HasChange("scaling_parameters") {
@@ -447,7 +447,7 @@ func resourceCloudSearchDomainUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceCloudSearchDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudSearchConn
+	conn := meta.(*conns.AWSClient).CloudSearchConn()
 
 	log.Printf("[DEBUG] Deleting CloudSearch Domain: %s", d.Id())
 
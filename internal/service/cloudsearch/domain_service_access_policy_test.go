@@ -84,7 +84,7 @@ func testAccCloudSearchDomainServiceAccessPolicyExists(n string) resource.TestCh
 			return fmt.Errorf("No CloudSearch Domain Service Access Policy ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudSearchConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudSearchConn()
 
 		_, err := tfcloudsearch.FindAccessPolicyByName(conn, rs.Primary.ID)
 
@@ -102,7 +102,7 @@ func testAccCloudSearchDomainServiceAccessPolicyDestroy(s *terraform.State) erro
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudSearchConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudSearchConn()
 
 		_, err := tfcloudsearch.FindAccessPolicyByName(conn, rs.Primary.ID)
 
@@ -216,7 +216,7 @@ func testAccCloudSearchDomainExists(n string, v *cloudsearch.DomainStatus) resou
 			return fmt.Errorf("No CloudSearch Domain ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudSearchConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudSearchConn()
 
 		output, err := tfcloudsearch.FindDomainStatusByName(conn, rs.Primary.ID)
 
@@ -236,7 +236,7 @@ func testAccCloudSearchDomainDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudSearchConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudSearchConn()
 
 		_, err := tfcloudsearch.FindDomainStatusByName(conn, rs.Primary.ID)
 
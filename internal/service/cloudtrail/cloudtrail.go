@@ -215,7 +215,7 @@ func ResourceCloudTrail() *schema.Resource {
 			"kms_key_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("kms", "key", "alias"),
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -246,7 +246,7 @@ func ResourceCloudTrail() *schema.Resource {
 }
 
 func resourceCloudTrailCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudTrailConn
+	conn := meta.(*conns.AWSClient).CloudTrailConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -344,7 +344,7 @@ func resourceCloudTrailCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudTrailConn
+	conn := meta.(*conns.AWSClient).CloudTrailConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -457,7 +457,7 @@ func resourceCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudTrailConn
+	conn := meta.(*conns.AWSClient).CloudTrailConn()
 
 	if d.HasChangesExcept("tags", "tags_all", "insight_selector", "advanced_event_selector", "event_selector", "enable_logging") {
 		input := cloudtrail.UpdateTrailInput{
@@ -559,7 +559,7 @@ func resourceCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCloudTrailDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudTrailConn
+	conn := meta.(*conns.AWSClient).CloudTrailConn()
 
 	log.Printf("[DEBUG] Deleting CloudTrail: %q", d.Id())
 	_, err := conn.DeleteTrail(&cloudtrail.DeleteTrailInput{
@@ -44,7 +44,7 @@ func ResourceDestination() *schema.Resource {
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 
 			"target_arn": {
@@ -62,7 +62,7 @@ func ResourceDestination() *schema.Resource {
 }
 
 func resourceDestinationPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn()
 
 	name := d.Get("name").(string)
 	roleArn := d.Get("role_arn").(string)
@@ -98,7 +98,7 @@ func resourceDestinationPut(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDestinationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn()
 
 	destination, exists, err := LookupDestination(conn, d.Id(), nil)
 	if err != nil {
@@ -118,7 +118,7 @@ func resourceDestinationRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDestinationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn()
 
 	params := &cloudwatchlogs.DeleteDestinationInput{
 		DestinationName: aws.String(d.Id()),
@@ -50,7 +50,7 @@ func TestAccCloudWatchLogsDestinationPolicy_basic(t *testing.T) {
 }
 
 func testAccCheckDestinationPolicyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchLogsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchLogsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_log_destination_policy" {
@@ -78,7 +78,7 @@ func testAccCheckDestinationPolicyExists(n string, d *cloudwatchlogs.Destination
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchLogsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchLogsConn()
 		destination, exists, err := tfcloudwatchlogs.LookupDestination(conn, rs.Primary.ID, nil)
 		if err != nil {
 			return err
@@ -60,7 +60,7 @@ func ResourceDomainPermissionsPolicy() *schema.Resource {
 }
 
 func resourceDomainPermissionsPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodeArtifactConn
+	conn := meta.(*conns.AWSClient).CodeArtifactConn()
 	log.Print("[DEBUG] Creating CodeArtifact Domain Permissions Policy")
 
 	policy, err := structure.NormalizeJsonString(d.Get("policy_document").(string))
@@ -93,7 +93,7 @@ func resourceDomainPermissionsPolicyPut(d *schema.ResourceData, meta interface{}
 }
 
 func resourceDomainPermissionsPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodeArtifactConn
+	conn := meta.(*conns.AWSClient).CodeArtifactConn()
 	log.Printf("[DEBUG] Reading CodeArtifact Domain Permissions Policy: %s", d.Id())
 
 	domainOwner, domainName, err := DecodeDomainID(d.Id())
@@ -137,7 +137,7 @@ func resourceDomainPermissionsPolicyRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceDomainPermissionsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodeArtifactConn
+	conn := meta.(*conns.AWSClient).CodeArtifactConn()
 	log.Printf("[DEBUG] Deleting CodeArtifact Domain Permissions Policy: %s", d.Id())
 
 	domainOwner, domainName, err := DecodeDomainID(d.Id())
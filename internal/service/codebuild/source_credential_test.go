@@ -117,7 +117,7 @@ func TestAccCodeBuildSourceCredential_disappears(t *testing.T) {
 }
 
 func testAccCheckSourceCredentialDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeBuildConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeBuildConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codebuild_source_credential" {
@@ -146,7 +146,7 @@ func testAccCheckSourceCredentialExists(name string, sourceCredential *codebuild
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeBuildConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeBuildConn()
 
 		output, err := tfcodebuild.FindSourceCredentialByARN(conn, rs.Primary.ID)
 		if err != nil {
@@ -60,7 +60,7 @@ func ResourceCodePipeline() *schema.Resource {
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"artifact_store": {
 				Type:     schema.TypeSet,
@@ -175,7 +175,7 @@ func ResourceCodePipeline() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 									"run_order": {
 										Type:         schema.TypeInt,
@@ -211,7 +211,7 @@ func ResourceCodePipeline() *schema.Resource {
 }
 
 func resourceCodePipelineCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodePipelineConn
+	conn := meta.(*conns.AWSClient).CodePipelineConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -530,7 +530,7 @@ func flattenActionsInputArtifacts(artifacts []*codepipeline.InputArtifact) []str
 }
 
 func resourceCodePipelineRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodePipelineConn
+	conn := meta.(*conns.AWSClient).CodePipelineConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -591,7 +591,7 @@ func resourceCodePipelineRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCodePipelineUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodePipelineConn
+	conn := meta.(*conns.AWSClient).CodePipelineConn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		pipeline, err := expand(d)
@@ -621,7 +621,7 @@ func resourceCodePipelineUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceCodePipelineDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodePipelineConn
+	conn := meta.(*conns.AWSClient).CodePipelineConn()
 
 	_, err := conn.DeletePipeline(&codepipeline.DeletePipelineInput{
 		Name: aws.String(d.Id()),
@@ -201,7 +201,7 @@ func TestAccCodeStarNotificationsNotificationRule_eventTypeIDs(t *testing.T) {
 }
 
 func testAccCheckNotificationRuleDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarNotificationsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarNotificationsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		switch rs.Type {
@@ -272,7 +272,7 @@ func ResourceUserPool() *schema.Resource {
 						"kms_key_id": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("kms", "key", "alias"),
 						},
 						"custom_email_sender": {
 							Type:         schema.TypeList,
@@ -285,7 +285,7 @@ func ResourceUserPool() *schema.Resource {
 									"lambda_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("lambda", "function"),
 									},
 									"lambda_version": {
 										Type:         schema.TypeString,
@@ -306,7 +306,7 @@ func ResourceUserPool() *schema.Resource {
 									"lambda_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("lambda", "function"),
 									},
 									"lambda_version": {
 										Type:         schema.TypeString,
@@ -582,7 +582,7 @@ func ResourceUserPool() *schema.Resource {
 }
 
 func resourceUserPoolCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -804,7 +804,7 @@ func resourceUserPoolCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceUserPoolRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -944,7 +944,7 @@ func resourceUserPoolRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceUserPoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -1197,7 +1197,7 @@ func resourceUserPoolUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceUserPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 
 	params := &cognitoidentityprovider.DeleteUserPoolInput{
 		UserPoolId: aws.String(d.Id()),
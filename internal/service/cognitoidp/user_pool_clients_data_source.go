@@ -36,7 +36,7 @@ func DataSourceUserPoolClients() *schema.Resource {
 }
 
 func dataSourceuserPoolClientsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 
 	userPoolID := d.Get("user_pool_id").(string)
 	input := &cognitoidentityprovider.ListUserPoolClientsInput{
@@ -105,7 +105,7 @@ func ResourceConfigurationAggregator() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 					},
 				},
@@ -117,7 +117,7 @@ func ResourceConfigurationAggregator() *schema.Resource {
 }
 
 func resourceConfigurationAggregatorPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigServiceConn
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -155,7 +155,7 @@ func resourceConfigurationAggregatorPut(d *schema.ResourceData, meta interface{}
 }
 
 func resourceConfigurationAggregatorRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigServiceConn
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -213,7 +213,7 @@ func resourceConfigurationAggregatorRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceConfigurationAggregatorDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigServiceConn
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
 
 	req := &configservice.DeleteConfigurationAggregatorInput{
 		ConfigurationAggregatorName: aws.String(d.Id()),
@@ -35,7 +35,7 @@ func ResourceConfigurationRecorder() *schema.Resource {
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"recording_group": {
 				Type:     schema.TypeList,
@@ -67,7 +67,7 @@ func ResourceConfigurationRecorder() *schema.Resource {
 }
 
 func resourceConfigurationRecorderPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigServiceConn
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
 
 	name := d.Get("name").(string)
 	recorder := configservice.ConfigurationRecorder{
@@ -93,7 +93,7 @@ func resourceConfigurationRecorderPut(d *schema.ResourceData, meta interface{})
 }
 
 func resourceConfigurationRecorderRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigServiceConn
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
 
 	input := configservice.DescribeConfigurationRecordersInput{
 		ConfigurationRecorderNames: []*string{aws.String(d.Id())},
@@ -137,7 +137,7 @@ func resourceConfigurationRecorderRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceConfigurationRecorderDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigServiceConn
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
 	input := configservice.DeleteConfigurationRecorderInput{
 		ConfigurationRecorderName: aws.String(d.Id()),
 	}
@@ -39,7 +39,7 @@ func ResourceConfigurationRecorderStatus() *schema.Resource {
 }
 
 func resourceConfigurationRecorderStatusPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigServiceConn
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
 
 	name := d.Get("name").(string)
 	d.SetId(name)
@@ -71,7 +71,7 @@ func resourceConfigurationRecorderStatusPut(d *schema.ResourceData, meta interfa
 }
 
 func resourceConfigurationRecorderStatusRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigServiceConn
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
 
 	name := d.Id()
 	statusInput := configservice.DescribeConfigurationRecorderStatusInput{
@@ -106,7 +106,7 @@ func resourceConfigurationRecorderStatusRead(d *schema.ResourceData, meta interf
 }
 
 func resourceConfigurationRecorderStatusDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigServiceConn
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
 	input := configservice.StopConfigurationRecorderInput{
 		ConfigurationRecorderName: aws.String(d.Get("name").(string)),
 	}
@@ -53,7 +53,7 @@ func ResourceDeliveryChannel() *schema.Resource {
 			"sns_topic_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("sns", "topic"),
 			},
 			"snapshot_delivery_properties": {
 				Type:     schema.TypeList,
@@ -74,7 +74,7 @@ func ResourceDeliveryChannel() *schema.Resource {
 }
 
 func resourceDeliveryChannelPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigServiceConn
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
 
 	name := d.Get("name").(string)
 	channel := configservice.DeliveryChannel{
@@ -130,7 +130,7 @@ func resourceDeliveryChannelPut(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceDeliveryChannelRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigServiceConn
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
 
 	input := configservice.DescribeDeliveryChannelsInput{
 		DeliveryChannelNames: []*string{aws.String(d.Id())},
@@ -174,7 +174,7 @@ func resourceDeliveryChannelRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceDeliveryChannelDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ConfigServiceConn
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
 	input := configservice.DeleteDeliveryChannelInput{
 		DeliveryChannelName: aws.String(d.Id()),
 	}
@@ -28,7 +28,7 @@ func ResourceLambdaFunctionAssociation() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("lambda", "function"),
 			},
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -40,7 +40,7 @@ func ResourceLambdaFunctionAssociation() *schema.Resource {
 }
 
 func resourceLambdaFunctionAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ConnectConn
+	conn := meta.(*conns.AWSClient).ConnectConn()
 
 	instanceId := d.Get("instance_id").(string)
 	functionArn := d.Get("function_arn").(string)
@@ -61,7 +61,7 @@ func resourceLambdaFunctionAssociationCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceLambdaFunctionAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ConnectConn
+	conn := meta.(*conns.AWSClient).ConnectConn()
 
 	instanceID, functionArn, err := LambdaFunctionAssociationParseResourceID(d.Id())
 
@@ -88,7 +88,7 @@ func resourceLambdaFunctionAssociationRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceLambdaFunctionAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ConnectConn
+	conn := meta.(*conns.AWSClient).ConnectConn()
 
 	instanceID, functionArn, err := LambdaFunctionAssociationParseResourceID(d.Id())
 	if err != nil {
@@ -17,7 +17,7 @@ func DataSourceLambdaFunctionAssociation() *schema.Resource {
 			"function_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("lambda", "function"),
 			},
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -28,7 +28,7 @@ func DataSourceLambdaFunctionAssociation() *schema.Resource {
 }
 
 func dataSourceLambdaFunctionAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ConnectConn
+	conn := meta.(*conns.AWSClient).ConnectConn()
 	functionArn := d.Get("function_arn")
 	instanceID := d.Get("instance_id")
 
@@ -53,7 +53,7 @@ func ResourceRevision() *schema.Resource {
 }
 
 func resourceRevisionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DataExchangeConn
+	conn := meta.(*conns.AWSClient).DataExchangeConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -77,7 +77,7 @@ func resourceRevisionCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRevisionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DataExchangeConn
+	conn := meta.(*conns.AWSClient).DataExchangeConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -117,7 +117,7 @@ func resourceRevisionRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRevisionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DataExchangeConn
+	conn := meta.(*conns.AWSClient).DataExchangeConn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		input := &dataexchange.UpdateRevisionInput{
@@ -148,7 +148,7 @@ func resourceRevisionUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRevisionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DataExchangeConn
+	conn := meta.(*conns.AWSClient).DataExchangeConn()
 
 	input := &dataexchange.DeleteRevisionInput{
 		RevisionId: aws.String(d.Get("revision_id").(string)),
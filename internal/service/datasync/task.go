@@ -38,7 +38,7 @@ func ResourceTask() *schema.Resource {
 			"cloudwatch_log_group_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("logs", "log-group"),
 			},
 			"destination_location_arn": {
 				Type:         schema.TypeString,
@@ -189,7 +189,7 @@ func ResourceTask() *schema.Resource {
 }
 
 func resourceTaskCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DataSyncConn
+	conn := meta.(*conns.AWSClient).DataSyncConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -233,7 +233,7 @@ func resourceTaskCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTaskRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DataSyncConn
+	conn := meta.(*conns.AWSClient).DataSyncConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -285,7 +285,7 @@ func resourceTaskRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTaskUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DataSyncConn
+	conn := meta.(*conns.AWSClient).DataSyncConn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		input := &datasync.UpdateTaskInput{
@@ -330,7 +330,7 @@ func resourceTaskUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTaskDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DataSyncConn
+	conn := meta.(*conns.AWSClient).DataSyncConn()
 
 	input := &datasync.DeleteTaskInput{
 		TaskArn: aws.String(d.Id()),
@@ -701,7 +701,7 @@ func TestAccDataSyncTask_tags(t *testing.T) {
 }
 
 func testAccCheckTaskDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_datasync_task" {
@@ -731,7 +731,7 @@ func testAccCheckTaskExists(resourceName string, task *datasync.DescribeTaskOutp
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn()
 
 		output, err := tfdatasync.FindTaskByARN(conn, rs.Primary.ID)
 
@@ -761,7 +761,7 @@ func testAccCheckTaskNotRecreated(i, j *datasync.DescribeTaskOutput) resource.Te
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DataSyncConn()
 
 	input := &datasync.ListTasksInput{
 		MaxResults: aws.Int64(1),
@@ -57,7 +57,7 @@ func testAccCheckDetectiveInvitationAccepterExists(resourceName string) resource
 			return fmt.Errorf("resource (%s) has empty ID", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn()
 
 		result, err := tfdetective.FindInvitationByGraphArn(context.Background(), conn, rs.Primary.ID)
 
@@ -74,7 +74,7 @@ func testAccCheckDetectiveInvitationAccepterExists(resourceName string) resource
 }
 
 func testAccCheckDetectiveInvitationAccepterDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_detective_invitation_accepter" {
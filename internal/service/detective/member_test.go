@@ -136,7 +136,7 @@ func testAccCheckDetectiveMemberExists(resourceName string, detectiveSession *de
 			return fmt.Errorf("not found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn()
 
 		graphArn, accountId, err := tfdetective.DecodeMemberID(rs.Primary.ID)
 		if err != nil {
@@ -159,7 +159,7 @@ func testAccCheckDetectiveMemberExists(resourceName string, detectiveSession *de
 }
 
 func testAccCheckDetectiveMemberDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_detective_member" {
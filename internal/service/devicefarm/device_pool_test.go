@@ -184,7 +184,7 @@ func testAccCheckDeviceFarmDevicePoolExists(n string, v *devicefarm.DevicePool)
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn()
 		resp, err := tfdevicefarm.FindDevicepoolByArn(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -200,7 +200,7 @@ func testAccCheckDeviceFarmDevicePoolExists(n string, v *devicefarm.DevicePool)
 }
 
 func testAccCheckDeviceFarmDevicePoolDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_devicefarm_device_pool" {
@@ -194,7 +194,7 @@ func testAccCheckDeviceFarmProjectExists(n string, v *devicefarm.Project) resour
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn()
 		resp, err := tfdevicefarm.FindProjectByArn(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -210,7 +210,7 @@ func testAccCheckDeviceFarmProjectExists(n string, v *devicefarm.Project) resour
 }
 
 func testAccCheckDeviceFarmProjectDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_devicefarm_project" {
@@ -34,7 +34,7 @@ func sweepProjects(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).DeviceFarmConn
+	conn := client.(*conns.AWSClient).DeviceFarmConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -88,7 +88,7 @@ func sweepTestGridProjects(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).DeviceFarmConn
+	conn := client.(*conns.AWSClient).DeviceFarmConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
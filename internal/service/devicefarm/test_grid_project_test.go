@@ -188,7 +188,7 @@ func testAccCheckDeviceFarmProjectTestGridProjectExists(n string, v *devicefarm.
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn()
 		resp, err := tfdevicefarm.FindTestGridProjectByArn(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -204,7 +204,7 @@ func testAccCheckDeviceFarmProjectTestGridProjectExists(n string, v *devicefarm.
 }
 
 func testAccCheckDeviceFarmProjectTestGridProjectDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_devicefarm_test_grid_project" {
@@ -137,7 +137,7 @@ func testAccCheckDeviceFarmUploadExists(n string, v *devicefarm.Upload) resource
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn()
 		resp, err := tfdevicefarm.FindUploadByArn(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -153,7 +153,7 @@ func testAccCheckDeviceFarmUploadExists(n string, v *devicefarm.Upload) resource
 }
 
 func testAccCheckDeviceFarmUploadDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_devicefarm_upload" {
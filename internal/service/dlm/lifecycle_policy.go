@@ -41,7 +41,7 @@ func ResourceLifecyclePolicy() *schema.Resource {
 				// TODO: Make this not required and if it's not provided then use the default service role, creating it if necessary
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"policy_details": {
 				Type:     schema.TypeList,
@@ -221,7 +221,7 @@ func ResourceLifecyclePolicy() *schema.Resource {
 }
 
 func resourceLifecyclePolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DLMConn
+	conn := meta.(*conns.AWSClient).DLMConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -248,7 +248,7 @@ func resourceLifecyclePolicyCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceLifecyclePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DLMConn
+	conn := meta.(*conns.AWSClient).DLMConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -290,7 +290,7 @@ func resourceLifecyclePolicyRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceLifecyclePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DLMConn
+	conn := meta.(*conns.AWSClient).DLMConn()
 
 	input := dlm.UpdateLifecyclePolicyInput{
 		PolicyId: aws.String(d.Id()),
@@ -333,7 +333,7 @@ func resourceLifecyclePolicyUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceLifecyclePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DLMConn
+	conn := meta.(*conns.AWSClient).DLMConn()
 
 	log.Printf("[INFO] Deleting DLM lifecycle policy: %s", d.Id())
 	_, err := conn.DeleteLifecyclePolicy(&dlm.DeleteLifecyclePolicyInput{
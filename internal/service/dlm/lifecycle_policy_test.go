@@ -209,7 +209,7 @@ func TestAccDLMLifecyclePolicy_tags(t *testing.T) {
 }
 
 func dlmLifecyclePolicyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DLMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DLMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dlm_lifecycle_policy" {
@@ -245,7 +245,7 @@ func checkDlmLifecyclePolicyExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DLMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DLMConn()
 
 		input := dlm.GetLifecyclePolicyInput{
 			PolicyId: aws.String(rs.Primary.ID),
@@ -262,7 +262,7 @@ func checkDlmLifecyclePolicyExists(name string) resource.TestCheckFunc {
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DLMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DLMConn()
 
 	input := &dlm.GetLifecyclePoliciesInput{}
 
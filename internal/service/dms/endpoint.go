@@ -78,7 +78,7 @@ func ResourceEndpoint() *schema.Resource {
 						"service_access_role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 							// API returns this error with ModifyEndpoint:
 							// InvalidParameterCombinationException: Elasticsearch endpoint cant be modified.
 							ForceNew: true,
@@ -258,7 +258,7 @@ func ResourceEndpoint() *schema.Resource {
 						"service_access_role_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 						"stream_arn": {
 							Type:         schema.TypeString,
@@ -273,7 +273,7 @@ func ResourceEndpoint() *schema.Resource {
 				Computed:     true,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("kms", "key", "alias"),
 			},
 			"mongodb_settings": {
 				Type:             schema.TypeList,
@@ -340,7 +340,7 @@ func ResourceEndpoint() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Default:      "",
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 						"external_table_definition": {
 							Type:     schema.TypeString,
@@ -454,7 +454,7 @@ func ResourceEndpoint() *schema.Resource {
 }
 
 func resourceEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -621,7 +621,7 @@ func resourceEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -664,7 +664,7 @@ func resourceEndpointRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 
 	request := &dms.ModifyEndpointInput{
 		EndpointArn: aws.String(d.Get("endpoint_arn").(string)),
@@ -907,7 +907,7 @@ func resourceEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 
 	log.Printf("[DEBUG] Deleting DMS Endpoint: (%s)", d.Id())
 	_, err := conn.DeleteEndpoint(&dms.DeleteEndpointInput{
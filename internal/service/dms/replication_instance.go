@@ -70,7 +70,7 @@ func ResourceReplicationInstance() *schema.Resource {
 				Computed:     true,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("kms", "key", "alias"),
 			},
 			"multi_az": {
 				Type:     schema.TypeBool,
@@ -137,7 +137,7 @@ func ResourceReplicationInstance() *schema.Resource {
 }
 
 func resourceReplicationInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -204,7 +204,7 @@ func resourceReplicationInstanceCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceReplicationInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -287,7 +287,7 @@ func resourceReplicationInstanceRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceReplicationInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 
 	request := &dms.ModifyReplicationInstanceInput{
 		ApplyImmediately:       aws.Bool(d.Get("apply_immediately").(bool)),
@@ -379,7 +379,7 @@ func resourceReplicationInstanceUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceReplicationInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 
 	request := &dms.DeleteReplicationInstanceInput{
 		ReplicationInstanceArn: aws.String(d.Get("replication_instance_arn").(string)),
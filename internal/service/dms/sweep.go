@@ -37,7 +37,7 @@ func sweepReplicationInstances(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).DMSConn
+	conn := client.(*conns.AWSClient).DMSConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -77,7 +77,7 @@ func sweepReplicationTasks(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).DMSConn
+	conn := client.(*conns.AWSClient).DMSConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -0,0 +1,53 @@
+package dynamodb
+
+import (
+	"testing"
+)
+
+func TestSuppressEquivalentTime(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Expected bool
+	}{
+		{
+			Name:     "same",
+			Old:      "2022-01-02T03:04:05Z",
+			New:      "2022-01-02T03:04:05Z",
+			Expected: true,
+		},
+		{
+			Name:     "same instant in another timezone",
+			Old:      "2022-01-02T03:04:05Z",
+			New:      "2022-01-02T05:04:05+02:00",
+			Expected: true,
+		},
+		{
+			Name:     "different instant",
+			Old:      "2022-01-02T03:04:05Z",
+			New:      "2022-01-02T03:04:05+02:00",
+			Expected: false,
+		},
+		{
+			Name:     "not configured",
+			Old:      "",
+			New:      "2022-01-02T03:04:05Z",
+			Expected: false,
+		},
+		{
+			Name:     "invalid",
+			Old:      "2022-01-02T03:04:05Z",
+			New:      "2022-01-02",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := suppressEquivalentTime("export_time", testCase.Old, testCase.New, nil); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -5,6 +5,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func FindDynamoDBKinesisDataStreamDestination(ctx context.Context, conn *dynamodb.DynamoDB, streamArn, tableName string) (*dynamodb.KinesisDataStreamDestination, error) {
@@ -119,3 +122,28 @@ func FindDynamoDBTTLRDescriptionByTableName(conn *dynamodb.DynamoDB, tableName s
 
 	return output.TimeToLiveDescription, nil
 }
+
+func FindDynamoDBTableExportByARN(conn *dynamodb.DynamoDB, arn string) (*dynamodb.ExportDescription, error) {
+	input := &dynamodb.DescribeExportInput{
+		ExportArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeExport(input)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeExportNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ExportDescription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ExportDescription, nil
+}
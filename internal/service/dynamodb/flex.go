@@ -2,10 +2,12 @@ package dynamodb
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 )
 
@@ -55,3 +57,147 @@ func flattenDynamoDBTableItemAttributes(attrs map[string]*dynamodb.AttributeValu
 
 	return rawBuffer.String(), nil
 }
+
+// expandTableItemsItem parses an item in DynamoDB JSON or in plain JSON.
+func expandTableItemsItem(format, raw string) (map[string]*dynamodb.AttributeValue, error) {
+	if format == TableItemsFormatDynamoDBJSON {
+		return ExpandTableItemAttributes(raw)
+	}
+
+	var tfMap map[string]interface{}
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.UseNumber()
+
+	if err := dec.Decode(&tfMap); err != nil {
+		return nil, fmt.Errorf("Decoding failed: %s", err)
+	}
+
+	attributes := make(map[string]*dynamodb.AttributeValue, len(tfMap))
+
+	for name, value := range tfMap {
+		attribute, err := expandTableItemsAttributeValue(value)
+
+		if err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", name, err)
+		}
+
+		attributes[name] = attribute
+	}
+
+	return attributes, nil
+}
+
+// expandTableItemsAttributeValue infers the DynamoDB type of a plain JSON value.
+// Arrays are lists and objects are maps; sets and binary values require DynamoDB JSON.
+func expandTableItemsAttributeValue(value interface{}) (*dynamodb.AttributeValue, error) {
+	switch value := value.(type) {
+	case nil:
+		return &dynamodb.AttributeValue{NULL: aws.Bool(true)}, nil
+	case bool:
+		return &dynamodb.AttributeValue{BOOL: aws.Bool(value)}, nil
+	case json.Number:
+		return &dynamodb.AttributeValue{N: aws.String(value.String())}, nil
+	case string:
+		return &dynamodb.AttributeValue{S: aws.String(value)}, nil
+	case []interface{}:
+		list := make([]*dynamodb.AttributeValue, 0, len(value))
+
+		for _, v := range value {
+			attribute, err := expandTableItemsAttributeValue(v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			list = append(list, attribute)
+		}
+
+		return &dynamodb.AttributeValue{L: list}, nil
+	case map[string]interface{}:
+		m := make(map[string]*dynamodb.AttributeValue, len(value))
+
+		for k, v := range value {
+			attribute, err := expandTableItemsAttributeValue(v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			m[k] = attribute
+		}
+
+		return &dynamodb.AttributeValue{M: m}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported value %v", value)
+}
+
+// flattenTableItemsItem formats an item in DynamoDB JSON or in plain JSON.
+func flattenTableItemsItem(format string, attributes map[string]*dynamodb.AttributeValue) (string, error) {
+	if format == TableItemsFormatDynamoDBJSON {
+		return flattenDynamoDBTableItemAttributes(attributes)
+	}
+
+	tfMap := make(map[string]interface{}, len(attributes))
+
+	for name, attribute := range attributes {
+		tfMap[name] = flattenTableItemsAttributeValue(attribute)
+	}
+
+	raw, err := json.Marshal(tfMap)
+
+	if err != nil {
+		return "", fmt.Errorf("Encoding failed: %s", err)
+	}
+
+	return string(raw), nil
+}
+
+func flattenTableItemsAttributeValue(attribute *dynamodb.AttributeValue) interface{} {
+	switch {
+	case attribute.B != nil:
+		return base64.StdEncoding.EncodeToString(attribute.B)
+	case attribute.BOOL != nil:
+		return aws.BoolValue(attribute.BOOL)
+	case attribute.BS != nil:
+		var list []interface{}
+
+		for _, v := range attribute.BS {
+			list = append(list, base64.StdEncoding.EncodeToString(v))
+		}
+
+		return list
+	case attribute.L != nil:
+		list := make([]interface{}, 0, len(attribute.L))
+
+		for _, v := range attribute.L {
+			list = append(list, flattenTableItemsAttributeValue(v))
+		}
+
+		return list
+	case attribute.M != nil:
+		m := make(map[string]interface{}, len(attribute.M))
+
+		for k, v := range attribute.M {
+			m[k] = flattenTableItemsAttributeValue(v)
+		}
+
+		return m
+	case attribute.N != nil:
+		return json.Number(aws.StringValue(attribute.N))
+	case attribute.NS != nil:
+		var list []interface{}
+
+		for _, v := range attribute.NS {
+			list = append(list, json.Number(aws.StringValue(v)))
+		}
+
+		return list
+	case attribute.S != nil:
+		return aws.StringValue(attribute.S)
+	case attribute.SS != nil:
+		return aws.StringValueSlice(attribute.SS)
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package dynamodb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+func TestExpandTableItemsItem(t *testing.T) {
+	testCases := []struct {
+		TestName    string
+		Format      string
+		Input       string
+		Expected    map[string]*dynamodb.AttributeValue
+		ExpectError bool
+	}{
+		{
+			TestName: "DynamoDB JSON",
+			Format:   TableItemsFormatDynamoDBJSON,
+			Input:    `{"id": {"S": "a"}, "tags": {"SS": ["x", "y"]}}`,
+			Expected: map[string]*dynamodb.AttributeValue{
+				"id":   {S: aws.String("a")},
+				"tags": {SS: aws.StringSlice([]string{"x", "y"})},
+			},
+		},
+		{
+			TestName: "JSON",
+			Format:   TableItemsFormatJSON,
+			Input:    `{"id": "a", "count": 12.50, "enabled": true, "deleted": null, "list": [1, "b"], "map": {"k": "v"}}`,
+			Expected: map[string]*dynamodb.AttributeValue{
+				"id":      {S: aws.String("a")},
+				"count":   {N: aws.String("12.50")},
+				"enabled": {BOOL: aws.Bool(true)},
+				"deleted": {NULL: aws.Bool(true)},
+				"list": {L: []*dynamodb.AttributeValue{
+					{N: aws.String("1")},
+					{S: aws.String("b")},
+				}},
+				"map": {M: map[string]*dynamodb.AttributeValue{
+					"k": {S: aws.String("v")},
+				}},
+			},
+		},
+		{
+			TestName:    "JSON not an object",
+			Format:      TableItemsFormatJSON,
+			Input:       `["a"]`,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := expandTableItemsItem(testCase.Format, testCase.Input)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFlattenTableItemsItem(t *testing.T) {
+	attributes := map[string]*dynamodb.AttributeValue{
+		"id":      {S: aws.String("a")},
+		"count":   {N: aws.String("12.5")},
+		"enabled": {BOOL: aws.Bool(false)},
+		"data":    {B: []byte("hi")},
+		"list": {L: []*dynamodb.AttributeValue{
+			{N: aws.String("1")},
+		}},
+		"tags": {SS: aws.StringSlice([]string{"x"})},
+	}
+
+	got, err := flattenTableItemsItem(TableItemsFormatJSON, attributes)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"count":12.5,"data":"aGk=","enabled":false,"id":"a","list":[1],"tags":["x"]}`
+
+	if got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	// Round trips, except for binary values and sets, which become strings and lists.
+	delete(attributes, "data")
+	delete(attributes, "tags")
+
+	raw, err := flattenTableItemsItem(TableItemsFormatJSON, attributes)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	roundTrip, err := expandTableItemsItem(TableItemsFormatJSON, raw)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(roundTrip, attributes) {
+		t.Errorf("got %s, expected %s", roundTrip, attributes)
+	}
+}
+
+func TestTableItemsHash(t *testing.T) {
+	a := tableItemsHash(`{"id": {"S": "a"}, "n": {"N": "1"}}`)
+	b := tableItemsHash("{\n  \"n\": {\"N\": \"1\"},\n  \"id\": {\"S\": \"a\"}\n}")
+	c := tableItemsHash(`{"id": {"S": "b"}, "n": {"N": "1"}}`)
+
+	if a != b {
+		t.Errorf("expected equivalent items to hash equally")
+	}
+
+	if a == c {
+		t.Errorf("expected different items to hash differently")
+	}
+}
+
+func TestTableItemAttributesEqual(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		A        map[string]*dynamodb.AttributeValue
+		B        map[string]*dynamodb.AttributeValue
+		Expected bool
+	}{
+		{
+			Name:     "normalized number",
+			A:        map[string]*dynamodb.AttributeValue{"n": {N: aws.String("1.50")}},
+			B:        map[string]*dynamodb.AttributeValue{"n": {N: aws.String("1.5")}},
+			Expected: true,
+		},
+		{
+			Name:     "exponent number",
+			A:        map[string]*dynamodb.AttributeValue{"n": {N: aws.String("1E3")}},
+			B:        map[string]*dynamodb.AttributeValue{"n": {N: aws.String("1000")}},
+			Expected: true,
+		},
+		{
+			Name:     "different number",
+			A:        map[string]*dynamodb.AttributeValue{"n": {N: aws.String("1.5")}},
+			B:        map[string]*dynamodb.AttributeValue{"n": {N: aws.String("1.05")}},
+			Expected: false,
+		},
+		{
+			Name:     "number set order and normalization",
+			A:        map[string]*dynamodb.AttributeValue{"ns": {NS: aws.StringSlice([]string{"2.0", "1"})}},
+			B:        map[string]*dynamodb.AttributeValue{"ns": {NS: aws.StringSlice([]string{"1", "2"})}},
+			Expected: true,
+		},
+		{
+			Name:     "string set order",
+			A:        map[string]*dynamodb.AttributeValue{"ss": {SS: aws.StringSlice([]string{"b", "a"})}},
+			B:        map[string]*dynamodb.AttributeValue{"ss": {SS: aws.StringSlice([]string{"a", "b"})}},
+			Expected: true,
+		},
+		{
+			Name:     "binary set order",
+			A:        map[string]*dynamodb.AttributeValue{"bs": {BS: [][]byte{[]byte("b"), []byte("a")}}},
+			B:        map[string]*dynamodb.AttributeValue{"bs": {BS: [][]byte{[]byte("a"), []byte("b")}}},
+			Expected: true,
+		},
+		{
+			Name:     "different string set",
+			A:        map[string]*dynamodb.AttributeValue{"ss": {SS: aws.StringSlice([]string{"a", "b"})}},
+			B:        map[string]*dynamodb.AttributeValue{"ss": {SS: aws.StringSlice([]string{"a", "c"})}},
+			Expected: false,
+		},
+		{
+			Name: "nested",
+			A: map[string]*dynamodb.AttributeValue{"m": {M: map[string]*dynamodb.AttributeValue{
+				"l": {L: []*dynamodb.AttributeValue{{N: aws.String("0.10")}, {SS: aws.StringSlice([]string{"y", "x"})}}},
+			}}},
+			B: map[string]*dynamodb.AttributeValue{"m": {M: map[string]*dynamodb.AttributeValue{
+				"l": {L: []*dynamodb.AttributeValue{{N: aws.String("0.1")}, {SS: aws.StringSlice([]string{"x", "y"})}}},
+			}}},
+			Expected: true,
+		},
+		{
+			Name:     "list order",
+			A:        map[string]*dynamodb.AttributeValue{"l": {L: []*dynamodb.AttributeValue{{S: aws.String("a")}, {S: aws.String("b")}}}},
+			B:        map[string]*dynamodb.AttributeValue{"l": {L: []*dynamodb.AttributeValue{{S: aws.String("b")}, {S: aws.String("a")}}}},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := tableItemAttributesEqual(testCase.A, testCase.B); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
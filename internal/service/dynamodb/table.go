@@ -248,7 +248,7 @@ func ResourceTable() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Computed:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("kms", "key", "alias"),
 						},
 						"region_name": {
 							Type:     schema.TypeString,
@@ -272,7 +272,7 @@ func ResourceTable() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Computed:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("kms", "key", "alias"),
 						},
 					},
 				},
@@ -358,7 +358,7 @@ func ResourceTable() *schema.Resource {
 }
 
 func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -580,7 +580,7 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -715,7 +715,7 @@ func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 	o, n := d.GetChange("billing_mode")
 	billingMode := n.(string)
 	oldBillingMode := o.(string)
@@ -903,7 +903,7 @@ func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTableDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 
 	log.Printf("[DEBUG] DynamoDB delete table: %s", d.Id())
 
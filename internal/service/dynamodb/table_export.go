@@ -0,0 +1,216 @@
+package dynamodb
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTableExport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTableExportCreate,
+		Read:   resourceTableExportRead,
+		Delete: resourceTableExportDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(tableExportCreatedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"billed_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dynamodb.ExportFormatDynamodbJson,
+				ValidateFunc: validation.StringInSlice(dynamodb.ExportFormat_Values(), false),
+			},
+			"export_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				// The export time is read back in UTC, suppressEquivalentTime allows any timezone to be used.
+				DiffSuppressFunc: suppressEquivalentTime,
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+			"item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"manifest_files_s3_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"s3_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"s3_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"s3_sse_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(dynamodb.S3SseAlgorithm_Values(), false),
+			},
+			"s3_sse_kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARNForService("dynamodb", "table"),
+			},
+		},
+	}
+}
+
+func resourceTableExportCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
+
+	tableARN := d.Get("table_arn").(string)
+	input := &dynamodb.ExportTableToPointInTimeInput{
+		ClientToken:  aws.String(resource.UniqueId()),
+		ExportFormat: aws.String(d.Get("export_format").(string)),
+		S3Bucket:     aws.String(d.Get("s3_bucket").(string)),
+		TableArn:     aws.String(tableARN),
+	}
+
+	if v, ok := d.GetOk("export_time"); ok {
+		input.ExportTime = expandDynamoDBTableExportTime(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_bucket_owner"); ok {
+		input.S3BucketOwner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_prefix"); ok {
+		input.S3Prefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_sse_algorithm"); ok {
+		input.S3SseAlgorithm = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_sse_kms_key_id"); ok {
+		input.S3SseKmsKeyId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating DynamoDB Table Export: %s", input)
+	output, err := conn.ExportTableToPointInTime(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating DynamoDB Table (%s) Export: %w", tableARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.ExportDescription.ExportArn))
+
+	if _, err := waitDynamoDBTableExportCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Table Export (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceTableExportRead(d, meta)
+}
+
+func resourceTableExportRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
+
+	export, err := FindDynamoDBTableExportByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table Export (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Table Export (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", export.ExportArn)
+	d.Set("billed_size_in_bytes", export.BilledSizeBytes)
+	d.Set("end_time", flattenDynamoDBTableExportTime(export.EndTime))
+	d.Set("export_format", export.ExportFormat)
+	d.Set("export_status", export.ExportStatus)
+	d.Set("export_time", flattenDynamoDBTableExportTime(export.ExportTime))
+	d.Set("item_count", export.ItemCount)
+	d.Set("manifest_files_s3_key", export.ExportManifest)
+	d.Set("s3_bucket", export.S3Bucket)
+	d.Set("s3_bucket_owner", export.S3BucketOwner)
+	d.Set("s3_prefix", export.S3Prefix)
+	d.Set("s3_sse_algorithm", export.S3SseAlgorithm)
+	d.Set("s3_sse_kms_key_id", export.S3SseKmsKeyId)
+	d.Set("start_time", flattenDynamoDBTableExportTime(export.StartTime))
+	d.Set("table_arn", export.TableArn)
+
+	return nil
+}
+
+func resourceTableExportDelete(d *schema.ResourceData, meta interface{}) error {
+	// Exports cannot be deleted. The exported files remain in the S3 bucket.
+	log.Printf("[WARN] DynamoDB Table Export (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func expandDynamoDBTableExportTime(v string) *time.Time {
+	t, _ := time.Parse(time.RFC3339, v)
+
+	return aws.Time(t)
+}
+
+func flattenDynamoDBTableExportTime(v *time.Time) string {
+	if v == nil {
+		return ""
+	}
+
+	return aws.TimeValue(v).Format(time.RFC3339)
+}
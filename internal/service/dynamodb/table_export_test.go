@@ -0,0 +1,175 @@
+package dynamodb_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+)
+
+func TestAccDynamoDBTableExport_basic(t *testing.T) {
+	var export dynamodb.ExportDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_export.test"
+	tableResourceName := "aws_dynamodb_table.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableExportConfig(rName, dynamodb.ExportFormatDynamodbJson),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExportExists(resourceName, &export),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "dynamodb", regexp.MustCompile(fmt.Sprintf("table/%s/export/.+$", rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+					resource.TestCheckResourceAttr(resourceName, "export_format", dynamodb.ExportFormatDynamodbJson),
+					resource.TestCheckResourceAttr(resourceName, "export_status", dynamodb.ExportStatusCompleted),
+					resource.TestCheckResourceAttrSet(resourceName, "export_time"),
+					resource.TestCheckResourceAttr(resourceName, "item_count", "0"),
+					resource.TestMatchResourceAttr(resourceName, "manifest_files_s3_key", regexp.MustCompile(`^exports/AWSDynamoDB/.+/manifest-files\.json$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket", bucketResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "s3_prefix", "exports"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_arn", tableResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableExport_ion(t *testing.T) {
+	var export dynamodb.ExportDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableExportConfig(rName, dynamodb.ExportFormatIon),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExportExists(resourceName, &export),
+					resource.TestCheckResourceAttr(resourceName, "export_format", dynamodb.ExportFormatIon),
+					resource.TestCheckResourceAttr(resourceName, "export_status", dynamodb.ExportStatusCompleted),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableExport_kms(t *testing.T) {
+	var export dynamodb.ExportDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_export.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableExportKMSConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExportExists(resourceName, &export),
+					resource.TestCheckResourceAttr(resourceName, "export_status", dynamodb.ExportStatusCompleted),
+					resource.TestCheckResourceAttr(resourceName, "s3_sse_algorithm", dynamodb.S3SseAlgorithmKms),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_sse_kms_key_id", kmsKeyResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTableExportExists(n string, v *dynamodb.ExportDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB Table Export ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn()
+
+		output, err := tfdynamodb.FindDynamoDBTableExportByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTableExportBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "hk"
+
+  attribute {
+    name = "hk"
+    type = "S"
+  }
+
+  point_in_time_recovery {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccTableExportConfig(rName, exportFormat string) string {
+	return acctest.ConfigCompose(testAccTableExportBaseConfig(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_export" "test" {
+  table_arn     = aws_dynamodb_table.test.arn
+  s3_bucket     = aws_s3_bucket.test.id
+  s3_prefix     = "exports"
+  export_format = %[1]q
+}
+`, exportFormat))
+}
+
+func testAccTableExportKMSConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTableExportBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_dynamodb_table_export" "test" {
+  table_arn         = aws_dynamodb_table.test.arn
+  s3_bucket         = aws_s3_bucket.test.id
+  s3_sse_algorithm  = "KMS"
+  s3_sse_kms_key_id = aws_kms_key.test.arn
+}
+`, rName))
+}
@@ -0,0 +1,566 @@
+package dynamodb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// TableItemsFormatDynamoDBJSON is DynamoDB JSON, e.g. {"id": {"S": "example"}}.
+	TableItemsFormatDynamoDBJSON = "DYNAMODB_JSON"
+	// TableItemsFormatJSON is plain JSON, e.g. {"id": "example"}, with the attribute types inferred.
+	TableItemsFormatJSON = "JSON"
+
+	tableItemsBatchGetSize   = 100
+	tableItemsBatchWriteSize = 25
+	tableItemsBatchTimeout   = 5 * time.Minute
+)
+
+func TableItemsFormat_Values() []string {
+	return []string{
+		TableItemsFormatDynamoDBJSON,
+		TableItemsFormatJSON,
+	}
+}
+
+func ResourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTableItemsCreate,
+		Read:   resourceTableItemsRead,
+		Update: resourceTableItemsUpdate,
+		Delete: resourceTableItemsDelete,
+
+		CustomizeDiff: resourceTableItemsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      TableItemsFormatDynamoDBJSON,
+				ValidateFunc: validation.StringInSlice(TableItemsFormat_Values(), false),
+			},
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"items": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Set: tableItemsHash,
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTableItemsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
+
+	tableName := d.Get("table_name").(string)
+
+	items, err := expandTableItems(d, d.Get("items").(*schema.Set).List())
+
+	if err != nil {
+		return err
+	}
+
+	var requests []*dynamodb.WriteRequest
+
+	for _, item := range items {
+		requests = append(requests, &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{Item: item.attributes},
+		})
+	}
+
+	if err := batchWriteTableItems(conn, tableName, requests); err != nil {
+		return fmt.Errorf("error putting DynamoDB Table (%s) items: %w", tableName, err)
+	}
+
+	d.SetId(tableName)
+
+	return resourceTableItemsRead(d, meta)
+}
+
+func resourceTableItemsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
+
+	tableName := d.Get("table_name").(string)
+	format := d.Get("format").(string)
+
+	items, err := expandTableItems(d, d.Get("items").(*schema.Set).List())
+
+	if err != nil {
+		return err
+	}
+
+	remote, err := batchGetTableItems(conn, tableName, d.Get("hash_key").(string), d.Get("range_key").(string), items)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] DynamoDB Table (%s) not found, removing DynamoDB Table Items from state", tableName)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Table (%s) items: %w", tableName, err)
+	}
+
+	// Items deleted outside of Terraform are removed, so that they are put again.
+	// Items changed outside of Terraform are replaced by their current attributes.
+	var tfList []interface{}
+
+	for _, item := range items {
+		attributes, ok := remote[item.id]
+
+		if !ok {
+			log.Printf("[WARN] DynamoDB Table (%s) item (%s) not found", tableName, item.id)
+			continue
+		}
+
+		if tableItemAttributesEqual(attributes, item.attributes) {
+			tfList = append(tfList, item.raw)
+			continue
+		}
+
+		raw, err := flattenTableItemsItem(format, attributes)
+
+		if err != nil {
+			return err
+		}
+
+		tfList = append(tfList, raw)
+	}
+
+	if err := d.Set("items", tfList); err != nil {
+		return fmt.Errorf("error setting items: %w", err)
+	}
+
+	return nil
+}
+
+func resourceTableItemsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
+
+	tableName := d.Get("table_name").(string)
+
+	o, n := d.GetChange("items")
+
+	oldItems, err := expandTableItems(d, o.(*schema.Set).List())
+
+	if err != nil {
+		return err
+	}
+
+	newItems, err := expandTableItems(d, n.(*schema.Set).List())
+
+	if err != nil {
+		return err
+	}
+
+	oldAttributes := make(map[string]map[string]*dynamodb.AttributeValue)
+
+	for _, item := range oldItems {
+		oldAttributes[item.id] = item.attributes
+	}
+
+	newAttributes := make(map[string]map[string]*dynamodb.AttributeValue)
+
+	for _, item := range newItems {
+		newAttributes[item.id] = item.attributes
+	}
+
+	// Only new and changed items are put, and only removed items are deleted.
+	var requests []*dynamodb.WriteRequest
+
+	for _, item := range newItems {
+		if attributes, ok := oldAttributes[item.id]; ok && tableItemAttributesEqual(attributes, item.attributes) {
+			continue
+		}
+
+		requests = append(requests, &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{Item: item.attributes},
+		})
+	}
+
+	for _, item := range oldItems {
+		if _, ok := newAttributes[item.id]; ok {
+			continue
+		}
+
+		requests = append(requests, &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{Key: item.key},
+		})
+	}
+
+	if err := batchWriteTableItems(conn, tableName, requests); err != nil {
+		return fmt.Errorf("error updating DynamoDB Table (%s) items: %w", tableName, err)
+	}
+
+	return resourceTableItemsRead(d, meta)
+}
+
+func resourceTableItemsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
+
+	tableName := d.Get("table_name").(string)
+
+	items, err := expandTableItems(d, d.Get("items").(*schema.Set).List())
+
+	if err != nil {
+		return err
+	}
+
+	var requests []*dynamodb.WriteRequest
+
+	for _, item := range items {
+		requests = append(requests, &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{Key: item.key},
+		})
+	}
+
+	err = batchWriteTableItems(conn, tableName, requests)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting DynamoDB Table (%s) items: %w", tableName, err)
+	}
+
+	return nil
+}
+
+func resourceTableItemsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"format", "hash_key", "items", "range_key", "table_name"} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	_, err := expandTableItems(diff, diff.Get("items").(*schema.Set).List())
+
+	return err
+}
+
+// tableItemsItem is an item of the items argument.
+type tableItemsItem struct {
+	attributes map[string]*dynamodb.AttributeValue
+	id         string
+	key        map[string]*dynamodb.AttributeValue
+	raw        string
+}
+
+// expandTableItems parses the items and checks that each has a key, and that keys are unique.
+func expandTableItems(d interface{ Get(string) interface{} }, tfList []interface{}) ([]*tableItemsItem, error) {
+	format := d.Get("format").(string)
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	var items []*tableItemsItem
+	ids := make(map[string]bool)
+
+	for _, tfListRaw := range tfList {
+		raw, ok := tfListRaw.(string)
+
+		if !ok {
+			continue
+		}
+
+		attributes, err := expandTableItemsItem(format, raw)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid item %s: %w", raw, err)
+		}
+
+		if _, ok := attributes[hashKey]; !ok {
+			return nil, fmt.Errorf("invalid item %s: missing hash key (%s)", raw, hashKey)
+		}
+
+		if _, ok := attributes[rangeKey]; rangeKey != "" && !ok {
+			return nil, fmt.Errorf("invalid item %s: missing range key (%s)", raw, rangeKey)
+		}
+
+		id := buildDynamoDbTableItemId(tableName, hashKey, rangeKey, attributes)
+
+		if ids[id] {
+			return nil, fmt.Errorf("invalid item %s: duplicate key", raw)
+		}
+
+		ids[id] = true
+
+		items = append(items, &tableItemsItem{
+			attributes: attributes,
+			id:         id,
+			key:        BuildTableItemqueryKey(attributes, hashKey, rangeKey),
+			raw:        raw,
+		})
+	}
+
+	// Sort for stable batches.
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].id < items[j].id
+	})
+
+	return items, nil
+}
+
+// batchGetTableItems returns the configured attributes of the items that exist, keyed by ID.
+func batchGetTableItems(conn *dynamodb.DynamoDB, tableName, hashKey, rangeKey string, items []*tableItemsItem) (map[string]map[string]*dynamodb.AttributeValue, error) {
+	configured := make(map[string]*tableItemsItem)
+	remote := make(map[string]map[string]*dynamodb.AttributeValue)
+
+	for _, item := range items {
+		configured[item.id] = item
+	}
+
+	for start := 0; start < len(items); start += tableItemsBatchGetSize {
+		end := start + tableItemsBatchGetSize
+
+		if end > len(items) {
+			end = len(items)
+		}
+
+		var keys []map[string]*dynamodb.AttributeValue
+		projection := make(map[string]*dynamodb.AttributeValue)
+
+		for _, item := range items[start:end] {
+			keys = append(keys, item.key)
+
+			for name, value := range item.attributes {
+				projection[name] = value
+			}
+		}
+
+		input := &dynamodb.BatchGetItemInput{
+			RequestItems: map[string]*dynamodb.KeysAndAttributes{
+				tableName: {
+					ConsistentRead:           aws.Bool(true),
+					ExpressionAttributeNames: BuildExpressionAttributeNames(projection),
+					Keys:                     keys,
+					ProjectionExpression:     BuildProjectionExpression(projection),
+				},
+			},
+		}
+
+		var responses []map[string]*dynamodb.AttributeValue
+
+		err := resource.Retry(tableItemsBatchTimeout, func() *resource.RetryError {
+			output, err := conn.BatchGetItem(input)
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			responses = append(responses, output.Responses[tableName]...)
+
+			if unprocessed, ok := output.UnprocessedKeys[tableName]; ok && len(unprocessed.Keys) > 0 {
+				input.RequestItems = output.UnprocessedKeys
+				return resource.RetryableError(fmt.Errorf("%d unprocessed keys", len(unprocessed.Keys)))
+			}
+
+			return nil
+		})
+
+		if tfresource.TimedOut(err) {
+			return nil, fmt.Errorf("error reading items: unprocessed keys after %s", tableItemsBatchTimeout)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, attributes := range responses {
+			id := buildDynamoDbTableItemId(tableName, hashKey, rangeKey, attributes)
+			item, ok := configured[id]
+
+			if !ok {
+				continue
+			}
+
+			// Other items' attributes are projected too.
+			for name := range attributes {
+				if _, ok := item.attributes[name]; !ok {
+					delete(attributes, name)
+				}
+			}
+
+			remote[id] = attributes
+		}
+	}
+
+	return remote, nil
+}
+
+// batchWriteTableItems writes the requests in batches, retrying unprocessed items.
+func batchWriteTableItems(conn *dynamodb.DynamoDB, tableName string, requests []*dynamodb.WriteRequest) error {
+	for start := 0; start < len(requests); start += tableItemsBatchWriteSize {
+		end := start + tableItemsBatchWriteSize
+
+		if end > len(requests) {
+			end = len(requests)
+		}
+
+		input := &dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]*dynamodb.WriteRequest{
+				tableName: requests[start:end],
+			},
+		}
+
+		err := resource.Retry(tableItemsBatchTimeout, func() *resource.RetryError {
+			output, err := conn.BatchWriteItem(input)
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			if unprocessed := output.UnprocessedItems[tableName]; len(unprocessed) > 0 {
+				input.RequestItems = output.UnprocessedItems
+				return resource.RetryableError(fmt.Errorf("%d unprocessed items", len(unprocessed)))
+			}
+
+			return nil
+		})
+
+		if tfresource.TimedOut(err) {
+			return fmt.Errorf("%d unprocessed items after %s", len(input.RequestItems[tableName]), tableItemsBatchTimeout)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tableItemsHash hashes an item by its normalized JSON, so that formatting changes cause no diff.
+func tableItemsHash(v interface{}) int {
+	raw := v.(string)
+
+	var value interface{}
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.UseNumber()
+
+	if err := dec.Decode(&value); err != nil {
+		return create.StringHashcode(raw)
+	}
+
+	normalized, err := json.Marshal(value)
+
+	if err != nil {
+		return create.StringHashcode(raw)
+	}
+
+	return create.StringHashcode(string(normalized))
+}
+
+// tableItemAttributesEqual returns whether the item attributes are equivalent as stored by DynamoDB,
+// which normalizes numbers, e.g. "1.50" to "1.5", and does not preserve the order of set members.
+func tableItemAttributesEqual(a, b map[string]*dynamodb.AttributeValue) bool {
+	return reflect.DeepEqual(canonicalTableItemAttributes(a), canonicalTableItemAttributes(b))
+}
+
+func canonicalTableItemAttributes(attributes map[string]*dynamodb.AttributeValue) map[string]*dynamodb.AttributeValue {
+	if attributes == nil {
+		return nil
+	}
+
+	canonical := make(map[string]*dynamodb.AttributeValue, len(attributes))
+
+	for k, v := range attributes {
+		canonical[k] = canonicalTableItemAttributeValue(v)
+	}
+
+	return canonical
+}
+
+func canonicalTableItemAttributeValue(attribute *dynamodb.AttributeValue) *dynamodb.AttributeValue {
+	if attribute == nil {
+		return nil
+	}
+
+	canonical := *attribute
+
+	if attribute.N != nil {
+		canonical.N = aws.String(canonicalTableItemNumber(aws.StringValue(attribute.N)))
+	}
+
+	if attribute.NS != nil {
+		canonical.NS = make([]*string, len(attribute.NS))
+
+		for i, v := range attribute.NS {
+			canonical.NS[i] = aws.String(canonicalTableItemNumber(aws.StringValue(v)))
+		}
+
+		sort.Slice(canonical.NS, func(i, j int) bool { return aws.StringValue(canonical.NS[i]) < aws.StringValue(canonical.NS[j]) })
+	}
+
+	if attribute.SS != nil {
+		canonical.SS = make([]*string, len(attribute.SS))
+		copy(canonical.SS, attribute.SS)
+		sort.Slice(canonical.SS, func(i, j int) bool { return aws.StringValue(canonical.SS[i]) < aws.StringValue(canonical.SS[j]) })
+	}
+
+	if attribute.BS != nil {
+		canonical.BS = make([][]byte, len(attribute.BS))
+		copy(canonical.BS, attribute.BS)
+		sort.Slice(canonical.BS, func(i, j int) bool { return bytes.Compare(canonical.BS[i], canonical.BS[j]) < 0 })
+	}
+
+	if attribute.L != nil {
+		canonical.L = make([]*dynamodb.AttributeValue, len(attribute.L))
+
+		for i, v := range attribute.L {
+			canonical.L[i] = canonicalTableItemAttributeValue(v)
+		}
+	}
+
+	if attribute.M != nil {
+		canonical.M = canonicalTableItemAttributes(attribute.M)
+	}
+
+	return &canonical
+}
+
+// canonicalTableItemNumber returns the exact rational value of the number, e.g. "3/2" for "1.50", or the number as is if it cannot be parsed.
+func canonicalTableItemNumber(n string) string {
+	if r, ok := new(big.Rat).SetString(n); ok {
+		return r.String()
+	}
+
+	return n
+}
@@ -0,0 +1,251 @@
+package dynamodb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	resourceName := "aws_dynamodb_table_items.test"
+	tableName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableItemsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig(tableName, `[
+    jsonencode({ id = { S = "a" }, value = { N = "1" } }),
+    jsonencode({ id = { S = "b" }, value = { N = "2" } }),
+    jsonencode({ id = { S = "c" }, value = { N = "3" } }),
+  ]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(tableName, 3),
+					resource.TestCheckResourceAttr(resourceName, "format", "DYNAMODB_JSON"),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "id"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "table_name", tableName),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig(tableName, `[
+    jsonencode({ id = { S = "a" }, value = { N = "1" } }),
+    jsonencode({ id = { S = "b" }, value = { N = "20" } }),
+    jsonencode({ id = { S = "d" }, value = { N = "4" } }),
+  ]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(tableName, 3),
+					testAccCheckTableItemsItem(tableName, "b", "20"),
+					testAccCheckTableItemsItemNotExists(tableName, "c"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_json(t *testing.T) {
+	resourceName := "aws_dynamodb_table_items.test"
+	tableName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableItemsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsJSONConfig(tableName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(tableName, 30),
+					testAccCheckTableItemsItem(tableName, "item-7", "7"),
+					resource.TestCheckResourceAttr(resourceName, "format", "JSON"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_disappears(t *testing.T) {
+	tableName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	config := testAccTableItemsConfig(tableName, `[
+    jsonencode({ id = { S = "a" }, value = { N = "1" } }),
+  ]`)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableItemsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(tableName, 1),
+					testAccCheckTableItemsDeleteItem(tableName, "a"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(tableName, 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTableItemsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_table_items" {
+			continue
+		}
+
+		output, err := conn.Scan(&dynamodb.ScanInput{
+			ConsistentRead: aws.Bool(true),
+			Select:         aws.String(dynamodb.SelectCount),
+			TableName:      aws.String(rs.Primary.Attributes["table_name"]),
+		})
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if count := aws.Int64Value(output.Count); count > 0 {
+			return fmt.Errorf("DynamoDB Table (%s) still has %d items", rs.Primary.Attributes["table_name"], count)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckTableItemsItem(tableName, id, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn()
+
+		output, err := conn.GetItem(&dynamodb.GetItemInput{
+			ConsistentRead: aws.Bool(true),
+			Key: map[string]*dynamodb.AttributeValue{
+				"id": {S: aws.String(id)},
+			},
+			TableName: aws.String(tableName),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output.Item == nil {
+			return fmt.Errorf("DynamoDB Table (%s) item (%s) not found", tableName, id)
+		}
+
+		if got := aws.StringValue(output.Item["value"].N); got != value {
+			return fmt.Errorf("DynamoDB Table (%s) item (%s) value: expected %s, got %s", tableName, id, value, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableItemsItemNotExists(tableName, id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn()
+
+		output, err := conn.GetItem(&dynamodb.GetItemInput{
+			ConsistentRead: aws.Bool(true),
+			Key: map[string]*dynamodb.AttributeValue{
+				"id": {S: aws.String(id)},
+			},
+			TableName: aws.String(tableName),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output.Item != nil {
+			return fmt.Errorf("DynamoDB Table (%s) item (%s) still exists", tableName, id)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableItemsDeleteItem(tableName, id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn()
+
+		_, err := conn.DeleteItem(&dynamodb.DeleteItemInput{
+			Key: map[string]*dynamodb.AttributeValue{
+				"id": {S: aws.String(id)},
+			},
+			TableName: aws.String(tableName),
+		})
+
+		return err
+	}
+}
+
+func testAccTableItemsBaseConfig(tableName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 10
+  write_capacity = 10
+  hash_key       = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+}
+`, tableName)
+}
+
+func testAccTableItemsConfig(tableName, items string) string {
+	return acctest.ConfigCompose(testAccTableItemsBaseConfig(tableName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = %[1]s
+}
+`, items))
+}
+
+func testAccTableItemsJSONConfig(tableName string) string {
+	return acctest.ConfigCompose(testAccTableItemsBaseConfig(tableName), `
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  format     = "JSON"
+
+  # More than one batch.
+  items = [for i in range(30) : jsonencode({
+    id      = "item-${i}"
+    value   = i
+    enabled = i % 2 == 0
+    labels  = ["a", "b"]
+  })]
+}
+`)
+}
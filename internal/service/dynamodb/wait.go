@@ -2,10 +2,13 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -19,6 +22,7 @@ const (
 	deleteTableTimeout                         = 10 * time.Minute
 	pitrUpdateTimeout                          = 30 * time.Second
 	ttlUpdateTimeout                           = 30 * time.Second
+	tableExportCreatedTimeout                  = 60 * time.Minute
 )
 
 func waitDynamoDBKinesisStreamingDestinationActive(ctx context.Context, conn *dynamodb.DynamoDB, streamArn, tableName string) error {
@@ -258,3 +262,25 @@ func waitDynamoDBSSEUpdated(conn *dynamodb.DynamoDB, tableName string) (*dynamod
 
 	return nil, err
 }
+
+func waitDynamoDBTableExportCreated(conn *dynamodb.DynamoDB, arn string, timeout time.Duration) (*dynamodb.ExportDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.ExportStatusInProgress},
+		Target:  []string{dynamodb.ExportStatusCompleted},
+		Timeout: timeout,
+		Refresh: statusDynamoDBTableExport(conn, arn),
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dynamodb.ExportDescription); ok {
+		if status := aws.StringValue(output.ExportStatus); status == dynamodb.ExportStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -147,7 +147,7 @@ func testAccCheckAMILaunchPermissionExists(resourceName string) resource.TestChe
 			return fmt.Errorf("No resource ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		accountID := rs.Primary.Attributes["account_id"]
 		imageID := rs.Primary.Attributes["image_id"]
 
@@ -166,7 +166,7 @@ func testAccCheckAMILaunchPermissionDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		accountID := rs.Primary.Attributes["account_id"]
 		imageID := rs.Primary.Attributes["image_id"]
 
@@ -191,7 +191,7 @@ func testAccCheckAMILaunchPermissionAddPublic(resourceName string) resource.Test
 			return fmt.Errorf("No resource ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		imageID := rs.Primary.Attributes["image_id"]
 
 		input := &ec2.ModifyImageAttributeInput{
@@ -221,7 +221,7 @@ func testAccCheckAMILaunchPermissionDisappears(resourceName string) resource.Tes
 			return fmt.Errorf("No resource ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		accountID := rs.Primary.Attributes["account_id"]
 		imageID := rs.Primary.Attributes["image_id"]
 
@@ -246,7 +246,7 @@ func testAccCheckAMILaunchPermissionDisappears(resourceName string) resource.Tes
 // so we can test that Terraform will react properly
 func testAccAMIDisappears(imageID *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		req := &ec2.DeregisterImageInput{
 			ImageId: aws.String(*imageID),
 		}
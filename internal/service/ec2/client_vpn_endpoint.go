@@ -219,7 +219,7 @@ func ResourceClientVPNEndpoint() *schema.Resource {
 }
 
 func resourceClientVPNEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -285,7 +285,7 @@ func resourceClientVPNEndpointCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceClientVPNEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -366,7 +366,7 @@ func resourceClientVPNEndpointRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceClientVPNEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		var waitForClientConnectResponseOptionsUpdate bool
@@ -463,7 +463,7 @@ func resourceClientVPNEndpointUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceClientVPNEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[DEBUG] Deleting EC2 Client VPN Endpoint: %s", d.Id())
 	_, err := conn.DeleteClientVpnEndpoint(&ec2.DeleteClientVpnEndpointInput{
@@ -27,7 +27,7 @@ func DataSourceCoIPPools() *schema.Resource {
 }
 
 func dataSourceCoIPPoolsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeCoipPoolsInput{}
 
@@ -192,7 +192,7 @@ func ResourceDefaultSecurityGroup() *schema.Resource {
 }
 
 func resourceDefaultSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	securityGroupOpts := &ec2.DescribeSecurityGroupsInput{
@@ -262,7 +262,7 @@ func resourceDefaultSecurityGroupCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceDefaultSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -324,7 +324,7 @@ func resourceDefaultSecurityGroupRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceDefaultSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	group, err := FindSecurityGroupByID(conn, d.Id())
 	if err != nil {
@@ -360,7 +360,7 @@ func resourceDefaultSecurityGroupDelete(d *schema.ResourceData, meta interface{}
 }
 
 func revokeDefaultSecurityGroupRules(meta interface{}, g *ec2.SecurityGroup) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	groupID := aws.StringValue(g.GroupId)
 	log.Printf("[WARN] Removing all ingress and egress rules found on Default Security Group (%s)", groupID)
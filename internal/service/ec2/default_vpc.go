@@ -150,7 +150,7 @@ func ResourceDefaultVPC() *schema.Resource {
 }
 
 func resourceDefaultVPCCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeVpcsInput{
 		Filters: BuildAttributeFilterList(
@@ -30,7 +30,7 @@ func TestAccEC2EBSDefaultKMSKeyDataSource_basic(t *testing.T) {
 
 func testAccCheckEBSDefaultKMSKey(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
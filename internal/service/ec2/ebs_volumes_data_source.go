@@ -26,7 +26,7 @@ func DataSourceEBSVolumes() *schema.Resource {
 }
 
 func dataSourceEBSVolumesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeVolumesInput{}
 
@@ -72,7 +72,7 @@ func ResourceFlowLog() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"log_destination": {
 				Type:          schema.TypeString,
@@ -137,7 +137,7 @@ func ResourceFlowLog() *schema.Resource {
 }
 
 func resourceLogFlowCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -219,7 +219,7 @@ func resourceLogFlowCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLogFlowRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -282,7 +282,7 @@ func resourceLogFlowRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLogFlowUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -295,7 +295,7 @@ func resourceLogFlowUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLogFlowDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[INFO] Deleting Flow Log: %s", d.Id())
 	output, err := conn.DeleteFlowLogs(&ec2.DeleteFlowLogsInput{
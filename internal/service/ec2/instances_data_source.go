@@ -47,7 +47,7 @@ func DataSourceInstances() *schema.Resource {
 }
 
 func dataSourceInstancesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeInstancesInput{}
 
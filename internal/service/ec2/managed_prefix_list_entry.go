@@ -45,7 +45,7 @@ func ResourceManagedPrefixListEntry() *schema.Resource {
 }
 
 func resourceManagedPrefixListEntryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	cidr := d.Get("cidr").(string)
 	plID := d.Get("prefix_list_id").(string)
@@ -85,7 +85,7 @@ func resourceManagedPrefixListEntryCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceManagedPrefixListEntryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	plID, cidr, err := ManagedPrefixListEntryParseID(d.Id())
 
@@ -116,7 +116,7 @@ func resourceManagedPrefixListEntryRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceManagedPrefixListEntryDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	plID, cidr, err := ManagedPrefixListEntryParseID(d.Id())
 
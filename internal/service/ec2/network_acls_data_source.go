@@ -30,7 +30,7 @@ func DataSourceNetworkACLs() *schema.Resource {
 }
 
 func dataSourceNetworkACLsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeNetworkAclsInput{}
 
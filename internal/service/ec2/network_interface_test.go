@@ -961,7 +961,7 @@ func testAccCheckENIExists(n string, v *ec2.NetworkInterface) resource.TestCheck
 			return fmt.Errorf("No EC2 Network Interface ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		output, err := tfec2.FindNetworkInterfaceByID(conn, rs.Primary.ID)
 
@@ -976,7 +976,7 @@ func testAccCheckENIExists(n string, v *ec2.NetworkInterface) resource.TestCheck
 }
 
 func testAccCheckENIDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_network_interface" {
@@ -1012,7 +1012,7 @@ func testAccCheckENIMakeExternalAttachment(n string, conf *ec2.NetworkInterface)
 			NetworkInterfaceId: conf.NetworkInterfaceId,
 		}
 
-		_, err := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn.AttachNetworkInterface(input)
+		_, err := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn().AttachNetworkInterface(input)
 
 		if err != nil {
 			return fmt.Errorf("error attaching ENI: %w", err)
@@ -65,7 +65,7 @@ func TestAccEC2PrefixListDataSource_nameDoesNotOverrideFilter(t *testing.T) {
 
 func testAccPrefixListCheckDataSource(name string) resource.TestCheckFunc {
 	getPrefixListId := func(name string) (string, error) {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		input := ec2.DescribePrefixListsInput{
 			Filters: tfec2.BuildAttributeFilterList(map[string]string{
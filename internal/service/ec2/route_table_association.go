@@ -45,7 +45,7 @@ func ResourceRouteTableAssociation() *schema.Resource {
 }
 
 func resourceRouteTableAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	routeTableID := d.Get("route_table_id").(string)
 	input := &ec2.AssociateRouteTableInput{
@@ -84,7 +84,7 @@ func resourceRouteTableAssociationCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceRouteTableAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
 		return FindRouteTableAssociationByID(conn, d.Id())
@@ -110,7 +110,7 @@ func resourceRouteTableAssociationRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceRouteTableAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.ReplaceRouteTableAssociationInput{
 		AssociationId: aws.String(d.Id()),
@@ -146,7 +146,7 @@ func resourceRouteTableAssociationUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceRouteTableAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	return ec2RouteTableAssociationDelete(conn, d.Id())
 }
@@ -162,7 +162,7 @@ func resourceRouteTableAssociationImport(d *schema.ResourceData, meta interface{
 
 	log.Printf("[DEBUG] Importing route table association, target: %s, route table: %s", targetID, routeTableID)
 
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	routeTable, err := FindRouteTableByID(conn, routeTableID)
 
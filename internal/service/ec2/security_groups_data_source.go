@@ -38,7 +38,7 @@ func DataSourceSecurityGroups() *schema.Resource {
 }
 
 func dataSourceSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeSecurityGroupsInput{}
 
@@ -410,7 +410,7 @@ func testAccSpotInstanceRequestTime(t *testing.T, duration string) string {
 }
 
 func testAccCheckSpotInstanceRequestDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_spot_instance_request" {
@@ -476,7 +476,7 @@ func testAccCheckSpotInstanceRequestExists(
 			return fmt.Errorf("No SNS subscription with that ARN exists")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		params := &ec2.DescribeSpotInstanceRequestsInput{
 			SpotInstanceRequestIds: []*string{&rs.Primary.ID},
@@ -538,7 +538,7 @@ func testAccCheckSpotInstanceRequestAttributesCheckSIRWithoutSpot(
 
 func testAccCheckSpotInstanceRequest_InstanceAttributes(sir *ec2.SpotInstanceRequest, rName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		instance, err := tfec2.InstanceFindByID(conn, aws.StringValue(sir.InstanceId))
 		if err != nil {
 			if tfawserr.ErrMessageContains(err, "InvalidInstanceID.NotFound", "") {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -340,7 +341,7 @@ func sweepCapacityReservations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	resp, err := conn.DescribeCapacityReservations(&ec2.DescribeCapacityReservationsInput{})
 
@@ -384,7 +385,7 @@ func sweepCarrierGateway(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeCarrierGatewaysInput{}
 	var sweeperErrs *multierror.Error
 
@@ -426,7 +427,7 @@ func sweepClientVPNEndpoints(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeClientVpnEndpointsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -440,7 +441,7 @@ func sweepClientVPNEndpoints(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.ClientVpnEndpointId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, newSweepResource(r, d, client, v.Tags))
 		}
 
 		return !lastPage
@@ -469,7 +470,7 @@ func sweepClientVPNNetworkAssociations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeClientVpnEndpointsInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -536,9 +537,14 @@ func sweepEBSVolumes(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
+	sweepResources := make([]*sweep.SweepResource, 0)
 
 	err = conn.DescribeVolumesPages(&ec2.DescribeVolumesInput{}, func(page *ec2.DescribeVolumesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
 		for _, volume := range page.Volumes {
 			id := aws.StringValue(volume.VolumeId)
 
@@ -547,16 +553,11 @@ func sweepEBSVolumes(region string) error {
 				continue
 			}
 
-			input := &ec2.DeleteVolumeInput{
-				VolumeId: aws.String(id),
-			}
-
-			log.Printf("[INFO] Deleting EC2 EBS Volume: %s", id)
-			_, err := conn.DeleteVolume(input)
+			r := ResourceEBSVolume()
+			d := r.Data(nil)
+			d.SetId(id)
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting EC2 EBS Volume (%s): %s", id, err)
-			}
+			sweepResources = append(sweepResources, newSweepResource(r, d, client, volume.Tags).WithCreationTime(aws.TimeValue(volume.CreateTime)))
 		}
 
 		return !lastPage
@@ -568,7 +569,13 @@ func sweepEBSVolumes(region string) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving EC2 EBS Volumes: %s", err)
+		return fmt.Errorf("error listing EC2 EBS Volumes (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 EBS Volumes (%s): %w", region, err)
 	}
 
 	return nil
@@ -582,7 +589,7 @@ func sweepEBSSnapshots(region string) error {
 	input := &ec2.DescribeSnapshotsInput{
 		OwnerIds: aws.StringSlice([]string{"self"}),
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 
 	err = conn.DescribeSnapshotsPages(input, func(page *ec2.DescribeSnapshotsOutput, lastPage bool) bool {
@@ -595,7 +602,7 @@ func sweepEBSSnapshots(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.SnapshotId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, newSweepResource(r, d, client, v.Tags).WithCreationTime(aws.TimeValue(v.StartTime)))
 		}
 
 		return !lastPage
@@ -625,7 +632,7 @@ func sweepEgressOnlyInternetGateways(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	input := &ec2.DescribeEgressOnlyInternetGatewaysInput{}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 
 	err = conn.DescribeEgressOnlyInternetGatewaysPages(input, func(page *ec2.DescribeEgressOnlyInternetGatewaysOutput, lastPage bool) bool {
@@ -638,7 +645,7 @@ func sweepEgressOnlyInternetGateways(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.EgressOnlyInternetGatewayId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, newSweepResource(r, d, client, v.Tags))
 		}
 
 		return !lastPage
@@ -669,7 +676,7 @@ func sweepEIPs(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	// There is currently no paginator or Marker/NextToken
 	input := &ec2.DescribeAddressesInput{}
@@ -709,7 +716,7 @@ func sweepEIPs(region string) error {
 			d.SetId(aws.StringValue(address.PublicIp))
 		}
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, newSweepResource(r, d, client, address.Tags))
 	}
 
 	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
@@ -729,7 +736,7 @@ func sweepFlowLogs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeFlowLogsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -743,7 +750,7 @@ func sweepFlowLogs(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(flowLog.FlowLogId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, newSweepResource(r, d, client, flowLog.Tags).WithCreationTime(aws.TimeValue(flowLog.CreationTime)))
 		}
 
 		return !lastPage
@@ -772,7 +779,7 @@ func sweepHosts(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeHostsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -786,7 +793,7 @@ func sweepHosts(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(host.HostId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, newSweepResource(r, d, client, host.Tags).WithCreationTime(aws.TimeValue(host.AllocationTime)))
 		}
 
 		return !lastPage
@@ -817,7 +824,7 @@ func sweepInstances(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -844,7 +851,7 @@ func sweepInstances(region string) error {
 				d.SetId(id)
 				d.Set("disable_api_termination", false)
 
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, newSweepResource(r, d, client, instance.Tags).WithCreationTime(aws.TimeValue(instance.LaunchTime)))
 			}
 		}
 		return !lastPage
@@ -871,7 +878,7 @@ func sweepInternetGateways(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	defaultVPCID := ""
 	describeVpcsInput := &ec2.DescribeVpcsInput{
@@ -924,7 +931,7 @@ func sweepInternetGateways(region string) error {
 				d.Set("vpc_id", internetGateway.Attachments[0].VpcId)
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, newSweepResource(r, d, client, internetGateway.Tags))
 		}
 
 		return !lastPage
@@ -953,63 +960,63 @@ func sweepKeyPairs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
+	sweepResources := make([]*sweep.SweepResource, 0)
 
-	log.Printf("Destroying the tmp keys in (%s)", client.(*conns.AWSClient).Region)
+	output, err := conn.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Key Pair sweep for %s: %s", region, err)
+		return nil
+	}
 
-	resp, err := conn.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{})
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping EC2 Key Pair sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error describing key pairs in Sweeper: %s", err)
+		return fmt.Errorf("error listing EC2 Key Pairs (%s): %w", region, err)
 	}
 
-	keyPairs := resp.KeyPairs
-	for _, d := range keyPairs {
-		_, err := conn.DeleteKeyPair(&ec2.DeleteKeyPairInput{
-			KeyName: d.KeyName,
-		})
+	for _, v := range output.KeyPairs {
+		name := aws.StringValue(v.KeyName)
+		r := ResourceKeyPair()
+		d := r.Data(nil)
+		d.SetId(name)
 
-		if err != nil {
-			return fmt.Errorf("Error deleting key pairs in Sweeper: %s", err)
-		}
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).WithName(name).WithTags(KeyValueTags(v.Tags).IgnoreAWS().Map()))
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Key Pairs (%s): %w", region, err)
 	}
+
 	return nil
 }
 
 func sweepLaunchTemplates(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
-
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeLaunchTemplatesInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
 
 	err = conn.DescribeLaunchTemplatesPages(input, func(page *ec2.DescribeLaunchTemplatesOutput, lastPage bool) bool {
-		for _, launchTemplate := range page.LaunchTemplates {
-			id := aws.StringValue(launchTemplate.LaunchTemplateId)
-			input := &ec2.DeleteLaunchTemplateInput{
-				LaunchTemplateId: launchTemplate.LaunchTemplateId,
-			}
+		if page == nil {
+			return !lastPage
+		}
 
-			log.Printf("[INFO] Deleting EC2 Launch Template: %s", id)
-			_, err := conn.DeleteLaunchTemplate(input)
+		for _, v := range page.LaunchTemplates {
+			r := ResourceLaunchTemplate()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.LaunchTemplateId))
 
-			if tfawserr.ErrMessageContains(err, "InvalidLaunchTemplateId.NotFound", "") {
-				continue
-			}
+			sweepResource := sweep.NewSweepResource(r, d, client).
+				WithCreationTime(aws.TimeValue(v.CreateTime)).
+				WithName(aws.StringValue(v.LaunchTemplateName)).
+				WithTags(KeyValueTags(v.Tags).IgnoreAWS().Map())
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting EC2 Launch Template (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweepResource)
 		}
 
 		return !lastPage
@@ -1021,10 +1028,16 @@ func sweepLaunchTemplates(region string) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error describing EC2 Launch Templates: %w", err)
+		return fmt.Errorf("error listing EC2 Launch Templates (%s): %w", region, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Launch Templates (%s): %w", region, err)
+	}
+
+	return nil
 }
 
 func sweepNATGateways(region string) error {
@@ -1033,7 +1046,7 @@ func sweepNATGateways(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	input := &ec2.DescribeNatGatewaysInput{}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 
 	err = conn.DescribeNatGatewaysPages(input, func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool {
@@ -1046,7 +1059,7 @@ func sweepNATGateways(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.NatGatewayId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, newSweepResource(r, d, client, v.Tags).WithCreationTime(aws.TimeValue(v.CreateTime)))
 		}
 
 		return !lastPage
@@ -1076,7 +1089,7 @@ func sweepNetworkACLs(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	input := &ec2.DescribeNetworkAclsInput{}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 
 	err = conn.DescribeNetworkAclsPages(input, func(page *ec2.DescribeNetworkAclsOutput, lastPage bool) bool {
@@ -1101,7 +1114,7 @@ func sweepNetworkACLs(region string) error {
 
 			d.Set("vpc_id", v.VpcId)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, newSweepResource(r, d, client, v.Tags))
 		}
 
 		return !lastPage
@@ -1130,7 +1143,7 @@ func sweepNetworkInterfaces(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	err = conn.DescribeNetworkInterfacesPages(&ec2.DescribeNetworkInterfacesInput{}, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
 		if page == nil {
@@ -1175,7 +1188,7 @@ func sweepPlacementGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribePlacementGroupsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -1195,7 +1208,7 @@ func sweepPlacementGroups(region string) error {
 		d := r.Data(nil)
 		d.SetId(aws.StringValue(placementGroup.GroupName))
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, newSweepResource(r, d, client, placementGroup.Tags))
 	}
 
 	err = sweep.SweepOrchestrator(sweepResources)
@@ -1214,7 +1227,7 @@ func sweepRouteTables(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -1331,7 +1344,7 @@ func sweepSecurityGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeSecurityGroupsInput{}
 
@@ -1424,7 +1437,7 @@ func sweepSpotFleetRequests(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -1446,7 +1459,7 @@ func sweepSpotFleetRequests(region string) error {
 			d.SetId(id)
 			d.Set("terminate_instances_with_expiration", true)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, newSweepResource(r, d, client, config.Tags).WithCreationTime(aws.TimeValue(config.CreateTime)))
 		}
 
 		return !lastPage
@@ -1473,7 +1486,7 @@ func sweepSubnets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeSubnetsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -1492,7 +1505,7 @@ func sweepSubnets(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.SubnetId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, newSweepResource(r, d, client, v.Tags))
 		}
 
 		return !lastPage
@@ -1521,7 +1534,7 @@ func sweepTransitGatewayPeeringAttachments(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeTransitGatewayPeeringAttachmentsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -1579,7 +1592,7 @@ func sweepTransitGateways(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeTransitGatewaysInput{}
 
 	for {
@@ -1660,7 +1673,7 @@ func sweepTransitGatewayVPCAttachments(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeTransitGatewayAttachmentsInput{}
 
 	for {
@@ -1722,7 +1735,7 @@ func sweepVPCDHCPOptions(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	input := &ec2.DescribeDhcpOptionsInput{}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 
 	err = conn.DescribeDhcpOptionsPages(input, func(page *ec2.DescribeDhcpOptionsOutput, lastPage bool) bool {
@@ -1762,7 +1775,7 @@ func sweepVPCDHCPOptions(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.DhcpOptionsId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, newSweepResource(r, d, client, v.Tags))
 		}
 
 		return !lastPage
@@ -1793,7 +1806,7 @@ func sweepVPCEndpointServices(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -1853,7 +1866,7 @@ func sweepVPCEndpoints(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -1912,7 +1925,7 @@ func sweepVPCPeeringConnections(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeVpcPeeringConnectionsInput{}
 
 	err = conn.DescribeVpcPeeringConnectionsPages(input, func(page *ec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool {
@@ -1975,7 +1988,7 @@ func sweepVPCs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeVpcsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -1994,7 +2007,7 @@ func sweepVPCs(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.VpcId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, newSweepResource(r, d, client, v.Tags))
 		}
 
 		return !lastPage
@@ -2023,7 +2036,7 @@ func sweepVPNConnections(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeVpnConnectionsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -2047,7 +2060,7 @@ func sweepVPNConnections(region string) error {
 		d := r.Data(nil)
 		d.SetId(aws.StringValue(v.VpnConnectionId))
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, newSweepResource(r, d, client, v.Tags))
 	}
 
 	err = sweep.SweepOrchestrator(sweepResources)
@@ -2064,7 +2077,7 @@ func sweepVPNGateways(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeVpnGatewaysInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -2096,7 +2109,7 @@ func sweepVPNGateways(region string) error {
 			}
 		}
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, newSweepResource(r, d, client, v.Tags))
 	}
 
 	err = sweep.SweepOrchestrator(sweepResources)
@@ -2113,7 +2126,7 @@ func sweepCustomerGateways(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeCustomerGatewaysInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -2137,7 +2150,7 @@ func sweepCustomerGateways(region string) error {
 		d := r.Data(nil)
 		d.SetId(aws.StringValue(v.CustomerGatewayId))
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, newSweepResource(r, d, client, v.Tags))
 	}
 
 	err = sweep.SweepOrchestrator(sweepResources)
@@ -2154,7 +2167,7 @@ func sweepIPAMPoolCIDRs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeIpamPoolsInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -2221,7 +2234,7 @@ func sweepIPAMPools(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeIpamPoolsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -2235,7 +2248,7 @@ func sweepIPAMPools(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.IpamPoolId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, newSweepResource(r, d, client, v.Tags))
 		}
 
 		return !lastPage
@@ -2264,7 +2277,7 @@ func sweepIPAMScopes(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeIpamScopesInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -2285,7 +2298,7 @@ func sweepIPAMScopes(region string) error {
 			d := r.Data(nil)
 			d.SetId(scopeID)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, newSweepResource(r, d, client, v.Tags))
 		}
 
 		return !lastPage
@@ -2314,7 +2327,7 @@ func sweepIPAMs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeIpamsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -2328,7 +2341,7 @@ func sweepIPAMs(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.IpamId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, newSweepResource(r, d, client, v.Tags))
 		}
 
 		return !lastPage
@@ -2360,7 +2373,7 @@ func sweepAMIs(region string) error {
 	input := &ec2.DescribeImagesInput{
 		Owners: aws.StringSlice([]string{"self"}),
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 
 	output, err := conn.DescribeImages(input)
@@ -2379,7 +2392,13 @@ func sweepAMIs(region string) error {
 		d := r.Data(nil)
 		d.SetId(aws.StringValue(v.ImageId))
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResource := newSweepResource(r, d, client, v.Tags)
+
+		if v, err := time.Parse(time.RFC3339, aws.StringValue(v.CreationDate)); err == nil {
+			sweepResource.WithCreationTime(v)
+		}
+
+		sweepResources = append(sweepResources, sweepResource)
 	}
 
 	err = sweep.SweepOrchestrator(sweepResources)
@@ -2390,3 +2409,15 @@ func sweepAMIs(region string) error {
 
 	return nil
 }
+
+// newSweepResource returns a sweep resource with the EC2 resource's tags, and its Name tag as its name.
+func newSweepResource(r *schema.Resource, d *schema.ResourceData, client interface{}, tags []*ec2.Tag) *sweep.SweepResource {
+	keyValueTags := KeyValueTags(tags).IgnoreAWS().Map()
+	sweepResource := sweep.NewSweepResource(r, d, client).WithTags(keyValueTags)
+
+	if v, ok := keyValueTags["Name"]; ok {
+		sweepResource.WithName(v)
+	}
+
+	return sweepResource
+}
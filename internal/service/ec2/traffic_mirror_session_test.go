@@ -167,7 +167,7 @@ func testAccCheckTrafficMirrorSessionExists(name string, session *ec2.TrafficMir
 			return fmt.Errorf("No ID set for %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		out, err := conn.DescribeTrafficMirrorSessions(&ec2.DescribeTrafficMirrorSessionsInput{
 			TrafficMirrorSessionIds: []*string{
 				aws.String(rs.Primary.ID),
@@ -317,7 +317,7 @@ resource "aws_ec2_traffic_mirror_session" "test" {
 }
 
 func testAccPreCheckTrafficMirrorSession(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	_, err := conn.DescribeTrafficMirrorSessions(&ec2.DescribeTrafficMirrorSessionsInput{})
 
@@ -331,7 +331,7 @@ func testAccPreCheckTrafficMirrorSession(t *testing.T) {
 }
 
 func testAccCheckTrafficMirrorSessionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_traffic_mirror_session" {
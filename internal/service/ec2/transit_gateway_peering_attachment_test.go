@@ -192,7 +192,7 @@ func testAccCheckTransitGatewayPeeringAttachmentExists(resourceName string, tran
 			return fmt.Errorf("No EC2 Transit Gateway Peering Attachment ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		attachment, err := tfec2.DescribeTransitGatewayPeeringAttachment(conn, rs.Primary.ID)
 
@@ -215,7 +215,7 @@ func testAccCheckTransitGatewayPeeringAttachmentExists(resourceName string, tran
 }
 
 func testAccCheckTransitGatewayPeeringAttachmentDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway_peering_attachment" {
@@ -246,7 +246,7 @@ func testAccCheckTransitGatewayPeeringAttachmentDestroy(s *terraform.State) erro
 
 func testAccCheckTransitGatewayPeeringAttachmentDisappears(transitGatewayPeeringAttachment *ec2.TransitGatewayPeeringAttachment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		input := &ec2.DeleteTransitGatewayPeeringAttachmentInput{
 			TransitGatewayAttachmentId: transitGatewayPeeringAttachment.TransitGatewayAttachmentId,
@@ -27,7 +27,7 @@ func DataSourceTransitGatewayRouteTables() *schema.Resource {
 }
 
 func dataSourceTransitGatewayRouteTablesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeTransitGatewayRouteTablesInput{}
 
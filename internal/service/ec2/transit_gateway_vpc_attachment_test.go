@@ -450,7 +450,7 @@ func testAccCheckTransitGatewayVPCAttachmentExists(resourceName string, transitG
 			return fmt.Errorf("No EC2 Transit Gateway VPC Attachment ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		attachment, err := tfec2.DescribeTransitGatewayVPCAttachment(conn, rs.Primary.ID)
 
@@ -473,7 +473,7 @@ func testAccCheckTransitGatewayVPCAttachmentExists(resourceName string, transitG
 }
 
 func testAccCheckTransitGatewayVPCAttachmentDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway_route_table" {
@@ -504,7 +504,7 @@ func testAccCheckTransitGatewayVPCAttachmentDestroy(s *terraform.State) error {
 
 func testAccCheckTransitGatewayVPCAttachmentDisappears(transitGatewayVpcAttachment *ec2.TransitGatewayVpcAttachment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		input := &ec2.DeleteTransitGatewayVpcAttachmentInput{
 			TransitGatewayAttachmentId: transitGatewayVpcAttachment.TransitGatewayAttachmentId,
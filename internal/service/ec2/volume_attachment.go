@@ -76,7 +76,7 @@ func ResourceVolumeAttachment() *schema.Resource {
 }
 
 func resourceVolumeAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	name := d.Get("device_name").(string)
 	iID := d.Get("instance_id").(string)
 	vID := d.Get("volume_id").(string)
@@ -156,7 +156,7 @@ func resourceVolumeAttachmentCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceVolumeAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	request := &ec2.DescribeVolumesInput{
 		VolumeIds: []*string{aws.String(d.Get("volume_id").(string))},
@@ -195,7 +195,7 @@ func resourceVolumeAttachmentUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceVolumeAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if _, ok := d.GetOk("skip_destroy"); ok {
 		return nil
@@ -167,7 +167,7 @@ func ResourceVPC() *schema.Resource {
 }
 
 func resourceVPCCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -246,7 +246,7 @@ func resourceVPCCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPCRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -384,7 +384,7 @@ func resourceVPCRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPCUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("enable_dns_hostnames") {
 		if err := modifyVPCDnsHostnames(conn, d.Id(), d.Get("enable_dns_hostnames").(bool)); err != nil {
@@ -460,7 +460,7 @@ func resourceVPCUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPCDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DeleteVpcInput{
 		VpcId: aws.String(d.Id()),
@@ -119,7 +119,7 @@ func TestAccEC2VPCDHCPOptions_disappears(t *testing.T) {
 }
 
 func testAccCheckDHCPOptionsDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_dhcp_options" {
@@ -153,7 +153,7 @@ func testAccCheckDHCPOptionsExists(n string, v *ec2.DhcpOptions) resource.TestCh
 			return fmt.Errorf("No EC2 DHCP Options Set ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		output, err := tfec2.FindDHCPOptionsByID(conn, rs.Primary.ID)
 
@@ -76,7 +76,7 @@ const (
 )
 
 func resourceVPCIpamCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	current_region := meta.(*conns.AWSClient).Region
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -112,7 +112,7 @@ func resourceVPCIpamCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPCIpamRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -150,7 +150,7 @@ func resourceVPCIpamRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPCIpamUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -203,7 +203,7 @@ func resourceVPCIpamUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPCIpamDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DeleteIpamInput{
 		IpamId: aws.String(d.Id()),
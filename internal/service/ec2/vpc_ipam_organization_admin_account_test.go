@@ -49,7 +49,7 @@ func TestAccVPCIpamOrganizationAdminAccount_basic(t *testing.T) {
 }
 
 func testAccCheckVPCIpamOrganizationAdminAccountDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_ipam_organization_admin_account" {
@@ -88,7 +88,7 @@ func testAccCheckVPCIpamOrganizationAdminAccountExists(n string, org *organizati
 
 		accountID := rs.Primary.ID
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn()
 		input := &organizations.ListDelegatedAdministratorsInput{
 			ServicePrincipal: aws.String(tfec2.Ipam_service_principal),
 		}
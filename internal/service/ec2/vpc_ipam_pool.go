@@ -123,7 +123,7 @@ const (
 )
 
 func ResourceVPCIpamPoolCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -190,7 +190,7 @@ func ResourceVPCIpamPoolCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func ResourceVPCIpamPoolRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -236,7 +236,7 @@ func ResourceVPCIpamPoolRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func ResourceVPCIpamPoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -298,7 +298,7 @@ func ResourceVPCIpamPoolUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func ResourceVPCIpamPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[DEBUG] Deleting IPAM Pool: %s", d.Id())
 	_, err := conn.DeleteIpamPool(&ec2.DeleteIpamPoolInput{
@@ -109,7 +109,7 @@ func testAccCheckVPCIpamAllocationExists(n string, allocation *ec2.IpamPoolAlloc
 		}
 
 		id := rs.Primary.ID
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		cidr_allocation, _, err := tfec2.FindIpamPoolCidrAllocation(conn, id)
 
 		if err != nil {
@@ -132,7 +132,7 @@ func testAccCheckVPCIpamCidrPrefix(allocation *ec2.IpamPoolAllocation, expected
 }
 
 func testAccCheckVPCIpamPoolAllocationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_ipam_pool_cidr_allocation" {
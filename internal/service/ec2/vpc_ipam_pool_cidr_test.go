@@ -49,7 +49,7 @@ func testAccCheckVPCIpamCidrExists(n string, cidr *ec2.IpamPoolCidr) resource.Te
 		}
 
 		id := rs.Primary.ID
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		found_cidr, _, err := tfec2.FindIpamPoolCidr(conn, id)
 
 		if err != nil {
@@ -62,7 +62,7 @@ func testAccCheckVPCIpamCidrExists(n string, cidr *ec2.IpamPoolCidr) resource.Te
 }
 
 func testAccCheckVPCIpamProvisionedPoolCidrDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_ipam_pool_cidr" {
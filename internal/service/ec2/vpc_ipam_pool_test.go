@@ -133,7 +133,7 @@ func testAccCheckVPCIpamPoolExists(n string, pool *ec2.IpamPool) resource.TestCh
 		}
 
 		id := rs.Primary.ID
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		found_pool, err := tfec2.FindIpamPoolById(conn, id)
 
 		if err != nil {
@@ -146,7 +146,7 @@ func testAccCheckVPCIpamPoolExists(n string, pool *ec2.IpamPool) resource.TestCh
 }
 
 func testAccCheckVPCIpamPoolDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_ipam_pool" {
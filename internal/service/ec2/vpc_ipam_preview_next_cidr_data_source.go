@@ -55,7 +55,7 @@ func DataSourceVPCIpamPreviewNextCidr() *schema.Resource {
 }
 
 func dataSourceVPCIpamPreviewNextCidrRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	poolId := d.Get("ipam_pool_id").(string)
 
 	input := &ec2.AllocateIpamPoolCidrInput{
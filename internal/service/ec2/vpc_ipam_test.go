@@ -137,7 +137,7 @@ func TestAccVPCIpam_tags(t *testing.T) {
 }
 
 func testAccCheckVPCIpamDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_ipam" {
@@ -1432,7 +1432,7 @@ func TestAccEC2VPNConnection_transitGatewayIDToVPNGatewayID(t *testing.T) {
 }
 
 func testAccVPNConnectionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpn_connection" {
@@ -1466,7 +1466,7 @@ func testAccVPNConnectionExists(n string, v *ec2.VpnConnection) resource.TestChe
 			return fmt.Errorf("No EC2 VPN Connection ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		output, err := tfec2.FindVPNConnectionByID(conn, rs.Primary.ID)
 
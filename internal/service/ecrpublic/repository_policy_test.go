@@ -128,7 +128,7 @@ func TestAccECRPublicRepositoryPolicy_iam(t *testing.T) {
 }
 
 func testAccCheckRepositoryPolicyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRPublicConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRPublicConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecrpublic_repository_policy" {
@@ -162,7 +162,7 @@ func testAccCheckRepositoryPolicyExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("No ECR Public Repository Policy ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRPublicConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRPublicConn()
 
 		_, err := tfecrpublic.FindRepositoryPolicyByName(conn, rs.Primary.ID)
 
@@ -198,7 +198,7 @@ func ResourceService() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("elasticloadbalancing", "targetgroup"),
 						},
 
 						"container_name": {
@@ -437,7 +437,7 @@ func resourceServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.
 }
 
 func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECSConn
+	conn := meta.(*conns.AWSClient).ECSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -613,7 +613,7 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECSConn
+	conn := meta.(*conns.AWSClient).ECSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -999,7 +999,7 @@ func flattenServiceRegistries(srs []*ecs.ServiceRegistry) []map[string]interface
 }
 
 func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECSConn
+	conn := meta.(*conns.AWSClient).ECSConn()
 	updateService := false
 
 	input := ecs.UpdateServiceInput{
@@ -1171,7 +1171,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECSConn
+	conn := meta.(*conns.AWSClient).ECSConn()
 
 	// Check if it's not already gone
 	output, err := conn.DescribeServices(&ecs.DescribeServicesInput{
@@ -43,7 +43,7 @@ func ResourceTag() *schema.Resource {
 }
 
 func resourceTagCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECSConn
+	conn := meta.(*conns.AWSClient).ECSConn()
 
 	identifier := d.Get("resource_arn").(string)
 	key := d.Get("key").(string)
@@ -59,7 +59,7 @@ func resourceTagCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTagRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECSConn
+	conn := meta.(*conns.AWSClient).ECSConn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
@@ -86,7 +86,7 @@ func resourceTagRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTagUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECSConn
+	conn := meta.(*conns.AWSClient).ECSConn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
@@ -101,7 +101,7 @@ func resourceTagUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTagDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECSConn
+	conn := meta.(*conns.AWSClient).ECSConn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
@@ -114,7 +114,7 @@ func ResourceTaskSet() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("elasticloadbalancing", "targetgroup"),
 						},
 						"container_name": {
 							Type:     schema.TypeString,
@@ -283,7 +283,7 @@ func ResourceTaskSet() *schema.Resource {
 }
 
 func resourceTaskSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECSConn
+	conn := meta.(*conns.AWSClient).ECSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -376,7 +376,7 @@ func resourceTaskSetCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTaskSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECSConn
+	conn := meta.(*conns.AWSClient).ECSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -470,7 +470,7 @@ func resourceTaskSetRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTaskSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECSConn
+	conn := meta.(*conns.AWSClient).ECSConn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		taskSetId, service, cluster, err := TaskSetParseID(d.Id())
@@ -520,7 +520,7 @@ func resourceTaskSetUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTaskSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECSConn
+	conn := meta.(*conns.AWSClient).ECSConn()
 
 	taskSetId, service, cluster, err := TaskSetParseID(d.Id())
 
@@ -54,7 +54,7 @@ func sweepAddon(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	ctx := context.TODO()
-	conn := client.(*conns.AWSClient).EKSConn
+	conn := client.(*conns.AWSClient).EKSConn()
 	input := &eks.ListClustersInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -120,7 +120,7 @@ func sweepClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EKSConn
+	conn := client.(*conns.AWSClient).EKSConn()
 	input := &eks.ListClustersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -163,7 +163,7 @@ func sweepFargateProfiles(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EKSConn
+	conn := client.(*conns.AWSClient).EKSConn()
 	input := &eks.ListClustersInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -230,7 +230,7 @@ func sweepIdentityProvidersConfig(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	ctx := context.TODO()
-	conn := client.(*conns.AWSClient).EKSConn
+	conn := client.(*conns.AWSClient).EKSConn()
 	input := &eks.ListClustersInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -296,7 +296,7 @@ func sweepNodeGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EKSConn
+	conn := client.(*conns.AWSClient).EKSConn()
 	input := &eks.ListClustersInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -2,6 +2,7 @@ package eks
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -17,14 +18,14 @@ const (
 )
 
 func waitAddonCreated(ctx context.Context, conn *eks.EKS, clusterName, addonName string) (*eks.Addon, error) {
-	stateConf := resource.StateChangeConf{
+	stateConf := &resource.StateChangeConf{
 		Pending: []string{eks.AddonStatusCreating, eks.AddonStatusDegraded},
 		Target:  []string{eks.AddonStatusActive},
 		Refresh: statusAddon(ctx, conn, clusterName, addonName),
 		Timeout: addonCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContextWithProgress(ctx, stateConf, fmt.Sprintf("EKS Add-On (%s/%s) create", clusterName, addonName))
 
 	if output, ok := outputRaw.(*eks.Addon); ok {
 		if status, health := aws.StringValue(output.Status), output.Health; status == eks.AddonStatusCreateFailed && health != nil {
@@ -45,7 +46,7 @@ func waitAddonDeleted(ctx context.Context, conn *eks.EKS, clusterName, addonName
 		Timeout: addonDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContextWithProgress(ctx, stateConf, fmt.Sprintf("EKS Add-On (%s/%s) delete", clusterName, addonName))
 
 	if output, ok := outputRaw.(*eks.Addon); ok {
 		if status, health := aws.StringValue(output.Status), output.Health; status == eks.AddonStatusDeleteFailed && health != nil {
@@ -59,14 +60,14 @@ func waitAddonDeleted(ctx context.Context, conn *eks.EKS, clusterName, addonName
 }
 
 func waitAddonUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterName, addonName, id string) (*eks.Update, error) {
-	stateConf := resource.StateChangeConf{
+	stateConf := &resource.StateChangeConf{
 		Pending: []string{eks.UpdateStatusInProgress},
 		Target:  []string{eks.UpdateStatusSuccessful},
 		Refresh: statusAddonUpdate(ctx, conn, clusterName, addonName, id),
 		Timeout: addonUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContextWithProgress(ctx, stateConf, fmt.Sprintf("EKS Add-On (%s/%s) update (%s)", clusterName, addonName, id))
 
 	if output, ok := outputRaw.(*eks.Update); ok {
 		if status := aws.StringValue(output.Status); status == eks.UpdateStatusCancelled || status == eks.UpdateStatusFailed {
@@ -87,7 +88,7 @@ func waitClusterCreated(conn *eks.EKS, name string, timeout time.Duration) (*eks
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateWithProgress(stateConf, fmt.Sprintf("EKS Cluster (%s) create", name))
 
 	if output, ok := outputRaw.(*eks.Cluster); ok {
 		return output, err
@@ -104,7 +105,7 @@ func waitClusterDeleted(conn *eks.EKS, name string, timeout time.Duration) (*eks
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateWithProgress(stateConf, fmt.Sprintf("EKS Cluster (%s) delete", name))
 
 	if output, ok := outputRaw.(*eks.Cluster); ok {
 		return output, err
@@ -121,7 +122,7 @@ func waitClusterUpdateSuccessful(conn *eks.EKS, name, id string, timeout time.Du
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateWithProgress(stateConf, fmt.Sprintf("EKS Cluster (%s) update (%s)", name, id))
 
 	if output, ok := outputRaw.(*eks.Update); ok {
 		if status := aws.StringValue(output.Status); status == eks.UpdateStatusCancelled || status == eks.UpdateStatusFailed {
@@ -142,7 +143,7 @@ func waitFargateProfileCreated(conn *eks.EKS, clusterName, fargateProfileName st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateWithProgress(stateConf, fmt.Sprintf("EKS Fargate Profile (%s/%s) create", clusterName, fargateProfileName))
 
 	if output, ok := outputRaw.(*eks.FargateProfile); ok {
 		return output, err
@@ -159,7 +160,7 @@ func waitFargateProfileDeleted(conn *eks.EKS, clusterName, fargateProfileName st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateWithProgress(stateConf, fmt.Sprintf("EKS Fargate Profile (%s/%s) delete", clusterName, fargateProfileName))
 
 	if output, ok := outputRaw.(*eks.FargateProfile); ok {
 		return output, err
@@ -176,7 +177,7 @@ func waitNodegroupCreated(ctx context.Context, conn *eks.EKS, clusterName, nodeG
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContextWithProgress(ctx, stateConf, fmt.Sprintf("EKS Node Group (%s/%s) create", clusterName, nodeGroupName))
 
 	if output, ok := outputRaw.(*eks.Nodegroup); ok {
 		if status, health := aws.StringValue(output.Status), output.Health; status == eks.NodegroupStatusCreateFailed && health != nil {
@@ -197,7 +198,7 @@ func waitNodegroupDeleted(ctx context.Context, conn *eks.EKS, clusterName, nodeG
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContextWithProgress(ctx, stateConf, fmt.Sprintf("EKS Node Group (%s/%s) delete", clusterName, nodeGroupName))
 
 	if output, ok := outputRaw.(*eks.Nodegroup); ok {
 		if status, health := aws.StringValue(output.Status), output.Health; status == eks.NodegroupStatusDeleteFailed && health != nil {
@@ -218,7 +219,7 @@ func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterNa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContextWithProgress(ctx, stateConf, fmt.Sprintf("EKS Node Group (%s/%s) update (%s)", clusterName, nodeGroupName, id))
 
 	if output, ok := outputRaw.(*eks.Update); ok {
 		if status := aws.StringValue(output.Status); status == eks.UpdateStatusCancelled || status == eks.UpdateStatusFailed {
@@ -232,14 +233,14 @@ func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterNa
 }
 
 func waitOIDCIdentityProviderConfigCreated(ctx context.Context, conn *eks.EKS, clusterName, configName string, timeout time.Duration) (*eks.OidcIdentityProviderConfig, error) {
-	stateConf := resource.StateChangeConf{
+	stateConf := &resource.StateChangeConf{
 		Pending: []string{eks.ConfigStatusCreating},
 		Target:  []string{eks.ConfigStatusActive},
 		Refresh: statusOIDCIdentityProviderConfig(ctx, conn, clusterName, configName),
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContextWithProgress(ctx, stateConf, fmt.Sprintf("EKS Identity Provider Config (%s/%s) create", clusterName, configName))
 
 	if output, ok := outputRaw.(*eks.OidcIdentityProviderConfig); ok {
 		return output, err
@@ -249,14 +250,14 @@ func waitOIDCIdentityProviderConfigCreated(ctx context.Context, conn *eks.EKS, c
 }
 
 func waitOIDCIdentityProviderConfigDeleted(ctx context.Context, conn *eks.EKS, clusterName, configName string, timeout time.Duration) (*eks.OidcIdentityProviderConfig, error) {
-	stateConf := resource.StateChangeConf{
+	stateConf := &resource.StateChangeConf{
 		Pending: []string{eks.ConfigStatusActive, eks.ConfigStatusDeleting},
 		Target:  []string{},
 		Refresh: statusOIDCIdentityProviderConfig(ctx, conn, clusterName, configName),
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContextWithProgress(ctx, stateConf, fmt.Sprintf("EKS Identity Provider Config (%s/%s) delete", clusterName, configName))
 
 	if output, ok := outputRaw.(*eks.OidcIdentityProviderConfig); ok {
 		return output, err
@@ -812,7 +812,7 @@ func testAccCheckClusterRecreated(i, j *elasticache.CacheCluster) resource.TestC
 }
 
 func testAccCheckClusterDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_cluster" {
@@ -841,7 +841,7 @@ func testAccCheckClusterExists(n string, v *elasticache.CacheCluster) resource.T
 			return fmt.Errorf("No cache cluster ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn()
 		resp, err := conn.DescribeCacheClusters(&elasticache.DescribeCacheClustersInput{
 			CacheClusterId: aws.String(rs.Primary.ID),
 		})
@@ -870,7 +870,7 @@ func testAccCheckClusterEC2ClassicExists(n string, v *elasticache.CacheCluster)
 			return fmt.Errorf("No cache cluster ID is set")
 		}
 
-		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).ElastiCacheConn
+		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).ElastiCacheConn()
 
 		input := &elasticache.DescribeCacheClustersInput{
 			CacheClusterId: aws.String(rs.Primary.ID),
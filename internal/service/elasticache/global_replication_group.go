@@ -144,7 +144,7 @@ func elasticacheDescriptionStateFunc(v interface{}) string {
 }
 
 func resourceGlobalReplicationGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElastiCacheConn
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 
 	input := &elasticache.CreateGlobalReplicationGroupInput{
 		GlobalReplicationGroupIdSuffix: aws.String(d.Get("global_replication_group_id_suffix").(string)),
@@ -170,7 +170,7 @@ func resourceGlobalReplicationGroupCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceGlobalReplicationGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElastiCacheConn
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 
 	globalReplicationGroup, err := FindGlobalReplicationGroupByID(conn, d.Id())
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -205,7 +205,7 @@ func resourceGlobalReplicationGroupRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceGlobalReplicationGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElastiCacheConn
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 
 	// Only one field can be changed per request
 	updaters := map[string]elasticacheGlobalReplicationGroupUpdater{}
@@ -247,7 +247,7 @@ func updateElasticacheGlobalReplicationGroup(conn *elasticache.ElastiCache, id s
 }
 
 func resourceGlobalReplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElastiCacheConn
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 
 	// Using Update timeout because the Global Replication Group could be in the middle of an update operation
 	err := DeleteGlobalReplicationGroup(conn, d.Id(), GlobalReplicationGroupDefaultUpdatedTimeout)
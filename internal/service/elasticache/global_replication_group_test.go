@@ -222,7 +222,7 @@ func testAccCheckGlobalReplicationGroupExists(resourceName string, v *elasticach
 			return fmt.Errorf("No ElastiCache Global Replication Group ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn()
 		grg, err := tfelasticache.FindGlobalReplicationGroupByID(conn, rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("error retrieving ElastiCache Global Replication Group (%s): %w", rs.Primary.ID, err)
@@ -239,7 +239,7 @@ func testAccCheckGlobalReplicationGroupExists(resourceName string, v *elasticach
 }
 
 func testAccCheckGlobalReplicationGroupDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_global_replication_group" {
@@ -260,7 +260,7 @@ func testAccCheckGlobalReplicationGroupDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheckGlobalReplicationGroup(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn()
 
 	input := &elasticache.DescribeGlobalReplicationGroupsInput{}
 	_, err := conn.DescribeGlobalReplicationGroups(input)
@@ -29,7 +29,7 @@ func sweepDomains(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).ElasticsearchConn
+	conn := client.(*conns.AWSClient).ElasticsearchConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
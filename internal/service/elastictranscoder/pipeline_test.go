@@ -237,7 +237,7 @@ func testAccCheckPipelineExists(n string, res *elastictranscoder.Pipeline) resou
 			return fmt.Errorf("No Pipeline ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticTranscoderConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticTranscoderConn()
 
 		out, err := conn.ReadPipeline(&elastictranscoder.ReadPipelineInput{
 			Id: aws.String(rs.Primary.ID),
@@ -254,7 +254,7 @@ func testAccCheckPipelineExists(n string, res *elastictranscoder.Pipeline) resou
 }
 
 func testAccCheckElasticTranscoderPipelineDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticTranscoderConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticTranscoderConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elastictranscoder_pipline" {
@@ -279,7 +279,7 @@ func testAccCheckElasticTranscoderPipelineDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticTranscoderConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticTranscoderConn()
 
 	input := &elastictranscoder.ListPipelinesInput{}
 
@@ -198,7 +198,7 @@ func TestAccElasticTranscoderPreset_Video_frameRate(t *testing.T) {
 
 func testAccCheckElasticTranscoderPresetExists(name string, preset *elastictranscoder.Preset) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticTranscoderConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticTranscoderConn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -224,7 +224,7 @@ func testAccCheckElasticTranscoderPresetExists(name string, preset *elastictrans
 
 func testAccCheckElasticTranscoderPresetDisappears(preset *elastictranscoder.Preset) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticTranscoderConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticTranscoderConn()
 		_, err := conn.DeletePreset(&elastictranscoder.DeletePresetInput{
 			Id: preset.Id,
 		})
@@ -234,7 +234,7 @@ func testAccCheckElasticTranscoderPresetDisappears(preset *elastictranscoder.Pre
 }
 
 func testAccCheckElasticTranscoderPresetDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticTranscoderConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticTranscoderConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elastictranscoder_preset" {
@@ -32,7 +32,7 @@ func sweepClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EMRConn
+	conn := client.(*conns.AWSClient).EMRConn()
 
 	input := &emr.ListClustersInput{
 		ClusterStates: []*string{
@@ -88,7 +88,7 @@ func sweepStudios(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).EMRConn
+	conn := client.(*conns.AWSClient).EMRConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var sweeperErrs *multierror.Error
 	input := &emr.ListStudiosInput{}
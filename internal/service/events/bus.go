@@ -49,7 +49,7 @@ func ResourceBus() *schema.Resource {
 }
 
 func resourceBusCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EventsConn
+	conn := meta.(*conns.AWSClient).EventsConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -103,7 +103,7 @@ func resourceBusCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBusRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EventsConn
+	conn := meta.(*conns.AWSClient).EventsConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -154,7 +154,7 @@ func resourceBusRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBusUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EventsConn
+	conn := meta.(*conns.AWSClient).EventsConn()
 
 	arn := d.Get("arn").(string)
 	if d.HasChange("tags_all") {
@@ -176,7 +176,7 @@ func resourceBusUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBusDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EventsConn
+	conn := meta.(*conns.AWSClient).EventsConn()
 	log.Printf("[INFO] Deleting EventBridge event bus (%s)", d.Id())
 	_, err := conn.DeleteEventBus(&eventbridge.DeleteEventBusInput{
 		Name: aws.String(d.Id()),
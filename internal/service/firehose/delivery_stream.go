@@ -127,7 +127,7 @@ func s3ConfigurationSchema() *schema.Schema {
 				"bucket_arn": {
 					Type:         schema.TypeString,
 					Required:     true,
-					ValidateFunc: verify.ValidARN,
+					ValidateFunc: verify.ValidARNForService("s3", "bucket"),
 				},
 
 				"buffer_size": {
@@ -160,13 +160,13 @@ func s3ConfigurationSchema() *schema.Schema {
 				"kms_key_arn": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					ValidateFunc: verify.ValidARN,
+					ValidateFunc: verify.ValidARNForService("kms", "key", "alias"),
 				},
 
 				"role_arn": {
 					Type:         schema.TypeString,
 					Required:     true,
-					ValidateFunc: verify.ValidARN,
+					ValidateFunc: verify.ValidARNForService("iam", "role"),
 				},
 
 				"prefix": {
@@ -928,7 +928,7 @@ func ResourceDeliveryStream() *schema.Resource {
 							Type:         schema.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 					},
 				},
@@ -964,7 +964,7 @@ func ResourceDeliveryStream() *schema.Resource {
 						"bucket_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("s3", "bucket"),
 						},
 
 						"buffer_size": {
@@ -1213,7 +1213,7 @@ func ResourceDeliveryStream() *schema.Resource {
 												"role_arn": {
 													Type:         schema.TypeString,
 													Required:     true,
-													ValidateFunc: verify.ValidARN,
+													ValidateFunc: verify.ValidARNForService("iam", "role"),
 												},
 												"table_name": {
 													Type:     schema.TypeString,
@@ -1240,13 +1240,13 @@ func ResourceDeliveryStream() *schema.Resource {
 						"kms_key_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("kms", "key", "alias"),
 						},
 
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 
 						"prefix": {
@@ -1299,7 +1299,7 @@ func ResourceDeliveryStream() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 
 						"s3_backup_mode": {
@@ -1387,7 +1387,7 @@ func ResourceDeliveryStream() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 
 						"s3_backup_mode": {
@@ -1431,7 +1431,7 @@ func ResourceDeliveryStream() *schema.Resource {
 										Type:         schema.TypeString,
 										Required:     true,
 										ForceNew:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 								},
 							},
@@ -1539,7 +1539,7 @@ func ResourceDeliveryStream() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 
 						"s3_backup_mode": {
@@ -2558,7 +2558,7 @@ func resourceDeliveryStreamCreate(d *schema.ResourceData, meta interface{}) erro
 		return validateError
 	}
 
-	conn := meta.(*conns.AWSClient).FirehoseConn
+	conn := meta.(*conns.AWSClient).FirehoseConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -2719,7 +2719,7 @@ func resourceDeliveryStreamUpdate(d *schema.ResourceData, meta interface{}) erro
 		return validateError
 	}
 
-	conn := meta.(*conns.AWSClient).FirehoseConn
+	conn := meta.(*conns.AWSClient).FirehoseConn()
 
 	sn := d.Get("name").(string)
 	updateInput := &firehose.UpdateDestinationInput{
@@ -2855,7 +2855,7 @@ func resourceDeliveryStreamUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceDeliveryStreamRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FirehoseConn
+	conn := meta.(*conns.AWSClient).FirehoseConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -2899,7 +2899,7 @@ func resourceDeliveryStreamRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceDeliveryStreamDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FirehoseConn
+	conn := meta.(*conns.AWSClient).FirehoseConn()
 
 	sn := d.Get("name").(string)
 	log.Printf("[DEBUG] Deleting Kinesis Firehose Delivery Stream: (%s)", sn)
@@ -180,7 +180,7 @@ func testAccPolicy_tags(t *testing.T) {
 }
 
 func testAccCheckPolicyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).FMSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FMSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_fms_policy" {
@@ -150,7 +150,7 @@ func ResourceDataRepositoryAssociation() *schema.Resource {
 }
 
 func resourceDataRepositoryAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FSxConn
+	conn := meta.(*conns.AWSClient).FSxConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -194,7 +194,7 @@ func resourceDataRepositoryAssociationCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceDataRepositoryAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FSxConn
+	conn := meta.(*conns.AWSClient).FSxConn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -232,7 +232,7 @@ func resourceDataRepositoryAssociationUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceDataRepositoryAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FSxConn
+	conn := meta.(*conns.AWSClient).FSxConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -272,7 +272,7 @@ func resourceDataRepositoryAssociationRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceDataRepositoryAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FSxConn
+	conn := meta.(*conns.AWSClient).FSxConn()
 
 	request := &fsx.DeleteDataRepositoryAssociationInput{
 		ClientRequestToken:     aws.String(resource.UniqueId()),
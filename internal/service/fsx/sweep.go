@@ -66,7 +66,7 @@ func sweepFSXBackups(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).FSxConn
+	conn := client.(*conns.AWSClient).FSxConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 	input := &fsx.DescribeBackupsInput{}
@@ -110,7 +110,7 @@ func sweepFSXLustreFileSystems(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).FSxConn
+	conn := client.(*conns.AWSClient).FSxConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 	input := &fsx.DescribeFileSystemsInput{}
@@ -158,7 +158,7 @@ func sweepFSXOntapFileSystems(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).FSxConn
+	conn := client.(*conns.AWSClient).FSxConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 	input := &fsx.DescribeFileSystemsInput{}
@@ -206,7 +206,7 @@ func sweepFSXOntapStorageVirtualMachine(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).FSxConn
+	conn := client.(*conns.AWSClient).FSxConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 	input := &fsx.DescribeStorageVirtualMachinesInput{}
@@ -250,7 +250,7 @@ func sweepFSXOntapVolume(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).FSxConn
+	conn := client.(*conns.AWSClient).FSxConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 	input := &fsx.DescribeVolumesInput{}
@@ -301,7 +301,7 @@ func sweepFSXOpenzfsFileSystems(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).FSxConn
+	conn := client.(*conns.AWSClient).FSxConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 	input := &fsx.DescribeFileSystemsInput{}
@@ -349,7 +349,7 @@ func sweepFSXOpenzfsVolume(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).FSxConn
+	conn := client.(*conns.AWSClient).FSxConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 	input := &fsx.DescribeVolumesInput{}
@@ -400,7 +400,7 @@ func sweepFSXWindowsFileSystems(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).FSxConn
+	conn := client.(*conns.AWSClient).FSxConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 	input := &fsx.DescribeFileSystemsInput{}
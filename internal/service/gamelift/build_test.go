@@ -204,7 +204,7 @@ func testAccCheckBuildExists(n string, res *gamelift.Build) resource.TestCheckFu
 			return fmt.Errorf("No Gamelift Build ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 		req := &gamelift.DescribeBuildInput{
 			BuildId: aws.String(rs.Primary.ID),
@@ -228,7 +228,7 @@ func testAccCheckBuildExists(n string, res *gamelift.Build) resource.TestCheckFu
 
 func testAccCheckBuildDisappears(res *gamelift.Build) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 		input := &gamelift.DeleteBuildInput{BuildId: res.BuildId}
 
@@ -238,7 +238,7 @@ func testAccCheckBuildDisappears(res *gamelift.Build) resource.TestCheckFunc {
 }
 
 func testAccCheckBuildDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_gamelift_build" {
@@ -265,7 +265,7 @@ func testAccCheckBuildDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 	input := &gamelift.ListBuildsInput{}
 
@@ -483,7 +483,7 @@ func testAccCheckFleetExists(n string, res *gamelift.FleetAttributes) resource.T
 			return fmt.Errorf("No Gamelift Fleet ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 		out, err := conn.DescribeFleetAttributes(&gamelift.DescribeFleetAttributesInput{
 			FleetIds: aws.StringSlice([]string{rs.Primary.ID}),
@@ -513,7 +513,7 @@ func testAccCheckFleetExists(n string, res *gamelift.FleetAttributes) resource.T
 
 func testAccCheckFleetDisappears(res *gamelift.FleetAttributes) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 		input := &gamelift.DeleteFleetInput{FleetId: res.FleetId}
 		err := resource.Retry(60*time.Minute, func() *resource.RetryError {
@@ -539,7 +539,7 @@ func testAccCheckFleetDisappears(res *gamelift.FleetAttributes) resource.TestChe
 }
 
 func testAccCheckFleetDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_gamelift_fleet" {
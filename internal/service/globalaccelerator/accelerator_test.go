@@ -228,7 +228,7 @@ func TestAccGlobalAcceleratorAccelerator_tags(t *testing.T) {
 }
 
 func testAccPreCheckGlobalAccelerator(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorConn()
 
 	input := &globalaccelerator.ListAcceleratorsInput{}
 
@@ -245,7 +245,7 @@ func testAccPreCheckGlobalAccelerator(t *testing.T) {
 
 func testAccCheckGlobalAcceleratorAcceleratorExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorConn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -267,7 +267,7 @@ func testAccCheckGlobalAcceleratorAcceleratorExists(name string) resource.TestCh
 }
 
 func testAccCheckGlobalAcceleratorAcceleratorDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_globalaccelerator_accelerator" {
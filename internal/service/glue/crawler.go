@@ -311,7 +311,7 @@ func ResourceCrawler() *schema.Resource {
 }
 
 func resourceCrawlerCreate(d *schema.ResourceData, meta interface{}) error {
-	glueConn := meta.(*conns.AWSClient).GlueConn
+	glueConn := meta.(*conns.AWSClient).GlueConn()
 	name := d.Get("name").(string)
 
 	crawlerInput, err := createCrawlerInput(d, name, meta.(*conns.AWSClient).DefaultTagsConfig)
@@ -671,7 +671,7 @@ func expandGlueDeltaTarget(cfg map[string]interface{}) *glue.DeltaTarget {
 }
 
 func resourceCrawlerUpdate(d *schema.ResourceData, meta interface{}) error {
-	glueConn := meta.(*conns.AWSClient).GlueConn
+	glueConn := meta.(*conns.AWSClient).GlueConn()
 	name := d.Get("name").(string)
 
 	if d.HasChangesExcept("tags", "tags_all") {
@@ -728,7 +728,7 @@ func resourceCrawlerUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCrawlerRead(d *schema.ResourceData, meta interface{}) error {
-	glueConn := meta.(*conns.AWSClient).GlueConn
+	glueConn := meta.(*conns.AWSClient).GlueConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -928,7 +928,7 @@ func flattenGlueDeltaTargets(deltaTargets []*glue.DeltaTarget) []map[string]inte
 }
 
 func resourceCrawlerDelete(d *schema.ResourceData, meta interface{}) error {
-	glueConn := meta.(*conns.AWSClient).GlueConn
+	glueConn := meta.(*conns.AWSClient).GlueConn()
 
 	log.Printf("[DEBUG] deleting Glue crawler: %s", d.Id())
 	_, err := glueConn.DeleteCrawler(&glue.DeleteCrawlerInput{
@@ -287,7 +287,7 @@ func TestAccGlueSchema_Disappears_registry(t *testing.T) {
 }
 
 func testAccPreCheckSchema(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn()
 
 	_, err := conn.ListRegistries(&glue.ListRegistriesInput{})
 
@@ -312,7 +312,7 @@ func testAccCheckSchemaExists(resourceName string, schema *glue.GetSchemaOutput)
 			return fmt.Errorf("No Glue Schema ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn()
 		output, err := tfglue.FindSchemaByID(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -337,7 +337,7 @@ func testAccCheckSchemaDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn()
 		output, err := tfglue.FindSchemaByID(conn, rs.Primary.ID)
 		if err != nil {
 			if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
@@ -531,7 +531,7 @@ func testAccCheckTriggerExists(resourceName string, trigger *glue.Trigger) resou
 			return fmt.Errorf("No Glue Trigger ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn()
 
 		output, err := tfglue.FindTriggerByName(conn, rs.Primary.ID)
 		if err != nil {
@@ -557,7 +557,7 @@ func testAccCheckTriggerDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn()
 
 		output, err := tfglue.FindTriggerByName(conn, rs.Primary.ID)
 
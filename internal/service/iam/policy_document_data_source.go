@@ -1,6 +1,7 @@
 package iam
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,9 +9,11 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
@@ -25,13 +28,46 @@ func DataSourcePolicyDocument() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Read: dataSourcePolicyDocumentRead,
+		ReadContext: dataSourcePolicyDocumentRead,
 
 		Schema: map[string]*schema.Schema{
 			"json": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"lint": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fail_on_findings": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"policy_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(verify.IAMPolicyType_Values(), false),
+						},
+					},
+				},
+			},
+			"lint_findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"minified_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"minify_action_wildcards": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"override_json": {
 				Type:       schema.TypeString,
 				Optional:   true,
@@ -56,6 +92,17 @@ func DataSourcePolicyDocument() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"split_json": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"split_size_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      6144,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"statement": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -116,7 +163,59 @@ func DataSourcePolicyDocument() *schema.Resource {
 	}
 }
 
-func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := dataSourcePolicyDocumentBuild(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	v, ok := d.GetOk("lint")
+
+	if !ok || len(v.([]interface{})) == 0 {
+		d.Set("lint_findings", nil)
+
+		return nil
+	}
+
+	var failOnFindings bool
+	var policyType string
+
+	if tfMap, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+		failOnFindings = tfMap["fail_on_findings"].(bool)
+		policyType = tfMap["policy_type"].(string)
+	}
+
+	findings, err := verify.LintIAMPolicyJSON(d.Get("json").(string), policyType)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error linting IAM policy document: %w", err))
+	}
+
+	var diags diag.Diagnostics
+	var lintFindings []string
+	severity := diag.Warning
+
+	if failOnFindings {
+		severity = diag.Error
+	}
+
+	for _, finding := range findings {
+		lintFindings = append(lintFindings, finding.String())
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  "IAM policy document lint finding",
+			Detail:   finding.String(),
+		})
+	}
+
+	if err := d.Set("lint_findings", lintFindings); err != nil {
+		return append(diags, diag.FromErr(fmt.Errorf("error setting lint_findings: %w", err))...)
+	}
+
+	return diags
+}
+
+// dataSourcePolicyDocumentBuild merges the source documents, statements and override documents and sets the resulting JSON.
+func dataSourcePolicyDocumentBuild(d *schema.ResourceData) error {
 	mergedDoc := &IAMPolicyDoc{}
 
 	if v, ok := d.GetOk("source_json"); ok {
@@ -276,7 +375,31 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 	}
 	jsonString := string(jsonDoc)
 
+	minifiedDoc, err := mergedDoc.Minify(d.Get("minify_action_wildcards").(bool))
+	if err != nil {
+		return fmt.Errorf("error minifying policy document: %w", err)
+	}
+	minifiedJSON, err := json.Marshal(minifiedDoc)
+	if err != nil {
+		return err
+	}
+
+	splitDocs, err := minifiedDoc.Split(d.Get("split_size_limit").(int))
+	if err != nil {
+		return fmt.Errorf("error splitting policy document: %w", err)
+	}
+	splitJSON := make([]string, len(splitDocs))
+	for i, splitDoc := range splitDocs {
+		v, err := json.Marshal(splitDoc)
+		if err != nil {
+			return err
+		}
+		splitJSON[i] = string(v)
+	}
+
 	d.Set("json", jsonString)
+	d.Set("minified_json", string(minifiedJSON))
+	d.Set("split_json", splitJSON)
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
 	return nil
@@ -315,6 +315,82 @@ func TestAccIAMPolicyDocumentDataSource_version20081017(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_lint(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentLintDataSourceConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "lint_findings.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "lint_findings.0", `invalid: statement "Admin": invalid action (GetObject), expected <service prefix>:<action>`),
+					resource.TestCheckResourceAttr(dataSourceName, "lint_findings.1", `permissive: statement "Admin": allows all actions on all resources`),
+				),
+			},
+			{
+				Config:      testAccPolicyDocumentLintDataSourceConfig(true),
+				ExpectError: regexp.MustCompile(`allows all actions on all resources`),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_minifyAndSplit(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentMinifyAndSplitDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":["s3:ListBucket","s3:Get*"],"Resource":["arn:aws:s3:::c","arn:aws:s3:::b","arn:aws:s3:::a"]}]}`),
+					resource.TestCheckResourceAttr(dataSourceName, "split_json.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyDocumentMinifyAndSplitDataSourceConfig = `
+data "aws_iam_policy_document" "test" {
+  dynamic "statement" {
+    for_each = ["a", "b", "c"]
+
+    content {
+      actions   = ["s3:GetObject", "s3:GetObjectTagging", "s3:ListBucket"]
+      resources = ["arn:aws:s3:::${statement.value}"]
+    }
+  }
+
+  minify_action_wildcards = true
+  split_size_limit        = 150
+}
+`
+
+func testAccPolicyDocumentLintDataSourceConfig(failOnFindings bool) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid       = "Admin"
+    actions   = ["*", "GetObject"]
+    resources = ["*"]
+  }
+
+  lint {
+    policy_type      = "managed"
+    fail_on_findings = %[1]t
+  }
+}
+`, failOnFindings)
+}
+
 var testAccPolicyDocumentConfig = `
 data "aws_partition" "current" {}
 
@@ -0,0 +1,553 @@
+package iam
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+const (
+	policyConditionPrefixForAllValues = "ForAllValues:"
+	policyConditionPrefixForAnyValue  = "ForAnyValue:"
+	policyConditionSuffixIfExists     = "IfExists"
+)
+
+// PolicyEvaluationPrincipal is the principal making a request.
+type PolicyEvaluationPrincipal struct {
+	Type       string
+	Identifier string
+}
+
+// PolicyEvaluationRequest is the request context against which policies are evaluated.
+// Context keys are case-insensitive.
+type PolicyEvaluationRequest struct {
+	Action    string
+	Context   map[string][]string
+	Principal *PolicyEvaluationPrincipal
+	Resource  string
+}
+
+// PolicyEvaluationResult is the result of evaluating policies.
+// Decision is one of the iam.PolicyEvaluationDecisionType values.
+// MatchedStatements are the statements that determined the decision.
+type PolicyEvaluationResult struct {
+	Decision          string
+	MatchedStatements []*IAMPolicyStatement
+}
+
+// EvaluatePolicies evaluates the policy documents' statements for the request, without calling AWS.
+// As in AWS, an explicit deny in any statement overrides any allow and requests that no statement allows are implicitly denied.
+// Permissions boundaries, session policies and service control policies are not distinguished from other policies.
+func EvaluatePolicies(docs []*IAMPolicyDoc, request *PolicyEvaluationRequest) (*PolicyEvaluationResult, error) {
+	context := make(map[string][]string, len(request.Context))
+	for k, v := range request.Context {
+		k = strings.ToLower(k)
+		context[k] = append(context[k], v...)
+	}
+
+	var allows, denies []*IAMPolicyStatement
+
+	for i, doc := range docs {
+		for j, statement := range doc.Statements {
+			ok, err := policyStatementApplies(statement, request, context)
+
+			if err != nil {
+				return nil, fmt.Errorf("policy %d, statement %d: %w", i, j, err)
+			}
+
+			if !ok {
+				continue
+			}
+
+			switch statement.Effect {
+			case "Allow":
+				allows = append(allows, statement)
+			case "Deny":
+				denies = append(denies, statement)
+			default:
+				return nil, fmt.Errorf("policy %d, statement %d: invalid Effect (%s)", i, j, statement.Effect)
+			}
+		}
+	}
+
+	if len(denies) > 0 {
+		return &PolicyEvaluationResult{Decision: iam.PolicyEvaluationDecisionTypeExplicitDeny, MatchedStatements: denies}, nil
+	}
+
+	if len(allows) > 0 {
+		return &PolicyEvaluationResult{Decision: iam.PolicyEvaluationDecisionTypeAllowed, MatchedStatements: allows}, nil
+	}
+
+	return &PolicyEvaluationResult{Decision: iam.PolicyEvaluationDecisionTypeImplicitDeny}, nil
+}
+
+func policyStatementApplies(statement *IAMPolicyStatement, request *PolicyEvaluationRequest, context map[string][]string) (bool, error) {
+	switch {
+	case statement.Actions != nil:
+		if !policyPatternsMatch(policyStringValues(statement.Actions), request.Action, true, nil) {
+			return false, nil
+		}
+	case statement.NotActions != nil:
+		if policyPatternsMatch(policyStringValues(statement.NotActions), request.Action, true, nil) {
+			return false, nil
+		}
+	default:
+		return false, fmt.Errorf("statement has neither Action nor NotAction")
+	}
+
+	// Trust policies have no Resource element.
+	switch {
+	case statement.Resources != nil:
+		if !policyPatternsMatch(policyStringValues(statement.Resources), request.Resource, false, context) {
+			return false, nil
+		}
+	case statement.NotResources != nil:
+		if policyPatternsMatch(policyStringValues(statement.NotResources), request.Resource, false, context) {
+			return false, nil
+		}
+	}
+
+	switch {
+	case len(statement.Principals) > 0:
+		if !policyPrincipalsMatch(statement.Principals, request.Principal) {
+			return false, nil
+		}
+	case len(statement.NotPrincipals) > 0:
+		if request.Principal == nil || policyPrincipalsMatch(statement.NotPrincipals, request.Principal) {
+			return false, nil
+		}
+	}
+
+	for _, condition := range statement.Conditions {
+		ok, err := policyConditionMatches(condition, context)
+
+		if err != nil {
+			return false, err
+		}
+
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func policyPrincipalsMatch(principals IAMPolicyStatementPrincipalSet, principal *PolicyEvaluationPrincipal) bool {
+	if principal == nil {
+		return false
+	}
+
+	for _, p := range principals {
+		if p.Type != "*" && p.Type != principal.Type {
+			continue
+		}
+
+		for _, identifier := range policyStringValues(p.Identifiers) {
+			if policyPrincipalIdentifierMatches(identifier, principal.Identifier) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+var (
+	policyAccountIDRegexp        = regexp.MustCompile(`^\d{12}$`)
+	policyAccountRootARNRegexp   = regexp.MustCompile(`^arn:[^:]+:iam::(\d{12}):root$`)
+	policyAccountPrincipalRegexp = regexp.MustCompile(`^arn:[^:]+:(?:iam|sts)::(\d{12}):`)
+)
+
+// policyPrincipalIdentifierMatches returns whether the principal identifier matches the policy principal.
+// An AWS account ID is equivalent to the account's root user ARN and matches any IAM principal in the account.
+func policyPrincipalIdentifierMatches(pattern, identifier string) bool {
+	if policyPatternsMatch([]string{pattern}, identifier, false, nil) {
+		return true
+	}
+
+	if m := policyAccountRootARNRegexp.FindStringSubmatch(pattern); m != nil {
+		pattern = m[1]
+	}
+
+	if !policyAccountIDRegexp.MatchString(pattern) {
+		return false
+	}
+
+	if m := policyAccountPrincipalRegexp.FindStringSubmatch(identifier); m != nil {
+		identifier = m[1]
+	}
+
+	return pattern == identifier
+}
+
+func policyConditionMatches(condition IAMPolicyStatementCondition, context map[string][]string) (bool, error) {
+	operator := condition.Test
+	forAllValues := strings.HasPrefix(operator, policyConditionPrefixForAllValues)
+	forAnyValue := strings.HasPrefix(operator, policyConditionPrefixForAnyValue)
+	operator = strings.TrimPrefix(strings.TrimPrefix(operator, policyConditionPrefixForAllValues), policyConditionPrefixForAnyValue)
+	ifExists := strings.HasSuffix(operator, policyConditionSuffixIfExists) && operator != policyConditionSuffixIfExists
+	operator = strings.TrimSuffix(operator, policyConditionSuffixIfExists)
+
+	policyValues := policyStringValues(condition.Values)
+	contextValues, exists := context[strings.ToLower(condition.Variable)]
+
+	if operator == "Null" {
+		for _, v := range policyValues {
+			if b, err := strconv.ParseBool(v); err != nil {
+				return false, fmt.Errorf("invalid %s condition value (%s): %w", condition.Test, v, err)
+			} else if b != exists {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	match, negated, err := policyConditionOperator(operator)
+
+	if err != nil {
+		return false, fmt.Errorf("condition operator (%s): %w", condition.Test, err)
+	}
+
+	if !exists {
+		switch {
+		case ifExists, forAllValues:
+			return true, nil
+		case forAnyValue:
+			return false, nil
+		default:
+			return negated, nil
+		}
+	}
+
+	matches := func(v string) (bool, error) {
+		for _, policyValue := range policyValues {
+			ok, err := match(v, policyValue, context)
+
+			if err != nil {
+				return false, fmt.Errorf("condition operator (%s): %w", condition.Test, err)
+			}
+
+			if ok {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	switch {
+	case forAllValues:
+		for _, v := range contextValues {
+			ok, err := matches(v)
+
+			if err != nil {
+				return false, err
+			}
+
+			if ok == negated {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	case forAnyValue:
+		for _, v := range contextValues {
+			ok, err := matches(v)
+
+			if err != nil {
+				return false, err
+			}
+
+			if ok != negated {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	default:
+		for _, v := range contextValues {
+			ok, err := matches(v)
+
+			if err != nil {
+				return false, err
+			}
+
+			if ok {
+				return !negated, nil
+			}
+		}
+
+		return negated, nil
+	}
+}
+
+type policyConditionMatchFunc func(contextValue, policyValue string, context map[string][]string) (bool, error)
+
+// policyConditionOperator returns the function matching a single context value with a single policy value
+// for the condition operator and whether the operator negates the match.
+func policyConditionOperator(operator string) (policyConditionMatchFunc, bool, error) {
+	switch operator {
+	case "StringEquals", "StringNotEquals":
+		return func(v, p string, context map[string][]string) (bool, error) {
+			p, ok := policySubstituteVariables(p, context)
+			return ok && v == p, nil
+		}, operator == "StringNotEquals", nil
+	case "StringEqualsIgnoreCase", "StringNotEqualsIgnoreCase":
+		return func(v, p string, context map[string][]string) (bool, error) {
+			p, ok := policySubstituteVariables(p, context)
+			return ok && strings.EqualFold(v, p), nil
+		}, operator == "StringNotEqualsIgnoreCase", nil
+	case "StringLike", "StringNotLike":
+		return func(v, p string, context map[string][]string) (bool, error) {
+			return policyPatternsMatch([]string{p}, v, false, context), nil
+		}, operator == "StringNotLike", nil
+	case "NumericEquals", "NumericNotEquals", "NumericLessThan", "NumericLessThanEquals", "NumericGreaterThan", "NumericGreaterThanEquals":
+		return func(v, p string, _ map[string][]string) (bool, error) {
+			pf, err := strconv.ParseFloat(p, 64)
+
+			if err != nil {
+				return false, fmt.Errorf("invalid numeric value (%s)", p)
+			}
+
+			vf, err := strconv.ParseFloat(v, 64)
+
+			if err != nil {
+				return false, nil
+			}
+
+			return policyCompare(operator, policyCompareFloats(vf, pf)), nil
+		}, operator == "NumericNotEquals", nil
+	case "DateEquals", "DateNotEquals", "DateLessThan", "DateLessThanEquals", "DateGreaterThan", "DateGreaterThanEquals":
+		return func(v, p string, _ map[string][]string) (bool, error) {
+			pt, err := policyParseDate(p)
+
+			if err != nil {
+				return false, fmt.Errorf("invalid date value (%s)", p)
+			}
+
+			vt, err := policyParseDate(v)
+
+			if err != nil {
+				return false, nil
+			}
+
+			return policyCompare(operator, policyCompareFloats(float64(vt.UnixNano()), float64(pt.UnixNano()))), nil
+		}, operator == "DateNotEquals", nil
+	case "Bool":
+		return func(v, p string, _ map[string][]string) (bool, error) {
+			return strings.EqualFold(v, p), nil
+		}, false, nil
+	case "BinaryEquals":
+		return func(v, p string, _ map[string][]string) (bool, error) {
+			return v == p, nil
+		}, false, nil
+	case "IpAddress", "NotIpAddress":
+		return func(v, p string, _ map[string][]string) (bool, error) {
+			if !strings.Contains(p, "/") {
+				if ip := net.ParseIP(p); ip != nil {
+					return ip.Equal(net.ParseIP(v)), nil
+				}
+			}
+
+			_, ipNet, err := net.ParseCIDR(p)
+
+			if err != nil {
+				return false, fmt.Errorf("invalid IP address value (%s)", p)
+			}
+
+			ip := net.ParseIP(v)
+
+			return ip != nil && ipNet.Contains(ip), nil
+		}, operator == "NotIpAddress", nil
+	case "ArnEquals", "ArnLike", "ArnNotEquals", "ArnNotLike":
+		return func(v, p string, context map[string][]string) (bool, error) {
+			return policyARNMatches(p, v, context), nil
+		}, operator == "ArnNotEquals" || operator == "ArnNotLike", nil
+	}
+
+	return nil, false, fmt.Errorf("unsupported condition operator")
+}
+
+// policyCompare returns whether the comparison of a context value with a policy value satisfies the operator.
+func policyCompare(operator string, cmp int) bool {
+	switch {
+	case strings.HasSuffix(operator, "LessThanEquals"):
+		return cmp <= 0
+	case strings.HasSuffix(operator, "LessThan"):
+		return cmp < 0
+	case strings.HasSuffix(operator, "GreaterThanEquals"):
+		return cmp >= 0
+	case strings.HasSuffix(operator, "GreaterThan"):
+		return cmp > 0
+	default:
+		return cmp == 0
+	}
+}
+
+func policyCompareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// policyParseDate parses an ISO 8601 date or an epoch time in seconds.
+func policyParseDate(s string) (time.Time, error) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(v, 0), nil
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04Z07:00", "2006-01-02"} {
+		if v, err := time.Parse(layout, s); err == nil {
+			return v, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date (%s)", s)
+}
+
+// policyARNMatches returns whether the ARN matches the pattern, comparing each of the ARN's components.
+func policyARNMatches(pattern, arn string, context map[string][]string) bool {
+	patternParts := strings.SplitN(pattern, ":", 6)
+	arnParts := strings.SplitN(arn, ":", 6)
+
+	if len(patternParts) != 6 || len(arnParts) != 6 {
+		return policyPatternsMatch([]string{pattern}, arn, false, context)
+	}
+
+	for i := range patternParts {
+		if !policyPatternsMatch([]string{patternParts[i]}, arnParts[i], false, context) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// policyPatternsMatch returns whether the value matches any of the patterns, which may contain the wildcards "*" and "?".
+// Policy variables in patterns are replaced with values from the request context, if any.
+func policyPatternsMatch(patterns []string, value string, ignoreCase bool, context map[string][]string) bool {
+	for _, pattern := range patterns {
+		if pattern == "*" {
+			return true
+		}
+
+		re, ok := policyPatternRegexp(pattern, ignoreCase, context)
+
+		if ok && re.MatchString(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var policyVariableRegexp = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// policyPatternRegexp compiles the pattern to a regular expression.
+// It returns false if the pattern references a policy variable that has no single value in the request context.
+func policyPatternRegexp(pattern string, ignoreCase bool, context map[string][]string) (*regexp.Regexp, bool) {
+	var sb strings.Builder
+
+	if ignoreCase {
+		sb.WriteString("(?i)")
+	}
+
+	sb.WriteString("(?s)^")
+
+	for len(pattern) > 0 {
+		if loc := policyVariableRegexp.FindStringSubmatchIndex(pattern); loc != nil && loc[0] == 0 && context != nil {
+			v, ok := policyVariableValue(pattern[loc[2]:loc[3]], context)
+
+			if !ok {
+				return nil, false
+			}
+
+			sb.WriteString(regexp.QuoteMeta(v))
+			pattern = pattern[loc[1]:]
+
+			continue
+		}
+
+		switch c := pattern[0]; c {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+
+		pattern = pattern[1:]
+	}
+
+	sb.WriteString("$")
+
+	re, err := regexp.Compile(sb.String())
+
+	return re, err == nil
+}
+
+// policySubstituteVariables replaces policy variables in the value with values from the request context.
+// It returns false if the value references a policy variable that has no single value in the request context.
+func policySubstituteVariables(value string, context map[string][]string) (string, bool) {
+	ok := true
+
+	value = policyVariableRegexp.ReplaceAllStringFunc(value, func(s string) string {
+		v, found := policyVariableValue(s[2:len(s)-1], context)
+
+		if !found {
+			ok = false
+		}
+
+		return v
+	})
+
+	return value, ok
+}
+
+func policyVariableValue(name string, context map[string][]string) (string, bool) {
+	switch name {
+	case "*", "?", "$":
+		return name, true
+	}
+
+	if v := context[strings.ToLower(name)]; len(v) == 1 {
+		return v[0], true
+	}
+
+	return "", false
+}
+
+// policyStringValues returns the string values of a policy element, which is a string or a list of strings.
+func policyStringValues(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		values := make([]string, 0, len(v))
+
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				values = append(values, v)
+			}
+		}
+
+		return values
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,152 @@
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourcePolicyEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePolicyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"decision": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"matched_statement_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"policy_documents": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIAMPolicyJSON,
+				},
+			},
+			"principal": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"resource": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "*",
+			},
+		},
+	}
+}
+
+func dataSourcePolicyEvaluationRead(d *schema.ResourceData, meta interface{}) error {
+	var docs []*IAMPolicyDoc
+
+	for i, v := range d.Get("policy_documents").([]interface{}) {
+		doc := &IAMPolicyDoc{}
+
+		if err := json.Unmarshal([]byte(v.(string)), doc); err != nil {
+			return fmt.Errorf("error parsing policy_documents (item %d): %w", i, err)
+		}
+
+		docs = append(docs, doc)
+	}
+
+	request := &PolicyEvaluationRequest{
+		Action:   d.Get("action").(string),
+		Context:  make(map[string][]string),
+		Resource: d.Get("resource").(string),
+	}
+
+	for _, tfMapRaw := range d.Get("context").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		key := tfMap["key"].(string)
+
+		request.Context[key] = append(request.Context[key], aws.StringValueSlice(flex.ExpandStringList(tfMap["values"].([]interface{})))...)
+	}
+
+	if v, ok := d.GetOk("principal"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		request.Principal = &PolicyEvaluationPrincipal{
+			Identifier: tfMap["identifier"].(string),
+			Type:       tfMap["type"].(string),
+		}
+	}
+
+	result, err := EvaluatePolicies(docs, request)
+
+	if err != nil {
+		return fmt.Errorf("error evaluating IAM policies: %w", err)
+	}
+
+	var sids []string
+	for _, statement := range result.MatchedStatements {
+		if statement.Sid != "" {
+			sids = append(sids, statement.Sid)
+		}
+	}
+
+	id, err := json.Marshal([]interface{}{docs, request})
+
+	if err != nil {
+		return fmt.Errorf("error evaluating IAM policies: %w", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(string(id))))
+	d.Set("allowed", result.Decision == iam.PolicyEvaluationDecisionTypeAllowed)
+	d.Set("decision", result.Decision)
+	d.Set("matched_statement_ids", sids)
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPolicyEvaluationDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig("s3:GetObject", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", iam.PolicyEvaluationDecisionTypeAllowed),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statement_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statement_ids.0", "ReadObjects"),
+				),
+			},
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig("s3:GetObject", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", iam.PolicyEvaluationDecisionTypeExplicitDeny),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statement_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statement_ids.0", "DenyInsecureTransport"),
+				),
+			},
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig("s3:PutObject", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", iam.PolicyEvaluationDecisionTypeImplicitDeny),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statement_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicyEvaluationDataSourceConfig(action, secureTransport string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid       = "ReadObjects"
+    actions   = ["s3:Get*"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::example/*"]
+  }
+
+  statement {
+    sid       = "DenyInsecureTransport"
+    effect    = "Deny"
+    actions   = ["s3:*"]
+    resources = ["*"]
+
+    condition {
+      test     = "Bool"
+      variable = "aws:SecureTransport"
+      values   = ["false"]
+    }
+  }
+}
+
+data "aws_iam_policy_evaluation" "test" {
+  policy_documents = [data.aws_iam_policy_document.test.json]
+  action           = %[1]q
+  resource         = "arn:${data.aws_partition.current.partition}:s3:::example/key"
+
+  context {
+    key    = "aws:SecureTransport"
+    values = [%[2]q]
+  }
+}
+`, action, secureTransport)
+}
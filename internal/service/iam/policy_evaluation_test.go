@@ -0,0 +1,416 @@
+package iam_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestEvaluatePolicies(t *testing.T) {
+	identityPolicy := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "ReadObjects",
+      "Effect": "Allow",
+      "Action": ["s3:Get*", "s3:List*"],
+      "Resource": "arn:aws:s3:::example/*"
+    },
+    {
+      "Sid": "HomeDirectory",
+      "Effect": "Allow",
+      "Action": "s3:PutObject",
+      "Resource": "arn:aws:s3:::example/home/${aws:username}/*"
+    },
+    {
+      "Sid": "DenyInsecureTransport",
+      "Effect": "Deny",
+      "Action": "s3:*",
+      "Resource": "*",
+      "Condition": {"Bool": {"aws:SecureTransport": false}}
+    },
+    {
+      "Sid": "DenyOutsideNetwork",
+      "Effect": "Deny",
+      "NotAction": "s3:List*",
+      "Resource": "*",
+      "Condition": {"NotIpAddress": {"aws:SourceIp": ["10.0.0.0/8", "192.0.2.1"]}}
+    },
+    {
+      "Sid": "AllowTaggedInstances",
+      "Effect": "Allow",
+      "Action": "ec2:StartInstances",
+      "NotResource": "arn:aws:ec2:*:*:instance/i-00000000",
+      "Condition": {
+        "StringEqualsIgnoreCase": {"aws:ResourceTag/Team": "Platform"},
+        "ForAllValues:StringLike": {"aws:TagKeys": ["team", "cost-*"]},
+        "DateLessThan": {"aws:CurrentTime": "2030-01-01T00:00:00Z"},
+        "NumericLessThanEquals": {"aws:MultiFactorAuthAge": "3600"}
+      }
+    }
+  ]
+}`
+
+	trustPolicy := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AssumeFromAccount",
+      "Effect": "Allow",
+      "Principal": {"AWS": "123456789012"},
+      "Action": "sts:AssumeRole",
+      "Condition": {
+        "ArnLike": {"aws:PrincipalArn": "arn:aws:iam::123456789012:role/deploy-*"},
+        "ForAnyValue:StringEquals": {"aws:PrincipalOrgPaths": ["o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"]},
+        "StringLikeIfExists": {"sts:ExternalId": "ext-*"}
+      }
+    }
+  ]
+}`
+
+	secure := map[string][]string{
+		"aws:SecureTransport": {"true"},
+		"aws:SourceIp":        {"10.1.2.3"},
+	}
+
+	testCases := []struct {
+		Name             string
+		Policies         []string
+		Request          *tfiam.PolicyEvaluationRequest
+		ExpectedDecision string
+		ExpectedSids     []string
+		ExpectError      bool
+	}{
+		{
+			Name:             "wildcard action",
+			Policies:         []string{identityPolicy},
+			Request:          &tfiam.PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key", Context: secure},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedSids:     []string{"ReadObjects"},
+		},
+		{
+			Name:             "action case insensitive",
+			Policies:         []string{identityPolicy},
+			Request:          &tfiam.PolicyEvaluationRequest{Action: "S3:getobject", Resource: "arn:aws:s3:::example/key", Context: secure},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedSids:     []string{"ReadObjects"},
+		},
+		{
+			Name:             "resource case sensitive",
+			Policies:         []string{identityPolicy},
+			Request:          &tfiam.PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::EXAMPLE/key", Context: secure},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name:             "policy variable",
+			Policies:         []string{identityPolicy},
+			Request:          &tfiam.PolicyEvaluationRequest{Action: "s3:PutObject", Resource: "arn:aws:s3:::example/home/alice/file", Context: testPolicyEvaluationContext(secure, map[string][]string{"AWS:Username": {"alice"}})},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedSids:     []string{"HomeDirectory"},
+		},
+		{
+			Name:             "policy variable other user",
+			Policies:         []string{identityPolicy},
+			Request:          &tfiam.PolicyEvaluationRequest{Action: "s3:PutObject", Resource: "arn:aws:s3:::example/home/bob/file", Context: testPolicyEvaluationContext(secure, map[string][]string{"aws:username": {"alice"}})},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name:             "policy variable missing",
+			Policies:         []string{identityPolicy},
+			Request:          &tfiam.PolicyEvaluationRequest{Action: "s3:PutObject", Resource: "arn:aws:s3:::example/home/alice/file", Context: secure},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name:             "explicit deny overrides allow",
+			Policies:         []string{identityPolicy},
+			Request:          &tfiam.PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key", Context: map[string][]string{"aws:SecureTransport": {"false"}, "aws:SourceIp": {"10.1.2.3"}}},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeExplicitDeny,
+			ExpectedSids:     []string{"DenyInsecureTransport"},
+		},
+		{
+			Name:             "negated operator",
+			Policies:         []string{identityPolicy},
+			Request:          &tfiam.PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key", Context: map[string][]string{"aws:SecureTransport": {"true"}, "aws:SourceIp": {"203.0.113.1"}}},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeExplicitDeny,
+			ExpectedSids:     []string{"DenyOutsideNetwork"},
+		},
+		{
+			Name:             "negated operator single IP address",
+			Policies:         []string{identityPolicy},
+			Request:          &tfiam.PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key", Context: map[string][]string{"aws:SecureTransport": {"true"}, "aws:SourceIp": {"192.0.2.1"}}},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedSids:     []string{"ReadObjects"},
+		},
+		{
+			Name:             "negated operator missing key",
+			Policies:         []string{identityPolicy},
+			Request:          &tfiam.PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key", Context: map[string][]string{"aws:SecureTransport": {"true"}}},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeExplicitDeny,
+			ExpectedSids:     []string{"DenyOutsideNetwork"},
+		},
+		{
+			Name:             "NotAction",
+			Policies:         []string{identityPolicy},
+			Request:          &tfiam.PolicyEvaluationRequest{Action: "s3:ListBucket", Resource: "arn:aws:s3:::example/key", Context: map[string][]string{"aws:SecureTransport": {"true"}, "aws:SourceIp": {"203.0.113.1"}}},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedSids:     []string{"ReadObjects"},
+		},
+		{
+			Name:     "NotResource and conditions",
+			Policies: []string{identityPolicy},
+			Request: &tfiam.PolicyEvaluationRequest{Action: "ec2:StartInstances", Resource: "arn:aws:ec2:us-west-2:123456789012:instance/i-12345678", Context: testPolicyEvaluationContext(secure, map[string][]string{ //lintignore:AWSAT003,AWSAT005
+				"aws:ResourceTag/Team":      {"platform"},
+				"aws:TagKeys":               {"team", "cost-center"},
+				"aws:CurrentTime":           {"2022-01-01T00:00:00Z"},
+				"aws:MultiFactorAuthAge":    {"60"},
+				"aws:RequestTag/Unrelated":  {"value"},
+				"aws:PrincipalServiceName":  {"ec2.amazonaws.com"},
+				"aws:ResourceTag/Unrelated": {"value"},
+			})},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedSids:     []string{"AllowTaggedInstances"},
+		},
+		{
+			Name:     "NotResource excluded",
+			Policies: []string{identityPolicy},
+			Request: &tfiam.PolicyEvaluationRequest{Action: "ec2:StartInstances", Resource: "arn:aws:ec2:us-west-2:123456789012:instance/i-00000000", Context: testPolicyEvaluationContext(secure, map[string][]string{ //lintignore:AWSAT003,AWSAT005
+				"aws:ResourceTag/Team":   {"platform"},
+				"aws:CurrentTime":        {"2022-01-01T00:00:00Z"},
+				"aws:MultiFactorAuthAge": {"60"},
+			})},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name:     "ForAllValues not all",
+			Policies: []string{identityPolicy},
+			Request: &tfiam.PolicyEvaluationRequest{Action: "ec2:StartInstances", Resource: "arn:aws:ec2:us-west-2:123456789012:instance/i-12345678", Context: testPolicyEvaluationContext(secure, map[string][]string{ //lintignore:AWSAT003,AWSAT005
+				"aws:ResourceTag/Team":   {"platform"},
+				"aws:TagKeys":            {"team", "owner"},
+				"aws:CurrentTime":        {"2022-01-01T00:00:00Z"},
+				"aws:MultiFactorAuthAge": {"60"},
+			})},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name:     "DateLessThan epoch",
+			Policies: []string{identityPolicy},
+			Request: &tfiam.PolicyEvaluationRequest{Action: "ec2:StartInstances", Resource: "arn:aws:ec2:us-west-2:123456789012:instance/i-12345678", Context: testPolicyEvaluationContext(secure, map[string][]string{ //lintignore:AWSAT003,AWSAT005
+				"aws:ResourceTag/Team":   {"platform"},
+				"aws:CurrentTime":        {"1924992000"},
+				"aws:MultiFactorAuthAge": {"60"},
+			})},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name:     "NumericLessThanEquals",
+			Policies: []string{identityPolicy},
+			Request: &tfiam.PolicyEvaluationRequest{Action: "ec2:StartInstances", Resource: "arn:aws:ec2:us-west-2:123456789012:instance/i-12345678", Context: testPolicyEvaluationContext(secure, map[string][]string{ //lintignore:AWSAT003,AWSAT005
+				"aws:ResourceTag/Team":   {"platform"},
+				"aws:CurrentTime":        {"2022-01-01T00:00:00Z"},
+				"aws:MultiFactorAuthAge": {"3601"},
+			})},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name:     "principal account",
+			Policies: []string{trustPolicy},
+			Request: &tfiam.PolicyEvaluationRequest{
+				Action:    "sts:AssumeRole",
+				Principal: &tfiam.PolicyEvaluationPrincipal{Type: "AWS", Identifier: "arn:aws:iam::123456789012:root"}, //lintignore:AWSAT005
+				Context: map[string][]string{
+					"aws:PrincipalArn":      {"arn:aws:iam::123456789012:role/deploy-production"}, //lintignore:AWSAT005
+					"aws:PrincipalOrgPaths": {"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"},
+				},
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedSids:     []string{"AssumeFromAccount"},
+		},
+		{
+			Name:     "principal account role",
+			Policies: []string{trustPolicy},
+			Request: &tfiam.PolicyEvaluationRequest{
+				Action:    "sts:AssumeRole",
+				Principal: &tfiam.PolicyEvaluationPrincipal{Type: "AWS", Identifier: "arn:aws:iam::123456789012:role/deploy-production"}, //lintignore:AWSAT005
+				Context: map[string][]string{
+					"aws:PrincipalArn":      {"arn:aws:iam::123456789012:role/deploy-production"}, //lintignore:AWSAT005
+					"aws:PrincipalOrgPaths": {"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"},
+				},
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedSids:     []string{"AssumeFromAccount"},
+		},
+		{
+			Name:     "principal account assumed role",
+			Policies: []string{trustPolicy},
+			Request: &tfiam.PolicyEvaluationRequest{
+				Action:    "sts:AssumeRole",
+				Principal: &tfiam.PolicyEvaluationPrincipal{Type: "AWS", Identifier: "arn:aws:sts::123456789012:assumed-role/deploy-production/session"}, //lintignore:AWSAT005
+				Context: map[string][]string{
+					"aws:PrincipalArn":      {"arn:aws:iam::123456789012:role/deploy-production"}, //lintignore:AWSAT005
+					"aws:PrincipalOrgPaths": {"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"},
+				},
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedSids:     []string{"AssumeFromAccount"},
+		},
+		{
+			Name:     "principal account root ARN user",
+			Policies: []string{`{"Statement":[{"Sid":"AccountRoot","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject","Resource":"*"}]}`}, //lintignore:AWSAT005
+			Request: &tfiam.PolicyEvaluationRequest{
+				Action:    "s3:GetObject",
+				Resource:  "*",
+				Principal: &tfiam.PolicyEvaluationPrincipal{Type: "AWS", Identifier: "arn:aws:iam::123456789012:user/alice"}, //lintignore:AWSAT005
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedSids:     []string{"AccountRoot"},
+		},
+		{
+			Name:     "principal other account role",
+			Policies: []string{`{"Statement":[{"Sid":"AccountRoot","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject","Resource":"*"}]}`}, //lintignore:AWSAT005
+			Request: &tfiam.PolicyEvaluationRequest{
+				Action:    "s3:GetObject",
+				Resource:  "*",
+				Principal: &tfiam.PolicyEvaluationPrincipal{Type: "AWS", Identifier: "arn:aws:iam::210987654321:role/deploy-production"}, //lintignore:AWSAT005
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name:     "principal role not account",
+			Policies: []string{`{"Statement":[{"Sid":"Role","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/deploy-production"},"Action":"s3:GetObject","Resource":"*"}]}`}, //lintignore:AWSAT005
+			Request: &tfiam.PolicyEvaluationRequest{
+				Action:    "s3:GetObject",
+				Resource:  "*",
+				Principal: &tfiam.PolicyEvaluationPrincipal{Type: "AWS", Identifier: "arn:aws:iam::123456789012:role/other"}, //lintignore:AWSAT005
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name:     "IfExists present",
+			Policies: []string{trustPolicy},
+			Request: &tfiam.PolicyEvaluationRequest{
+				Action:    "sts:AssumeRole",
+				Principal: &tfiam.PolicyEvaluationPrincipal{Type: "AWS", Identifier: "arn:aws:iam::123456789012:root"}, //lintignore:AWSAT005
+				Context: map[string][]string{
+					"aws:PrincipalArn":      {"arn:aws:iam::123456789012:role/deploy-production"}, //lintignore:AWSAT005
+					"aws:PrincipalOrgPaths": {"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"},
+					"sts:ExternalId":        {"other"},
+				},
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name:     "ForAnyValue missing key",
+			Policies: []string{trustPolicy},
+			Request: &tfiam.PolicyEvaluationRequest{
+				Action:    "sts:AssumeRole",
+				Principal: &tfiam.PolicyEvaluationPrincipal{Type: "AWS", Identifier: "123456789012"},
+				Context: map[string][]string{
+					"aws:PrincipalArn": {"arn:aws:iam::123456789012:role/deploy-production"}, //lintignore:AWSAT005
+				},
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name:     "ArnLike mismatch",
+			Policies: []string{trustPolicy},
+			Request: &tfiam.PolicyEvaluationRequest{
+				Action:    "sts:AssumeRole",
+				Principal: &tfiam.PolicyEvaluationPrincipal{Type: "AWS", Identifier: "123456789012"},
+				Context: map[string][]string{
+					"aws:PrincipalArn":      {"arn:aws:iam::123456789012:user/deploy-production"}, //lintignore:AWSAT005
+					"aws:PrincipalOrgPaths": {"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"},
+				},
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name:     "principal other account",
+			Policies: []string{trustPolicy},
+			Request: &tfiam.PolicyEvaluationRequest{
+				Action:    "sts:AssumeRole",
+				Principal: &tfiam.PolicyEvaluationPrincipal{Type: "AWS", Identifier: "arn:aws:iam::210987654321:root"}, //lintignore:AWSAT005
+				Context: map[string][]string{
+					"aws:PrincipalArn":      {"arn:aws:iam::123456789012:role/deploy-production"}, //lintignore:AWSAT005
+					"aws:PrincipalOrgPaths": {"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"},
+				},
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name:             "Null",
+			Policies:         []string{`{"Statement":[{"Sid":"RequireTag","Effect":"Deny","Action":"ec2:RunInstances","Resource":"*","Condition":{"Null":{"aws:RequestTag/Team":"true"}}}]}`},
+			Request:          &tfiam.PolicyEvaluationRequest{Action: "ec2:RunInstances", Resource: "*"},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeExplicitDeny,
+			ExpectedSids:     []string{"RequireTag"},
+		},
+		{
+			Name:        "unsupported condition operator",
+			Policies:    []string{`{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringMatches":{"aws:username":"alice"}}}]}`},
+			Request:     &tfiam.PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "*", Context: map[string][]string{"aws:username": {"alice"}}},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var docs []*tfiam.IAMPolicyDoc
+
+			for _, policy := range testCase.Policies {
+				doc := &tfiam.IAMPolicyDoc{}
+
+				if err := json.Unmarshal([]byte(policy), doc); err != nil {
+					t.Fatalf("error parsing policy: %s", err)
+				}
+
+				docs = append(docs, doc)
+			}
+
+			result, err := tfiam.EvaluatePolicies(docs, testCase.Request)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := result.Decision, testCase.ExpectedDecision; got != expected {
+				t.Errorf("got decision %s, expected %s", got, expected)
+			}
+
+			var sids []string
+			for _, statement := range result.MatchedStatements {
+				sids = append(sids, statement.Sid)
+			}
+
+			if got, expected := len(sids), len(testCase.ExpectedSids); got != expected {
+				t.Fatalf("got matched statements %v, expected %v", sids, testCase.ExpectedSids)
+			}
+
+			for i, sid := range testCase.ExpectedSids {
+				if sids[i] != sid {
+					t.Errorf("got matched statements %v, expected %v", sids, testCase.ExpectedSids)
+				}
+			}
+		})
+	}
+}
+
+func testPolicyEvaluationContext(maps ...map[string][]string) map[string][]string {
+	result := make(map[string][]string)
+
+	for _, m := range maps {
+		for k, v := range m {
+			result[k] = v
+		}
+	}
+
+	return result
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 )
 
 type IAMPolicyDoc struct {
@@ -70,6 +72,93 @@ func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	}
 }
 
+// Minify returns an equivalent document with fewer statements.
+// Statements that differ only in their actions, or only in their resources, are merged and lose their Sids.
+// Duplicate actions and actions matched by another of the statement's action wildcards are removed.
+// If collapseActions is true, multiple Describe, Get or List actions of a service are collapsed into a wildcard,
+// e.g. "ec2:DescribeInstances" and "ec2:DescribeVolumes" into "ec2:Describe*", which also allows the service's other actions with that prefix.
+func (s *IAMPolicyDoc) Minify(collapseActions bool) (*IAMPolicyDoc, error) {
+	doc := &IAMPolicyDoc{
+		Version: s.Version,
+		Id:      s.Id,
+	}
+
+	for _, statement := range s.Statements {
+		statement := *statement
+		statement.Actions = iamPolicyMinifyActions(policyStringValues(statement.Actions), collapseActions)
+		statement.Resources = iamPolicyUniqueStrings(policyStringValues(statement.Resources))
+		doc.Statements = append(doc.Statements, &statement)
+	}
+
+	for merged := true; merged; {
+		var err error
+
+		doc.Statements, merged, err = iamPolicyMergeStatements(doc.Statements, "Action")
+
+		if err != nil {
+			return nil, err
+		}
+
+		var mergedResources bool
+
+		doc.Statements, mergedResources, err = iamPolicyMergeStatements(doc.Statements, "Resource")
+
+		if err != nil {
+			return nil, err
+		}
+
+		merged = merged || mergedResources
+	}
+
+	for _, statement := range doc.Statements {
+		statement.Actions = iamPolicyEncodeStringList(policyStringValues(statement.Actions))
+		statement.Resources = iamPolicyEncodeStringList(policyStringValues(statement.Resources))
+	}
+
+	return doc, nil
+}
+
+// Split returns documents containing the document's statements, each no larger than limit characters when serialized without whitespace.
+// Statements that are too large on their own are split by their resources or actions.
+// A statement that cannot be split any further is returned in a document of its own, even if the document exceeds limit.
+func (s *IAMPolicyDoc) Split(limit int) ([]*IAMPolicyDoc, error) {
+	var statements []*IAMPolicyStatement
+
+	for _, statement := range s.Statements {
+		v, err := iamPolicySplitStatement(s, statement, limit)
+
+		if err != nil {
+			return nil, err
+		}
+
+		statements = append(statements, v...)
+	}
+
+	docs := []*IAMPolicyDoc{{Version: s.Version, Id: s.Id}}
+
+	for _, statement := range statements {
+		doc := docs[len(docs)-1]
+		doc.Statements = append(doc.Statements, statement)
+
+		if len(doc.Statements) == 1 {
+			continue
+		}
+
+		size, err := iamPolicyDocSize(doc)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if size > limit {
+			doc.Statements = doc.Statements[:len(doc.Statements)-1]
+			docs = append(docs, &IAMPolicyDoc{Version: s.Version, Id: s.Id, Statements: []*IAMPolicyStatement{statement}})
+		}
+	}
+
+	return docs, nil
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
@@ -193,12 +282,12 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 	for test_key, test_value := range data {
 		for var_key, var_values := range test_value {
 			switch var_values := var_values.(type) {
-			case string:
-				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{var_values}})
+			case string, bool, float64:
+				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{iamPolicyConditionValueString(var_values)}})
 			case []interface{}:
 				values := []string{}
 				for _, v := range var_values {
-					values = append(values, v.(string))
+					values = append(values, iamPolicyConditionValueString(v))
 				}
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: values})
 			}
@@ -209,6 +298,21 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// iamPolicyConditionValueString returns the string form of a JSON condition value.
+// Boolean and numeric values, e.g. {"Bool": {"aws:SecureTransport": false}}, are equivalent to their string forms.
+func iamPolicyConditionValueString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
 func iamPolicyDecodeConfigStringList(lI []interface{}) interface{} {
 	if len(lI) == 1 {
 		return lI[0].(string)
@@ -220,3 +324,214 @@ func iamPolicyDecodeConfigStringList(lI []interface{}) interface{} {
 	sort.Sort(sort.Reverse(sort.StringSlice(ret)))
 	return ret
 }
+
+// iamPolicyEncodeStringList returns the policy element for the values: nil, a string or a reverse sorted list of strings.
+func iamPolicyEncodeStringList(values []string) interface{} {
+	switch len(values) {
+	case 0:
+		return nil
+	case 1:
+		return values[0]
+	default:
+		sort.Sort(sort.Reverse(sort.StringSlice(values)))
+		return values
+	}
+}
+
+// iamPolicyUniqueStrings returns the sorted values without duplicates.
+func iamPolicyUniqueStrings(values []string) []string {
+	var unique []string
+	seen := make(map[string]bool)
+
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+
+	sort.Strings(unique)
+
+	return unique
+}
+
+// iamPolicyCollapsibleActionVerbs are the action name prefixes of read-only actions that Minify collapses into wildcards.
+var iamPolicyCollapsibleActionVerbs = []string{"Describe", "Get", "List"}
+
+// iamPolicyMinifyActions returns the actions without duplicates or actions matched by another action's wildcards,
+// optionally collapsing the read-only actions of each service into wildcards.
+func iamPolicyMinifyActions(actions []string, collapse bool) []string {
+	if collapse {
+		counts := make(map[string]int)
+
+		for _, action := range actions {
+			if prefix := iamPolicyCollapsibleActionPrefix(action); prefix != "" {
+				counts[strings.ToLower(prefix)]++
+			}
+		}
+
+		collapsed := make([]string, len(actions))
+
+		for i, action := range actions {
+			collapsed[i] = action
+
+			if prefix := iamPolicyCollapsibleActionPrefix(action); prefix != "" && counts[strings.ToLower(prefix)] > 1 {
+				collapsed[i] = prefix + "*"
+			}
+		}
+
+		actions = collapsed
+	}
+
+	actions = iamPolicyUniqueStrings(actions)
+
+	var minified []string
+
+	for i, action := range actions {
+		var patterns []string
+
+		for j, pattern := range actions {
+			if j != i && strings.ContainsAny(pattern, "*?") && !strings.EqualFold(pattern, action) {
+				patterns = append(patterns, pattern)
+			}
+		}
+
+		if !policyPatternsMatch(patterns, action, true, nil) {
+			minified = append(minified, action)
+		}
+	}
+
+	return minified
+}
+
+// iamPolicyCollapsibleActionPrefix returns the service and verb of a read-only action without wildcards, e.g. "ec2:Describe" for "ec2:DescribeInstances".
+func iamPolicyCollapsibleActionPrefix(action string) string {
+	if strings.ContainsAny(action, "*?") {
+		return ""
+	}
+
+	parts := strings.SplitN(action, ":", 2)
+
+	if len(parts) != 2 {
+		return ""
+	}
+
+	for _, verb := range iamPolicyCollapsibleActionVerbs {
+		if len(parts[1]) > len(verb) && strings.HasPrefix(parts[1], verb) {
+			return parts[0] + ":" + verb
+		}
+	}
+
+	return ""
+}
+
+// iamPolicyMergeStatements merges statements that differ only in the specified element, "Action" or "Resource".
+// Merged statements take the place of the first statement merged into them.
+func iamPolicyMergeStatements(statements []*IAMPolicyStatement, element string) ([]*IAMPolicyStatement, bool, error) {
+	var merged []*IAMPolicyStatement
+	var mergedAny bool
+	byKey := make(map[string]*IAMPolicyStatement)
+
+	for _, statement := range statements {
+		values := iamPolicyStatementElement(statement, element)
+
+		if len(policyStringValues(*values)) == 0 {
+			merged = append(merged, statement)
+			continue
+		}
+
+		key := *statement
+		key.Sid = ""
+		*iamPolicyStatementElement(&key, element) = nil
+
+		b, err := json.Marshal(&key)
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		if existing, ok := byKey[string(b)]; ok {
+			existingValues := iamPolicyStatementElement(existing, element)
+			*existingValues = iamPolicyUniqueStrings(append(policyStringValues(*existingValues), policyStringValues(*values)...))
+			existing.Sid = ""
+			mergedAny = true
+
+			continue
+		}
+
+		statement := *statement
+		byKey[string(b)] = &statement
+		merged = append(merged, &statement)
+	}
+
+	if mergedAny {
+		for _, statement := range merged {
+			if element == "Action" {
+				statement.Actions = iamPolicyMinifyActions(policyStringValues(statement.Actions), false)
+			}
+		}
+	}
+
+	return merged, mergedAny, nil
+}
+
+func iamPolicyStatementElement(statement *IAMPolicyStatement, element string) *interface{} {
+	if element == "Action" {
+		return &statement.Actions
+	}
+
+	return &statement.Resources
+}
+
+// iamPolicySplitStatement splits a statement too large for a document of its own by its resources or, failing that, its actions.
+func iamPolicySplitStatement(doc *IAMPolicyDoc, statement *IAMPolicyStatement, limit int) ([]*IAMPolicyStatement, error) {
+	size, err := iamPolicyDocSize(&IAMPolicyDoc{Version: doc.Version, Id: doc.Id, Statements: []*IAMPolicyStatement{statement}})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if size <= limit {
+		return []*IAMPolicyStatement{statement}, nil
+	}
+
+	for _, element := range []string{"Resource", "Action"} {
+		values := policyStringValues(*iamPolicyStatementElement(statement, element))
+
+		if len(values) < 2 {
+			continue
+		}
+
+		var statements []*IAMPolicyStatement
+
+		for _, half := range [][]string{values[:len(values)/2], values[len(values)/2:]} {
+			// Sids must be unique within a document.
+			v := *statement
+			v.Sid = ""
+			*iamPolicyStatementElement(&v, element) = iamPolicyEncodeStringList(append([]string(nil), half...))
+
+			split, err := iamPolicySplitStatement(doc, &v, limit)
+
+			if err != nil {
+				return nil, err
+			}
+
+			statements = append(statements, split...)
+		}
+
+		return statements, nil
+	}
+
+	return []*IAMPolicyStatement{statement}, nil
+}
+
+// iamPolicyDocSize returns the size of the document serialized without whitespace.
+func iamPolicyDocSize(doc *IAMPolicyDoc) (int, error) {
+	b, err := json.Marshal(doc)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
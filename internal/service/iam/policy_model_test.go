@@ -0,0 +1,219 @@
+package iam_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"testing"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestIAMPolicyDocMinify(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		Policy          string
+		CollapseActions bool
+		Expected        string
+	}{
+		{
+			Name:     "empty",
+			Policy:   `{"Version":"2012-10-17","Statement":[]}`,
+			Expected: `{"Version":"2012-10-17","Statement":null}`,
+		},
+		{
+			Name: "merge resources",
+			Policy: `{"Version":"2012-10-17","Statement":[
+  {"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*"},
+  {"Sid":"B","Effect":"Allow","Action":"s3:GetObject","Resource":["arn:aws:s3:::b/*","arn:aws:s3:::a/*"]},
+  {"Sid":"C","Effect":"Deny","Action":"s3:GetObject","Resource":"arn:aws:s3:::c/*"}
+]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[` +
+				`{"Sid":"","Effect":"Allow","Action":"s3:GetObject","Resource":["arn:aws:s3:::b/*","arn:aws:s3:::a/*"]},` +
+				`{"Sid":"C","Effect":"Deny","Action":"s3:GetObject","Resource":"arn:aws:s3:::c/*"}]}`,
+		},
+		{
+			Name: "merge actions then resources",
+			Policy: `{"Version":"2012-10-17","Statement":[
+  {"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*"},
+  {"Effect":"Allow","Action":"s3:PutObject","Resource":"arn:aws:s3:::a/*"},
+  {"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"arn:aws:s3:::b/*"}
+]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[` +
+				`{"Sid":"","Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":["arn:aws:s3:::b/*","arn:aws:s3:::a/*"]}]}`,
+		},
+		{
+			Name: "different principals and conditions",
+			Policy: `{"Version":"2012-10-17","Statement":[
+  {"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":"ec2.amazonaws.com"}},
+  {"Effect":"Allow","Action":"sts:TagSession","Principal":{"Service":"ec2.amazonaws.com"}},
+  {"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":"lambda.amazonaws.com"}},
+  {"Effect":"Allow","Action":"sts:TagSession","Principal":{"Service":"lambda.amazonaws.com"},"Condition":{"StringEquals":{"aws:SourceAccount":"123456789012"}}}
+]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[` +
+				`{"Sid":"","Effect":"Allow","Action":["sts:TagSession","sts:AssumeRole"],"Principal":{"Service":"ec2.amazonaws.com"}},` +
+				`{"Sid":"","Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":"lambda.amazonaws.com"}},` +
+				`{"Sid":"","Effect":"Allow","Action":"sts:TagSession","Principal":{"Service":"lambda.amazonaws.com"},"Condition":{"StringEquals":{"aws:SourceAccount":["123456789012"]}}}]}`,
+		},
+		{
+			Name: "not actions are not merged",
+			Policy: `{"Version":"2012-10-17","Statement":[
+  {"Effect":"Deny","NotAction":"iam:*","Resource":"*"},
+  {"Effect":"Deny","NotAction":"sts:*","Resource":"*"}
+]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[` +
+				`{"Sid":"","Effect":"Deny","NotAction":"iam:*","Resource":"*"},` +
+				`{"Sid":"","Effect":"Deny","NotAction":"sts:*","Resource":"*"}]}`,
+		},
+		{
+			Name: "wildcard actions",
+			Policy: `{"Version":"2012-10-17","Statement":[
+  {"Effect":"Allow","Action":["ec2:DescribeInstances","EC2:Describe*","s3:GetObject","s3:GetObject"],"Resource":"*"}
+]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[` +
+				`{"Sid":"","Effect":"Allow","Action":["s3:GetObject","EC2:Describe*"],"Resource":"*"}]}`,
+		},
+		{
+			Name: "collapse actions disabled",
+			Policy: `{"Version":"2012-10-17","Statement":[
+  {"Effect":"Allow","Action":["ec2:DescribeInstances","ec2:DescribeVolumes","ec2:RunInstances"],"Resource":"*"}
+]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[` +
+				`{"Sid":"","Effect":"Allow","Action":["ec2:RunInstances","ec2:DescribeVolumes","ec2:DescribeInstances"],"Resource":"*"}]}`,
+		},
+		{
+			Name: "collapse actions",
+			Policy: `{"Version":"2012-10-17","Statement":[
+  {"Effect":"Allow","Action":["ec2:DescribeInstances","ec2:DescribeVolumes","ec2:RunInstances","ec2:StartInstances","s3:GetObject"],"Resource":"*"}
+]}`,
+			CollapseActions: true,
+			Expected: `{"Version":"2012-10-17","Statement":[` +
+				`{"Sid":"","Effect":"Allow","Action":["s3:GetObject","ec2:StartInstances","ec2:RunInstances","ec2:Describe*"],"Resource":"*"}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := &tfiam.IAMPolicyDoc{}
+
+			if err := json.Unmarshal([]byte(testCase.Policy), doc); err != nil {
+				t.Fatalf("error parsing policy: %s", err)
+			}
+
+			original, err := json.Marshal(doc)
+
+			if err != nil {
+				t.Fatalf("error serializing policy: %s", err)
+			}
+
+			minified, err := doc.Minify(testCase.CollapseActions)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := json.Marshal(minified)
+
+			if err != nil {
+				t.Fatalf("error serializing minified policy: %s", err)
+			}
+
+			if string(got) != testCase.Expected {
+				t.Errorf("expected %s, got %s", testCase.Expected, got)
+			}
+
+			after, err := json.Marshal(doc)
+
+			if err != nil {
+				t.Fatalf("error serializing policy: %s", err)
+			}
+
+			if string(after) != string(original) {
+				t.Errorf("original policy modified: expected %s, got %s", original, after)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyDocSplit(t *testing.T) {
+	var resources []string
+
+	for i := 0; i < 10; i++ {
+		resources = append(resources, fmt.Sprintf("arn:aws:s3:::bucket-%d/*", i))
+	}
+
+	doc := &tfiam.IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*tfiam.IAMPolicyStatement{
+			{Sid: "Small", Effect: "Allow", Actions: "sqs:SendMessage", Resources: "*"},
+			{Sid: "Large", Effect: "Allow", Actions: "s3:GetObject", Resources: resources},
+			{Sid: "Unsplittable", Effect: "Allow", Actions: "s3:GetObject", Resources: "arn:aws:s3:::a-very-long-bucket-name-that-does-not-fit-anywhere/*"},
+		},
+	}
+
+	const limit = 200
+
+	docs, err := doc.Split(limit)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var gotResources []string
+	var sids []string
+
+	for _, doc := range docs {
+		b, err := json.Marshal(doc)
+
+		if err != nil {
+			t.Fatalf("error serializing policy: %s", err)
+		}
+
+		for _, statement := range doc.Statements {
+			sids = append(sids, statement.Sid)
+
+			if statement.Sid == "" {
+				switch v := statement.Resources.(type) {
+				case string:
+					gotResources = append(gotResources, v)
+				case []string:
+					gotResources = append(gotResources, v...)
+				}
+			}
+		}
+
+		if len(b) > limit && (len(doc.Statements) != 1 || doc.Statements[0].Sid != "Unsplittable") {
+			t.Errorf("document exceeds limit (%d): %s", len(b), b)
+		}
+	}
+
+	if len(docs) < 3 {
+		t.Errorf("expected at least 3 documents, got %d", len(docs))
+	}
+
+	if sids[0] != "Small" || sids[len(sids)-1] != "Unsplittable" {
+		t.Errorf("unexpected statement order: %q", sids)
+	}
+
+	sort.Strings(gotResources)
+
+	if len(gotResources) != len(resources) {
+		t.Fatalf("expected resources %q, got %q", resources, gotResources)
+	}
+
+	for i := range resources {
+		if gotResources[i] != resources[i] {
+			t.Errorf("expected resource %d %q, got %q", i, resources[i], gotResources[i])
+		}
+	}
+
+	docs, err = (&tfiam.IAMPolicyDoc{Version: "2012-10-17"}).Split(limit)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(docs) != 1 {
+		t.Errorf("expected 1 document, got %d", len(docs))
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
@@ -104,7 +105,7 @@ func sweepGroups(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 	input := &iam.ListGroupsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -217,7 +218,7 @@ func sweepInstanceProfile(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -274,7 +275,7 @@ func sweepOpenIDConnectProvider(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -315,11 +316,11 @@ func sweepPolicies(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 	input := &iam.ListPoliciesInput{
 		Scope: aws.String(iam.PolicyScopeTypeLocal),
 	}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
 
 	err = conn.ListPoliciesPages(input, func(page *iam.ListPoliciesOutput, lastPage bool) bool {
 		if page == nil {
@@ -327,42 +328,25 @@ func sweepPolicies(region string) error {
 		}
 
 		for _, policy := range page.Policies {
-			arn := aws.StringValue(policy.Arn)
-			input := &iam.DeletePolicyInput{
-				PolicyArn: policy.Arn,
-			}
-
-			log.Printf("[INFO] Deleting IAM Policy: %s", arn)
-			if err := PolicyDeleteNondefaultVersions(arn, conn); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Policy (%s) non-default versions: %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-
-			_, err := conn.DeletePolicy(input)
-
-			// Treat this sweeper as best effort for now. There are a lot of edge cases
-			// with lingering aws_iam_role resources in the HashiCorp testing accounts.
-			if tfawserr.ErrCodeEquals(err, iam.ErrCodeDeleteConflictException) {
-				log.Printf("[WARN] Ignoring IAM Policy (%s) deletion error: %s", arn, err)
-				continue
-			}
-
-			if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-				continue
-			}
-
-			if tfawserr.ErrMessageContains(err, "AccessDenied", "with an explicit deny") {
-				continue
+			r := ResourcePolicy()
+			r.Delete = sweepPolicyDelete
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(policy.Arn))
+
+			sweepResource := sweep.NewSweepResource(r, d, client).
+				WithCreationTime(aws.TimeValue(policy.CreateDate)).
+				WithName(aws.StringValue(policy.PolicyName))
+
+			// ListPolicies does not return tags.
+			if sweep.TagsFiltered() {
+				if output, err := conn.ListPolicyTags(&iam.ListPolicyTagsInput{PolicyArn: policy.Arn}); err != nil {
+					log.Printf("[WARN] Error listing IAM Policy (%s) tags: %s", d.Id(), err)
+				} else {
+					sweepResource.WithTags(KeyValueTags(output.Tags).IgnoreAWS().Map())
+				}
 			}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Policy (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweepResource)
 		}
 
 		return !lastPage
@@ -370,14 +354,39 @@ func sweepPolicies(region string) error {
 
 	if sweep.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping IAM Policy sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+		return nil
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving IAM Policies: %w", err))
+		return fmt.Errorf("error listing IAM Policies: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IAM Policies: %w", err)
+	}
+
+	return nil
+}
+
+// sweepPolicyDelete deletes the IAM Policy, skipping policies that are still attached or that cannot be deleted.
+func sweepPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	err := resourcePolicyDelete(d, meta)
+
+	// Treat this sweeper as best effort for now. There are a lot of edge cases
+	// with lingering aws_iam_role resources in the HashiCorp testing accounts.
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeDeleteConflictException) {
+		log.Printf("[WARN] Ignoring IAM Policy (%s) deletion error: %s", d.Id(), err)
+		return nil
+	}
+
+	if tfawserr.ErrMessageContains(err, "AccessDenied", "with an explicit deny") {
+		log.Printf("[WARN] Skipping IAM Policy (%s): %s", d.Id(), err)
+		return nil
+	}
+
+	return err
 }
 
 func sweepRoles(region string) error {
@@ -385,17 +394,41 @@ func sweepRoles(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
+	sweepResources := make([]*sweep.SweepResource, 0)
 
-	roles := make([]string, 0)
 	err = conn.ListRolesPages(&iam.ListRolesInput{}, func(page *iam.ListRolesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
 		for _, role := range page.Roles {
 			roleName := aws.StringValue(role.RoleName)
-			if roleNameFilter(roleName) {
-				roles = append(roles, roleName)
-			} else {
+
+			if !roleNameFilter(roleName) {
 				log.Printf("[INFO] Skipping IAM Role (%s): no match on allow-list", roleName)
+				continue
 			}
+
+			r := ResourceRole()
+			r.Delete = sweepRoleDelete
+			d := r.Data(nil)
+			d.SetId(roleName)
+
+			sweepResource := sweep.NewSweepResource(r, d, client).
+				WithCreationTime(aws.TimeValue(role.CreateDate)).
+				WithName(roleName)
+
+			// ListRoles does not return tags.
+			if sweep.TagsFiltered() {
+				if output, err := conn.ListRoleTags(&iam.ListRoleTagsInput{RoleName: role.RoleName}); err != nil {
+					log.Printf("[WARN] Error listing IAM Role (%s) tags: %s", roleName, err)
+				} else {
+					sweepResource.WithTags(KeyValueTags(output.Tags).IgnoreAWS().Map())
+				}
+			}
+
+			sweepResources = append(sweepResources, sweepResource)
 		}
 
 		return !lastPage
@@ -407,36 +440,16 @@ func sweepRoles(region string) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving IAM Roles: %w", err)
-	}
-
-	if len(roles) == 0 {
-		log.Print("[DEBUG] No IAM Roles to sweep")
-		return nil
+		return fmt.Errorf("error listing IAM Roles: %w", err)
 	}
 
-	var sweeperErrs *multierror.Error
-
-	for _, roleName := range roles {
-		log.Printf("[DEBUG] Deleting IAM Role (%s)", roleName)
+	err = sweep.SweepOrchestrator(sweepResources)
 
-		err := DeleteRole(conn, roleName, true, true, true)
-		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-			continue
-		}
-		if tfawserr.ErrCodeContains(err, "AccessDenied") {
-			log.Printf("[WARN] Skipping IAM Role (%s): %s", roleName, err)
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM Role (%s): %w", roleName, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err != nil {
+		return fmt.Errorf("error sweeping IAM Roles: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
 func sweepSamlProvider(region string) error {
@@ -444,7 +457,7 @@ func sweepSamlProvider(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -483,7 +496,7 @@ func sweepServerCertificates(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 
 	err = conn.ListServerCertificatesPages(&iam.ListServerCertificatesInput{}, func(out *iam.ListServerCertificatesOutput, lastPage bool) bool {
 		for _, sc := range out.ServerCertificateMetadataList {
@@ -516,7 +529,7 @@ func sweepServiceLinkedRoles(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 	var sweeperErrs *multierror.Error
 	input := &iam.ListRolesInput{
 		PathPrefix: aws.String("/aws-service-role/"),
@@ -570,23 +583,55 @@ func sweepUsers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 	prefixes := []string{
 		"test-user",
 		"test_user",
 		"tf-acc",
 		"tf_acc",
 	}
-	users := make([]*iam.User, 0)
+	sweepResources := make([]*sweep.SweepResource, 0)
 
 	err = conn.ListUsersPages(&iam.ListUsersInput{}, func(page *iam.ListUsersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
 		for _, user := range page.Users {
+			username := aws.StringValue(user.UserName)
+			matched := false
+
 			for _, prefix := range prefixes {
-				if strings.HasPrefix(aws.StringValue(user.UserName), prefix) {
-					users = append(users, user)
+				if strings.HasPrefix(username, prefix) {
+					matched = true
 					break
 				}
 			}
+
+			if !matched {
+				continue
+			}
+
+			r := ResourceUser()
+			r.Delete = sweepUserDelete
+			d := r.Data(nil)
+			d.SetId(username)
+			d.Set("force_destroy", true)
+
+			sweepResource := sweep.NewSweepResource(r, d, client).
+				WithCreationTime(aws.TimeValue(user.CreateDate)).
+				WithName(username)
+
+			// ListUsers does not return tags.
+			if sweep.TagsFiltered() {
+				if output, err := conn.ListUserTags(&iam.ListUserTagsInput{UserName: user.UserName}); err != nil {
+					log.Printf("[WARN] Error listing IAM User (%s) tags: %s", username, err)
+				} else {
+					sweepResource.WithTags(KeyValueTags(output.Tags).IgnoreAWS().Map())
+				}
+			}
+
+			sweepResources = append(sweepResources, sweepResource)
 		}
 
 		return !lastPage
@@ -598,141 +643,97 @@ func sweepUsers(region string) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving IAM Users: %s", err)
+		return fmt.Errorf("error listing IAM Users: %w", err)
 	}
 
-	if len(users) == 0 {
-		log.Print("[DEBUG] No IAM Users to sweep")
-		return nil
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IAM Users: %w", err)
 	}
 
-	var sweeperErrs *multierror.Error
-	for _, user := range users {
-		username := aws.StringValue(user.UserName)
-		log.Printf("[DEBUG] Deleting IAM User: %s", username)
+	return nil
+}
 
-		listUserPoliciesInput := &iam.ListUserPoliciesInput{
-			UserName: user.UserName,
-		}
-		listUserPoliciesOutput, err := conn.ListUserPolicies(listUserPoliciesInput)
+// sweepRoleDelete deletes the IAM Role and its instance profile associations and policies, skipping roles that cannot be deleted.
+func sweepRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn()
 
-		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error listing IAM User (%s) inline policies: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	err := DeleteRole(conn, d.Id(), true, true, true)
 
-		for _, inlinePolicyName := range listUserPoliciesOutput.PolicyNames {
-			log.Printf("[DEBUG] Deleting IAM User (%s) inline policy %q", username, *inlinePolicyName)
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
 
-			input := &iam.DeleteUserPolicyInput{
-				PolicyName: inlinePolicyName,
-				UserName:   user.UserName,
-			}
+	if tfawserr.ErrCodeContains(err, "AccessDenied") {
+		log.Printf("[WARN] Skipping IAM Role (%s): %s", d.Id(), err)
+		return nil
+	}
 
-			if _, err := conn.DeleteUserPolicy(input); err != nil {
-				if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-					continue
-				}
-				sweeperErr := fmt.Errorf("error deleting IAM User (%s) inline policy %q: %s", username, *inlinePolicyName, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-		}
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Role (%s): %w", d.Id(), err)
+	}
 
-		listAttachedUserPoliciesInput := &iam.ListAttachedUserPoliciesInput{
-			UserName: user.UserName,
-		}
-		listAttachedUserPoliciesOutput, err := conn.ListAttachedUserPolicies(listAttachedUserPoliciesInput)
+	return nil
+}
 
-		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error listing IAM User (%s) attached policies: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+// sweepUserDelete deletes the IAM User's inline policies and detaches its attached policies before deleting it.
+func sweepUserDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn()
+	username := d.Id()
 
-		for _, attachedPolicy := range listAttachedUserPoliciesOutput.AttachedPolicies {
-			policyARN := aws.StringValue(attachedPolicy.PolicyArn)
+	output, err := conn.ListUserPolicies(&iam.ListUserPoliciesInput{
+		UserName: aws.String(username),
+	})
 
-			log.Printf("[DEBUG] Detaching IAM User (%s) attached policy: %s", username, policyARN)
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
 
-			if err := DetachPolicyFromUser(conn, username, policyARN); err != nil {
-				sweeperErr := fmt.Errorf("error detaching IAM User (%s) attached policy (%s): %s", username, policyARN, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-		}
+	if err != nil {
+		return fmt.Errorf("error listing IAM User (%s) inline policies: %w", username, err)
+	}
 
-		if err := DeleteUserGroupMemberships(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) group memberships: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	for _, inlinePolicyName := range output.PolicyNames {
+		log.Printf("[DEBUG] Deleting IAM User (%s) inline policy %q", username, aws.StringValue(inlinePolicyName))
 
-		if err := DeleteUserAccessKeys(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) access keys: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+		_, err := conn.DeleteUserPolicy(&iam.DeleteUserPolicyInput{
+			PolicyName: inlinePolicyName,
+			UserName:   aws.String(username),
+		})
 
-		if err := DeleteUserSSHKeys(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) SSH keys: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
 			continue
 		}
 
-		if err := DeleteUserVirtualMFADevices(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) virtual MFA devices: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
+		if err != nil {
+			return fmt.Errorf("error deleting IAM User (%s) inline policy %q: %w", username, aws.StringValue(inlinePolicyName), err)
 		}
+	}
 
-		if err := DeactivateUserMFADevices(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) MFA devices: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	attachedPolicies, err := conn.ListAttachedUserPolicies(&iam.ListAttachedUserPoliciesInput{
+		UserName: aws.String(username),
+	})
 
-		if err := DeleteUserLoginProfile(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) login profile: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
 
-		input := &iam.DeleteUserInput{
-			UserName: aws.String(username),
-		}
+	if err != nil {
+		return fmt.Errorf("error listing IAM User (%s) attached policies: %w", username, err)
+	}
 
-		_, err = conn.DeleteUser(input)
+	for _, attachedPolicy := range attachedPolicies.AttachedPolicies {
+		policyARN := aws.StringValue(attachedPolicy.PolicyArn)
 
-		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM User (%s): %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
+		log.Printf("[DEBUG] Detaching IAM User (%s) attached policy: %s", username, policyARN)
+
+		if err := DetachPolicyFromUser(conn, username, policyARN); err != nil {
+			return fmt.Errorf("error detaching IAM User (%s) attached policy (%s): %w", username, policyARN, err)
 		}
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return resourceUserDelete(d, meta)
 }
 
 func roleNameFilter(name string) bool {
@@ -35,7 +35,7 @@ func DataSourceComponents() *schema.Resource {
 }
 
 func dataSourceComponentsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ImageBuilderConn
+	conn := meta.(*conns.AWSClient).ImageBuilderConn()
 
 	input := &imagebuilder.ListComponentsInput{}
 
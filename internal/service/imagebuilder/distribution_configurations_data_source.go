@@ -29,7 +29,7 @@ func DataSourceDistributionConfigurations() *schema.Resource {
 }
 
 func dataSourceDistributionConfigurationsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ImageBuilderConn
+	conn := meta.(*conns.AWSClient).ImageBuilderConn()
 
 	input := &imagebuilder.ListDistributionConfigurationsInput{}
 
@@ -155,7 +155,7 @@ func testAccCheckAuthorizerExists(n string, v *iot.AuthorizerDescription) resour
 			return fmt.Errorf("No IoT Authorizer ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn()
 
 		output, err := tfiot.FindAuthorizerByName(conn, rs.Primary.ID)
 
@@ -170,7 +170,7 @@ func testAccCheckAuthorizerExists(n string, v *iot.AuthorizerDescription) resour
 }
 
 func testAccCheckAuthorizerDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_authorizer" {
@@ -79,7 +79,7 @@ func sweepCertifcates(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).IoTConn
+	conn := client.(*conns.AWSClient).IoTConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -125,7 +125,7 @@ func sweepPolicyAttachments(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).IoTConn
+	conn := client.(*conns.AWSClient).IoTConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -191,7 +191,7 @@ func sweepPolicies(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).IoTConn
+	conn := client.(*conns.AWSClient).IoTConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -237,7 +237,7 @@ func sweepRoleAliases(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).IoTConn
+	conn := client.(*conns.AWSClient).IoTConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -283,7 +283,7 @@ func sweepThingPrincipalAttachments(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).IoTConn
+	conn := client.(*conns.AWSClient).IoTConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -349,7 +349,7 @@ func sweepThings(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).IoTConn
+	conn := client.(*conns.AWSClient).IoTConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -395,7 +395,7 @@ func sweepThingTypes(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).IoTConn
+	conn := client.(*conns.AWSClient).IoTConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -439,7 +439,7 @@ func sweepTopicRules(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).IoTConn
+	conn := client.(*conns.AWSClient).IoTConn()
 	input := &iot.ListTopicRulesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -486,7 +486,7 @@ func sweepThingGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).IoTConn
+	conn := client.(*conns.AWSClient).IoTConn()
 	input := &iot.ListThingGroupsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
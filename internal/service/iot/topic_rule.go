@@ -44,7 +44,7 @@ func ResourceTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 						"state_reason": {
 							Type:     schema.TypeString,
@@ -87,7 +87,7 @@ func ResourceTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 					},
 				},
@@ -141,7 +141,7 @@ func ResourceTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 						"table_name": {
 							Type:     schema.TypeString,
@@ -171,7 +171,7 @@ func ResourceTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 					},
 				},
@@ -197,7 +197,7 @@ func ResourceTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 						"type": {
 							Type:     schema.TypeString,
@@ -222,7 +222,7 @@ func ResourceTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 						"separator": {
 							Type:         schema.TypeString,
@@ -244,7 +244,7 @@ func ResourceTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 					},
 				},
@@ -265,7 +265,7 @@ func ResourceTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 					},
 				},
@@ -282,7 +282,7 @@ func ResourceTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 						"stream_name": {
 							Type:     schema.TypeString,
@@ -299,7 +299,7 @@ func ResourceTopicRule() *schema.Resource {
 						"function_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("lambda", "function"),
 						},
 					},
 				},
@@ -324,7 +324,7 @@ func ResourceTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 						"topic": {
 							Type:     schema.TypeString,
@@ -349,7 +349,7 @@ func ResourceTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 					},
 				},
@@ -370,7 +370,7 @@ func ResourceTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 					},
 				},
@@ -393,7 +393,7 @@ func ResourceTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 					},
 				},
@@ -418,7 +418,7 @@ func ResourceTopicRule() *schema.Resource {
 						"role_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("iam", "role"),
 						},
 						"use_base64": {
 							Type:     schema.TypeBool,
@@ -448,7 +448,7 @@ func ResourceTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 									"state_reason": {
 										Type:     schema.TypeString,
@@ -509,7 +509,7 @@ func ResourceTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 								},
 							},
@@ -577,7 +577,7 @@ func ResourceTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 									"table_name": {
 										Type:     schema.TypeString,
@@ -625,7 +625,7 @@ func ResourceTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 								},
 							},
@@ -669,7 +669,7 @@ func ResourceTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 									"type": {
 										Type:     schema.TypeString,
@@ -708,7 +708,7 @@ func ResourceTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 									"separator": {
 										Type:         schema.TypeString,
@@ -748,7 +748,7 @@ func ResourceTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 								},
 							},
@@ -787,7 +787,7 @@ func ResourceTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 								},
 							},
@@ -822,7 +822,7 @@ func ResourceTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 									"stream_name": {
 										Type:     schema.TypeString,
@@ -857,7 +857,7 @@ func ResourceTopicRule() *schema.Resource {
 									"function_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("lambda", "function"),
 									},
 								},
 							},
@@ -894,7 +894,7 @@ func ResourceTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 									"topic": {
 										Type:     schema.TypeString,
@@ -937,7 +937,7 @@ func ResourceTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 								},
 							},
@@ -976,7 +976,7 @@ func ResourceTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 								},
 							},
@@ -1017,7 +1017,7 @@ func ResourceTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 								},
 							},
@@ -1052,7 +1052,7 @@ func ResourceTopicRule() *schema.Resource {
 									"role_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 									"use_base64": {
 										Type:     schema.TypeBool,
@@ -1088,7 +1088,7 @@ func ResourceTopicRule() *schema.Resource {
 }
 
 func resourceTopicRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IoTConn
+	conn := meta.(*conns.AWSClient).IoTConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -1133,7 +1133,7 @@ func resourceTopicRuleCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTopicRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IoTConn
+	conn := meta.(*conns.AWSClient).IoTConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -1239,7 +1239,7 @@ func resourceTopicRuleRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTopicRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IoTConn
+	conn := meta.(*conns.AWSClient).IoTConn()
 
 	if d.HasChanges(
 		"cloudwatch_alarm",
@@ -1287,7 +1287,7 @@ func resourceTopicRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTopicRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IoTConn
+	conn := meta.(*conns.AWSClient).IoTConn()
 
 	input := &iot.DeleteTopicRuleInput{
 		RuleName: aws.String(d.Id()),
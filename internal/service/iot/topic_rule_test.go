@@ -568,7 +568,7 @@ func TestAccIoTTopicRule_updateKinesisErrorAction(t *testing.T) {
 }
 
 func testAccCheckTopicRuleDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_topic_rule" {
@@ -601,7 +601,7 @@ func testAccCheckTopicRuleExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn()
 		input := &iot.ListTopicRulesInput{}
 
 		output, err := conn.ListTopicRules(input)
@@ -66,7 +66,7 @@ func ResourceCustomPlugin() *schema.Resource {
 										Type:         schema.TypeString,
 										Required:     true,
 										ForceNew:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("s3", "bucket"),
 									},
 									"file_key": {
 										Type:     schema.TypeString,
@@ -98,7 +98,7 @@ func ResourceCustomPlugin() *schema.Resource {
 }
 
 func resourceCustomPluginCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KafkaConnectConn
+	conn := meta.(*conns.AWSClient).KafkaConnectConn()
 
 	name := d.Get("name").(string)
 	input := &kafkaconnect.CreateCustomPluginInput{
@@ -130,7 +130,7 @@ func resourceCustomPluginCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceCustomPluginRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KafkaConnectConn
+	conn := meta.(*conns.AWSClient).KafkaConnectConn()
 
 	plugin, err := FindCustomPluginByARN(conn, d.Id())
 
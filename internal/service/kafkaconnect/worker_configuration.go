@@ -60,7 +60,7 @@ func ResourceWorkerConfiguration() *schema.Resource {
 }
 
 func resourceWorkerConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KafkaConnectConn
+	conn := meta.(*conns.AWSClient).KafkaConnectConn()
 
 	name := d.Get("name").(string)
 	properties := d.Get("properties_file_content").(string)
@@ -86,7 +86,7 @@ func resourceWorkerConfigurationCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceWorkerConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KafkaConnectConn
+	conn := meta.(*conns.AWSClient).KafkaConnectConn()
 
 	config, err := FindWorkerConfigurationByARN(conn, d.Id())
 
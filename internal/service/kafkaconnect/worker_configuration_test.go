@@ -124,7 +124,7 @@ func testAccCheckWorkerConfigurationExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("No MSK Worker Configuration ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConnectConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConnectConn()
 
 		params := &kafkaconnect.DescribeWorkerConfigurationInput{
 			WorkerConfigurationArn: aws.String(rs.Primary.ID),
@@ -1877,7 +1877,7 @@ func TestAccKinesisAnalyticsApplication_StartApplication_update(t *testing.T) {
 }
 
 func testAccCheckKinesisAnalyticsApplicationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisAnalyticsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisAnalyticsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_kinesis_analytics_application" {
@@ -1910,7 +1910,7 @@ func testAccCheckKinesisAnalyticsApplicationExists(n string, v *kinesisanalytics
 			return fmt.Errorf("No Kinesis Analytics Application ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisAnalyticsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisAnalyticsConn()
 
 		application, err := tfkinesisanalytics.FindApplicationDetailByName(conn, rs.Primary.Attributes["name"])
 
@@ -1925,7 +1925,7 @@ func testAccCheckKinesisAnalyticsApplicationExists(n string, v *kinesisanalytics
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisAnalyticsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisAnalyticsConn()
 
 	input := &kinesisanalytics.ListApplicationsInput{}
 
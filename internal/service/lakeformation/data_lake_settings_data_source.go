@@ -71,7 +71,7 @@ func DataSourceDataLakeSettings() *schema.Resource {
 }
 
 func dataSourceDataLakeSettingsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LakeFormationConn
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
 
 	input := &lakeformation.GetDataLakeSettingsInput{}
 
@@ -565,7 +565,7 @@ func testAccPermissionDisappears(n string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("No resource ID is set")
@@ -600,7 +600,7 @@ func testAccCheckPermissionExists(n string, statement *tflambda.PolicyStatement)
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		// IAM is eventually consistent
 		var foundStatement *tflambda.PolicyStatement
@@ -632,7 +632,7 @@ func testAccCheckPermissionExists(n string, statement *tflambda.PolicyStatement)
 }
 
 func testAccCheckPermissionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_permission" {
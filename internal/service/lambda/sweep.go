@@ -6,8 +6,12 @@ package lambda
 import (
 	"fmt"
 	"log"
+	"strconv"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -25,36 +29,65 @@ func init() {
 	})
 }
 
+// lambdaTimestampLayout is the layout of Lambda API timestamps, e.g. 2019-10-24T18:38:30.511+0000.
+const lambdaTimestampLayout = "2006-01-02T15:04:05-0700"
+
 func sweepFunctions(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).LambdaConn
+	conn := client.(*conns.AWSClient).LambdaConn()
+	sweepResources := make([]*sweep.SweepResource, 0)
 
-	resp, err := conn.ListFunctions(&lambda.ListFunctionsInput{})
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Lambda Function sweep for %s: %s", region, err)
-			return nil
+	err = conn.ListFunctionsPages(&lambda.ListFunctionsInput{}, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Functions {
+			name := aws.StringValue(v.FunctionName)
+			r := ResourceFunction()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("function_name", name)
+
+			sweepResource := sweep.NewSweepResource(r, d, client).WithName(name)
+
+			// Functions are not older than their last modification.
+			if t, err := time.Parse(lambdaTimestampLayout, aws.StringValue(v.LastModified)); err == nil {
+				sweepResource.WithCreationTime(t)
+			}
+
+			// ListFunctions does not return tags.
+			if sweep.TagsFiltered() {
+				if tags, err := ListTags(conn, aws.StringValue(v.FunctionArn)); err != nil {
+					log.Printf("[WARN] Error listing Lambda Function (%s) tags: %s", name, err)
+				} else {
+					sweepResource.WithTags(tags.IgnoreAWS().Map())
+				}
+			}
+
+			sweepResources = append(sweepResources, sweepResource)
 		}
-		return fmt.Errorf("Error retrieving Lambda functions: %s", err)
-	}
 
-	if len(resp.Functions) == 0 {
-		log.Print("[DEBUG] No aws lambda functions to sweep")
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Lambda Function sweep for %s: %s", region, err)
 		return nil
 	}
 
-	for _, f := range resp.Functions {
-		_, err := conn.DeleteFunction(
-			&lambda.DeleteFunctionInput{
-				FunctionName: f.FunctionName,
-			})
-		if err != nil {
-			return err
-		}
+	if err != nil {
+		return fmt.Errorf("error listing Lambda Functions (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Lambda Functions (%s): %w", region, err)
 	}
 
 	return nil
@@ -66,39 +99,62 @@ func sweepLayerVersions(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).LambdaConn
-	resp, err := conn.ListLayers(&lambda.ListLayersInput{})
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Lambda Layer sweep for %s: %s", region, err)
-			return nil
+	conn := client.(*conns.AWSClient).LambdaConn()
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.ListLayersPages(&lambda.ListLayersInput{}, func(page *lambda.ListLayersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
-		return fmt.Errorf("Error retrieving Lambda layers: %s", err)
-	}
 
-	if len(resp.Layers) == 0 {
-		log.Print("[DEBUG] No aws lambda layers to sweep")
-		return nil
-	}
+		for _, l := range page.Layers {
+			layerName := aws.StringValue(l.LayerName)
 
-	for _, l := range resp.Layers {
-		versionResp, err := conn.ListLayerVersions(&lambda.ListLayerVersionsInput{
-			LayerName: l.LayerName,
-		})
-		if err != nil {
-			return fmt.Errorf("Error retrieving versions for lambda layer: %s", err)
-		}
+			err := conn.ListLayerVersionsPages(&lambda.ListLayerVersionsInput{LayerName: l.LayerName}, func(page *lambda.ListLayerVersionsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
 
-		for _, v := range versionResp.LayerVersions {
-			_, err := conn.DeleteLayerVersion(&lambda.DeleteLayerVersionInput{
-				LayerName:     l.LayerName,
-				VersionNumber: v.Version,
+				for _, v := range page.LayerVersions {
+					r := ResourceLayerVersion()
+					d := r.Data(nil)
+					d.SetId(aws.StringValue(v.LayerVersionArn))
+					d.Set("layer_name", layerName)
+					d.Set("version", strconv.FormatInt(aws.Int64Value(v.Version), 10))
+
+					sweepResource := sweep.NewSweepResource(r, d, client).WithName(layerName)
+
+					if t, err := time.Parse(lambdaTimestampLayout, aws.StringValue(v.CreatedDate)); err == nil {
+						sweepResource.WithCreationTime(t)
+					}
+
+					sweepResources = append(sweepResources, sweepResource)
+				}
+
+				return !lastPage
 			})
+
 			if err != nil {
-				return err
+				errs = multierror.Append(errs, fmt.Errorf("error listing Lambda Layer (%s) Versions (%s): %w", layerName, region, err))
 			}
 		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Lambda Layer sweep for %s: %s", region, err)
+		return nil
 	}
 
-	return nil
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Lambda Layers (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Lambda Layer Versions (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
 }
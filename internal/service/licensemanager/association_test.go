@@ -41,7 +41,7 @@ func TestAccLicenseManagerAssociation_basic(t *testing.T) {
 
 func testAccCheckLicenseManagerAssociationExists(resourceName string, licenseSpecification *licensemanager.LicenseSpecification) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LicenseManagerConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LicenseManagerConn()
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -72,7 +72,7 @@ func testAccCheckLicenseManagerAssociationExists(resourceName string, licenseSpe
 }
 
 func testAccCheckLicenseManagerAssociationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LicenseManagerConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LicenseManagerConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_licensemanager_association" {
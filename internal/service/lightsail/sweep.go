@@ -32,7 +32,7 @@ func sweepInstances(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).LightsailConn
+	conn := client.(*conns.AWSClient).LightsailConn()
 
 	input := &lightsail.GetInstancesInput{}
 	var sweeperErrs *multierror.Error
@@ -80,7 +80,7 @@ func sweepStaticIPs(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).LightsailConn
+	conn := client.(*conns.AWSClient).LightsailConn()
 
 	input := &lightsail.GetStaticIpsInput{}
 
@@ -32,7 +32,7 @@ func DataSourceRegions() *schema.Resource {
 }
 
 func dataSourceRegionsRead(d *schema.ResourceData, meta interface{}) error {
-	connection := meta.(*conns.AWSClient).EC2Conn
+	connection := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[DEBUG] Reading regions.")
 	request := &ec2.DescribeRegionsInput{}
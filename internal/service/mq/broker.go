@@ -102,7 +102,7 @@ func ResourceBroker() *schema.Resource {
 							Optional:     true,
 							Computed:     true,
 							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("kms", "key", "alias"),
 						},
 						"use_aws_owned_key": {
 							Type:     schema.TypeBool,
@@ -344,7 +344,7 @@ func ResourceBroker() *schema.Resource {
 }
 
 func resourceBrokerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).MQConn
+	conn := meta.(*conns.AWSClient).MQConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -413,7 +413,7 @@ func resourceBrokerCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBrokerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).MQConn
+	conn := meta.(*conns.AWSClient).MQConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -499,7 +499,7 @@ func resourceBrokerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).MQConn
+	conn := meta.(*conns.AWSClient).MQConn()
 
 	requiresReboot := false
 
@@ -595,7 +595,7 @@ func resourceBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBrokerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).MQConn
+	conn := meta.(*conns.AWSClient).MQConn()
 
 	log.Printf("[INFO] Deleting MQ Broker: %s", d.Id())
 	_, err := conn.DeleteBroker(&mq.DeleteBrokerInput{
@@ -1249,7 +1249,7 @@ func TestAccMQBroker_ldap(t *testing.T) {
 }
 
 func testAccCheckBrokerDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).MQConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MQConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_mq_broker" {
@@ -1285,7 +1285,7 @@ func testAccCheckBrokerExists(name string, broker *mq.DescribeBrokerResponse) re
 			return fmt.Errorf("No MQ Broker is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).MQConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MQConn()
 		resp, err := conn.DescribeBroker(&mq.DescribeBrokerInput{
 			BrokerId: aws.String(rs.Primary.ID),
 		})
@@ -1301,7 +1301,7 @@ func testAccCheckBrokerExists(name string, broker *mq.DescribeBrokerResponse) re
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).MQConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MQConn()
 
 	input := &mq.ListBrokersInput{}
 
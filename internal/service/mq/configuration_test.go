@@ -183,7 +183,7 @@ func TestAccMQConfiguration_updateTags(t *testing.T) {
 }
 
 func testAccCheckConfigurationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).MQConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MQConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_mq_configuration" {
@@ -588,7 +588,7 @@ func testAccCheckClusterDestroy(s *terraform.State) error {
 }
 
 func testAccCheckClusterDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*conns.AWSClient).NeptuneConn
+	conn := provider.Meta().(*conns.AWSClient).NeptuneConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_neptune_cluster" {
@@ -638,7 +638,7 @@ func testAccCheckClusterExistsWithProvider(n string, v *neptune.DBCluster, provi
 		}
 
 		provider := providerF()
-		conn := provider.Meta().(*conns.AWSClient).NeptuneConn
+		conn := provider.Meta().(*conns.AWSClient).NeptuneConn()
 		resp, err := conn.DescribeDBClusters(&neptune.DescribeDBClustersInput{
 			DBClusterIdentifier: aws.String(rs.Primary.ID),
 		})
@@ -666,7 +666,7 @@ func testAccCheckClusterSnapshot(rName string) resource.TestCheckFunc {
 			}
 
 			awsClient := acctest.Provider.Meta().(*conns.AWSClient)
-			conn := awsClient.NeptuneConn
+			conn := awsClient.NeptuneConn()
 
 			log.Printf("[INFO] Deleting the Snapshot %s", rName)
 			_, snapDeleteErr := conn.DeleteDBClusterSnapshot(
@@ -278,7 +278,7 @@ func testAccCheckLayerExists(n string, opslayer *opsworks.Layer) resource.TestCh
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn()
 
 		params := &opsworks.DescribeLayersInput{
 			LayerIds: []*string{aws.String(rs.Primary.ID)},
@@ -300,7 +300,7 @@ func testAccCheckLayerExists(n string, opslayer *opsworks.Layer) resource.TestCh
 }
 
 func testAccCheckLayerDestroy(resourceType string, s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != resourceType {
 			continue
@@ -75,7 +75,7 @@ func testAccCheckUserProfileExists(
 			return fmt.Errorf("User Profile user arn is missing, should be set.")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn()
 
 		params := &opsworks.DescribeUserProfilesInput{
 			IamUserArns: []*string{aws.String(rs.Primary.Attributes["user_arn"])},
@@ -106,7 +106,7 @@ func testAccCheckUserProfileExists(
 }
 
 func testAccCheckUserProfileDestroy(s *terraform.State) error {
-	client := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn
+	client := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_opsworks_user_profile" {
@@ -49,7 +49,7 @@ func DataSourceOutposts() *schema.Resource {
 }
 
 func dataSourceOutpostsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).OutpostsConn
+	conn := meta.(*conns.AWSClient).OutpostsConn()
 
 	input := &outposts.ListOutpostsInput{}
 
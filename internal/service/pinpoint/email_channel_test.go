@@ -154,7 +154,7 @@ func testAccCheckEmailChannelExists(n string, channel *pinpoint.EmailChannelResp
 			return fmt.Errorf("No Pinpoint Email Channel with that application ID exists")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn()
 
 		// Check if the app exists
 		params := &pinpoint.GetEmailChannelInput{
@@ -314,7 +314,7 @@ resource "aws_ses_domain_identity" "test" {
 }
 
 func testAccCheckEmailChannelDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_pinpoint_email_channel" {
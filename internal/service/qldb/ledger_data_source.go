@@ -44,7 +44,7 @@ func DataSourceLedger() *schema.Resource {
 }
 
 func dataSourceLedgerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).QLDBConn
+	conn := meta.(*conns.AWSClient).QLDBConn()
 
 	target := d.Get("name")
 
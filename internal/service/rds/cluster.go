@@ -424,7 +424,7 @@ func ResourceCluster() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("kms", "key", "alias"),
 			},
 
 			"replication_source_identifier": {
@@ -494,7 +494,7 @@ func resourceClusterImport(
 }
 
 func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -966,7 +966,7 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Wait, catching any errors
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForStateWithProgress(stateConf, fmt.Sprintf("RDS Cluster (%s) create", d.Id()))
 	if err != nil {
 		return fmt.Errorf("Error waiting for RDS Cluster state to be \"available\": %s", err)
 	}
@@ -1000,7 +1000,7 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -1150,7 +1150,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 	requestUpdate := false
 
 	req := &rds.ModifyDBClusterInput{
@@ -1361,7 +1361,7 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 	log.Printf("[DEBUG] Destroying RDS Cluster (%s)", d.Id())
 
 	// Automatically remove from global cluster to bypass this error on deletion:
@@ -1515,7 +1515,7 @@ func waitForRDSClusterUpdate(conn *rds.RDS, id string, timeout time.Duration) er
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForStateWithProgress(stateConf, fmt.Sprintf("RDS Cluster (%s) update", id))
 	return err
 }
 
@@ -1529,7 +1529,7 @@ func WaitForClusterDeletion(conn *rds.RDS, id string, timeout time.Duration) err
 		Delay:      30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForStateWithProgress(stateConf, fmt.Sprintf("RDS Cluster (%s) delete", id))
 
 	return err
 }
@@ -237,7 +237,7 @@ func ResourceClusterInstance() *schema.Resource {
 }
 
 func resourceClusterInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -339,7 +339,7 @@ func resourceClusterInstanceCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForStateWithProgress(stateConf, fmt.Sprintf("RDS Cluster Instance (%s) create", d.Id()))
 	if err != nil {
 		return err
 	}
@@ -409,7 +409,7 @@ func resourceClusterInstanceCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceClusterInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -503,7 +503,7 @@ func resourceClusterInstanceRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceClusterInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 	requestUpdate := false
 
 	req := &rds.ModifyDBInstanceInput{
@@ -612,7 +612,7 @@ func resourceClusterInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 
 		// Wait, catching any errors
-		_, err = stateConf.WaitForState()
+		_, err = tfresource.WaitForStateWithProgress(stateConf, fmt.Sprintf("RDS Cluster Instance (%s) update", d.Id()))
 		if err != nil {
 			return err
 		}
@@ -631,7 +631,7 @@ func resourceClusterInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceClusterInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	input := &rds.DeleteDBInstanceInput{
 		DBInstanceIdentifier: aws.String(d.Id()),
@@ -85,7 +85,7 @@ func TestAccRDSClusterInstance_isAlreadyBeingDeleted(t *testing.T) {
 			{
 				PreConfig: func() {
 					// Get Database Instance into deleting state
-					conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+					conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
 					input := &rds.DeleteDBInstanceInput{
 						DBInstanceIdentifier: aws.String(fmt.Sprintf("tf-cluster-instance-%d", rInt)),
 						SkipFinalSnapshot:    aws.Bool(true),
@@ -877,7 +877,7 @@ func TestAccRDSClusterInstance_caCertificateIdentifier(t *testing.T) {
 }
 
 func testAccRDSPerformanceInsightsDefaultVersionPreCheck(t *testing.T, engine string) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
 
 	input := &rds.DescribeDBEngineVersionsInput{
 		DefaultOnly: aws.Bool(true),
@@ -897,7 +897,7 @@ func testAccRDSPerformanceInsightsDefaultVersionPreCheck(t *testing.T, engine st
 }
 
 func testAccRDSPerformanceInsightsPreCheck(t *testing.T, engine string, engineVersion string) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
 
 	input := &rds.DescribeOrderableDBInstanceOptionsInput{
 		Engine:        aws.String(engine),
@@ -957,7 +957,7 @@ func testAccCheckClusterInstanceExists(n string, v *rds.DBInstance) resource.Tes
 			return fmt.Errorf("No RDS Cluster Instance ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
 
 		output, err := tfrds.FindDBInstanceByID(conn, rs.Primary.ID)
 
@@ -972,7 +972,7 @@ func testAccCheckClusterInstanceExists(n string, v *rds.DBInstance) resource.Tes
 }
 
 func testAccCheckClusterInstanceDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_instance" {
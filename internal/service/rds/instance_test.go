@@ -596,7 +596,7 @@ func TestAccRDSInstance_isAlreadyBeingDeleted(t *testing.T) {
 			{
 				PreConfig: func() {
 					// Get Database Instance into deleting state
-					conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+					conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
 					input := &rds.DeleteDBInstanceInput{
 						DBInstanceIdentifier: aws.String(rName),
 						SkipFinalSnapshot:    aws.Bool(true),
@@ -3164,7 +3164,7 @@ func TestAccRDSInstance_noDeleteAutomatedBackups(t *testing.T) {
 }
 
 func testAccCheckInstanceAutomatedBackups(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_instance" {
@@ -3198,7 +3198,7 @@ func testAccCheckInstanceAutomatedBackups(s *terraform.State) error {
 }
 
 func testAccCheckInstanceDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_instance" {
@@ -3304,7 +3304,7 @@ func testAccCheckInstanceSnapshot(s *terraform.State) error {
 		}
 
 		awsClient := acctest.Provider.Meta().(*conns.AWSClient)
-		conn := awsClient.RDSConn
+		conn := awsClient.RDSConn()
 
 		log.Printf("[INFO] Trying to locate the DBInstance Final Snapshot")
 		snapOutput, err := conn.DescribeDBSnapshots(
@@ -3375,7 +3375,7 @@ func testAccCheckInstanceSnapshot(s *terraform.State) error {
 }
 
 func testAccCheckInstanceNoSnapshot(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_instance" {
@@ -3428,7 +3428,7 @@ func testAccCheckInstanceExists(n string, v *rds.DBInstance) resource.TestCheckF
 			return fmt.Errorf("No DB Instance ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
 
 		output, err := tfrds.FindDBInstanceByID(conn, rs.Primary.ID)
 
@@ -3443,7 +3443,7 @@ func testAccCheckInstanceExists(n string, v *rds.DBInstance) resource.TestCheckF
 }
 
 func testAccCheckInstanceEC2ClassicDestroy(s *terraform.State) error {
-	conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).RDSConn
+	conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).RDSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_instance" {
@@ -3478,7 +3478,7 @@ func testAccCheckInstanceEC2ClassicExists(resourceName string, v *rds.DBInstance
 			return fmt.Errorf("resource ID not set")
 		}
 
-		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).RDSConn
+		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).RDSConn()
 
 		output, err := tfrds.FindDBInstanceByID(conn, rs.Primary.ID)
 
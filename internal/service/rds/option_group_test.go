@@ -561,7 +561,7 @@ func testAccCheckOptionGroupExists(n string, v *rds.OptionGroup) resource.TestCh
 			return fmt.Errorf("No DB Option Group Name is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
 
 		opts := rds.DescribeOptionGroupsInput{
 			OptionGroupName: aws.String(rs.Primary.ID),
@@ -585,7 +585,7 @@ func testAccCheckOptionGroupExists(n string, v *rds.OptionGroup) resource.TestCh
 }
 
 func testAccCheckOptionGroupDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_option_group" {
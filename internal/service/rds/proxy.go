@@ -65,7 +65,7 @@ func ResourceProxy() *schema.Resource {
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"vpc_security_group_ids": {
 				Type:     schema.TypeSet,
@@ -103,7 +103,7 @@ func ResourceProxy() *schema.Resource {
 						"secret_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("secretsmanager", "secret"),
 						},
 					},
 				},
@@ -121,7 +121,7 @@ func ResourceProxy() *schema.Resource {
 }
 
 func resourceProxyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -250,7 +250,7 @@ func flattenDbProxyAuths(userAuthConfigs []*rds.UserAuthConfigInfo) []interface{
 }
 
 func resourceProxyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -318,7 +318,7 @@ func resourceProxyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceProxyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	if d.HasChanges(
 		"auth",
@@ -384,7 +384,7 @@ func resourceProxyUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceProxyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	params := rds.DeleteDBProxyInput{
 		DBProxyName: aws.String(d.Id()),
@@ -50,7 +50,7 @@ func TestAccRDSSecurityGroup_basic(t *testing.T) {
 }
 
 func testAccCheckSecurityGroupDestroy(s *terraform.State) error {
-	conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).RDSConn
+	conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).RDSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_security_group" {
@@ -117,7 +117,7 @@ func testAccCheckSecurityGroupExists(n string, v *rds.DBSecurityGroup) resource.
 			return fmt.Errorf("No DB Security Group ID is set")
 		}
 
-		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).RDSConn
+		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).RDSConn()
 
 		opts := rds.DescribeDBSecurityGroupsInput{
 			DBSecurityGroupName: aws.String(rs.Primary.ID),
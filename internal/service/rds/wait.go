@@ -1,10 +1,12 @@
 package rds
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -168,7 +170,7 @@ func waitDBInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rd
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateWithProgress(stateConf, fmt.Sprintf("RDS DB Instance (%s) delete", id))
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -191,7 +193,7 @@ func waitDBClusterInstanceDeleted(conn *rds.RDS, id string, timeout time.Duratio
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateWithProgress(stateConf, fmt.Sprintf("RDS Cluster Instance (%s) delete", id))
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
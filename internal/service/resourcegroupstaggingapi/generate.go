@@ -1,4 +1,4 @@
-//go:generate go run ../../generate/tags/main.go -ServiceTagsSlice
+//go:generate go run ../../generate/tags/main.go -ListTags -ListResourcesTags -ListTagsOp=GetResources -ListTagsInIDElem=ResourceARNList -ListTagsOpBatchSize=100 -ListTagsOutResElem=ResourceTagMappingList -ServiceTagsSlice
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package resourcegroupstaggingapi
@@ -0,0 +1,119 @@
+package resourcegroupstaggingapi
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	multierror "github.com/hashicorp/go-multierror"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// Resource Groups Tagging API request limits.
+const (
+	tagResourcesARNBatchSize = 20
+	tagResourcesTagBatchSize = 50
+)
+
+// The functions in this file and the generated ListTags and ListResourcesTags implement tagging for any resource with an ARN
+// in a service supported by the Resource Groups Tagging API (https://docs.aws.amazon.com/resourcegroupstagging/latest/APIReference/supported-services.html).
+// Resources whose service package has no generated ListTags or UpdateTags can use them instead.
+
+// UpdateTags updates a resource's tags using the Resource Groups Tagging API.
+// The identifier must be the resource's Amazon Resource Name (ARN).
+func UpdateTags(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	return UpdateResourcesTags(conn, []string{identifier}, oldTagsMap, newTagsMap)
+}
+
+// UpdateResourcesTags updates the tags of the resources with the specified ARNs using the Resource Groups Tagging API.
+// Requests are chunked to the API's limits. An error is returned for each resource that could not be tagged or untagged.
+func UpdateResourcesTags(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, identifiers []string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := tftags.New(oldTagsMap)
+	newTags := tftags.New(newTagsMap)
+	var errs *multierror.Error
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAWS(); len(removedTags) > 0 {
+		for _, removedTags := range removedTags.Chunks(tagResourcesTagBatchSize) {
+			for _, identifiers := range chunkStrings(identifiers, tagResourcesARNBatchSize) {
+				input := &resourcegroupstaggingapi.UntagResourcesInput{
+					ResourceARNList: aws.StringSlice(identifiers),
+					TagKeys:         aws.StringSlice(removedTags.Keys()),
+				}
+
+				output, err := conn.UntagResources(input)
+
+				if err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("error untagging resources: %w", err))
+					continue
+				}
+
+				errs = multierror.Append(errs, failedResourcesErrors("untagging", output.FailedResourcesMap)...)
+			}
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAWS(); len(updatedTags) > 0 {
+		for _, updatedTags := range updatedTags.Chunks(tagResourcesTagBatchSize) {
+			for _, identifiers := range chunkStrings(identifiers, tagResourcesARNBatchSize) {
+				input := &resourcegroupstaggingapi.TagResourcesInput{
+					ResourceARNList: aws.StringSlice(identifiers),
+					Tags:            aws.StringMap(updatedTags.Map()),
+				}
+
+				output, err := conn.TagResources(input)
+
+				if err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("error tagging resources: %w", err))
+					continue
+				}
+
+				errs = multierror.Append(errs, failedResourcesErrors("tagging", output.FailedResourcesMap)...)
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// failedResourcesErrors returns an error for each resource in a TagResources or UntagResources FailedResourcesMap, ordered by ARN.
+func failedResourcesErrors(operation string, failedResources map[string]*resourcegroupstaggingapi.FailureInfo) []error {
+	arns := make([]string, 0, len(failedResources))
+
+	for arn := range failedResources {
+		arns = append(arns, arn)
+	}
+
+	sort.Strings(arns)
+
+	errs := make([]error, 0, len(arns))
+
+	for _, arn := range arns {
+		failure := failedResources[arn]
+
+		if failure == nil {
+			errs = append(errs, fmt.Errorf("error %s resource (%s)", operation, arn))
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("error %s resource (%s): %s: %s", operation, arn, aws.StringValue(failure.ErrorCode), aws.StringValue(failure.ErrorMessage)))
+	}
+
+	return errs
+}
+
+// chunkStrings returns the values in slices of at most the specified size.
+func chunkStrings(values []string, size int) [][]string {
+	var chunks [][]string
+
+	for len(values) > size {
+		chunks = append(chunks, values[:size])
+		values = values[size:]
+	}
+
+	if len(values) > 0 {
+		chunks = append(chunks, values)
+	}
+
+	return chunks
+}
@@ -7,6 +7,59 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
+// ListTags lists resourcegroupstaggingapi service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ListTags(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, identifier string) (tftags.KeyValueTags, error) {
+	tags, err := ListResourcesTags(conn, []string{identifier})
+
+	if err != nil {
+		return tftags.New(nil), err
+	}
+
+	return tags[identifier], nil
+}
+
+// ListResourcesTags lists resourcegroupstaggingapi service tags for multiple resources.
+// Resources without tags are omitted from the result.
+func ListResourcesTags(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, identifiers []string) (map[string]tftags.KeyValueTags, error) {
+	result := make(map[string]tftags.KeyValueTags)
+
+	for i := 0; i < len(identifiers); i += 100 {
+		j := i + 100
+
+		if j > len(identifiers) {
+			j = len(identifiers)
+		}
+
+		input := &resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: aws.StringSlice(identifiers[i:j]),
+		}
+
+		err := conn.GetResourcesPages(input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, resource := range page.ResourceTagMappingList {
+				if resource == nil {
+					continue
+				}
+
+				result[aws.StringValue(resource.ResourceARN)] = KeyValueTags(resource.Tags)
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
 // []*SERVICE.Tag handling
 
 // Tags returns resourcegroupstaggingapi service tags.
@@ -0,0 +1,226 @@
+package resourcegroupstaggingapi_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+)
+
+type taggingAPIRequest struct {
+	Operation       string
+	ResourceARNList []string
+	PaginationToken string
+	TagKeys         []string
+	Tags            map[string]string
+}
+
+// newTaggingAPIConn returns a conn for a fake Resource Groups Tagging API that records requests and responds with the handler's output.
+func newTaggingAPIConn(t *testing.T, handler func(request taggingAPIRequest) interface{}) (*resourcegroupstaggingapi.ResourceGroupsTaggingAPI, func() []taggingAPIRequest) {
+	var mu sync.Mutex
+	var requests []taggingAPIRequest
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request taggingAPIRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("error decoding request: %s", err)
+		}
+
+		request.Operation = strings.TrimPrefix(r.Header.Get("X-Amz-Target"), "ResourceGroupsTaggingAPI_20170126.")
+
+		mu.Lock()
+		requests = append(requests, request)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		json.NewEncoder(w).Encode(handler(request)) //nolint:errcheck
+	}))
+	t.Cleanup(ts.Close)
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(ts.URL),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	return resourcegroupstaggingapi.New(sess), func() []taggingAPIRequest {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return requests
+	}
+}
+
+func testTaggingAPIARNs(n int) []string {
+	var arns []string
+
+	for i := 0; i < n; i++ {
+		arns = append(arns, fmt.Sprintf("arn:aws:sqs:us-west-2:123456789012:queue-%d", i)) //lintignore:AWSAT003,AWSAT005
+	}
+
+	return arns
+}
+
+func TestListResourcesTags(t *testing.T) {
+	arns := testTaggingAPIARNs(150)
+
+	conn, requests := newTaggingAPIConn(t, func(request taggingAPIRequest) interface{} {
+		// Return each ARN batch in two pages, omitting the last ARN as an untagged resource.
+		arns := request.ResourceARNList[:len(request.ResourceARNList)-1]
+		half := len(arns) / 2
+		output := map[string]interface{}{}
+
+		if request.PaginationToken == "" {
+			arns = arns[:half]
+			output["PaginationToken"] = "next"
+		} else {
+			arns = arns[half:]
+		}
+
+		var mappings []map[string]interface{}
+
+		for _, arn := range arns {
+			mappings = append(mappings, map[string]interface{}{
+				"ResourceARN": arn,
+				"Tags":        []map[string]string{{"Key": "Name", "Value": arn}},
+			})
+		}
+
+		output["ResourceTagMappingList"] = mappings
+
+		return output
+	})
+
+	got, err := tfresourcegroupstaggingapi.ListResourcesTags(conn, arns)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(requests()), 4; got != expected {
+		t.Errorf("got %d requests, expected %d", got, expected)
+	}
+
+	for _, request := range requests() {
+		if got, limit := len(request.ResourceARNList), 100; got > limit {
+			t.Errorf("got %d ARNs in request, expected at most %d", got, limit)
+		}
+	}
+
+	if got, expected := len(got), 148; got != expected {
+		t.Errorf("got tags for %d resources, expected %d", got, expected)
+	}
+
+	if got, expected := got[arns[0]].Map()["Name"], arns[0]; got != expected {
+		t.Errorf("got Name tag %q, expected %q", got, expected)
+	}
+
+	if _, ok := got[arns[len(arns)-1]]; ok {
+		t.Errorf("got tags for untagged resource %s", arns[len(arns)-1])
+	}
+
+	tags, err := tfresourcegroupstaggingapi.ListTags(conn, arns[1])
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := len(tags); got != 0 {
+		t.Errorf("got %d tags for untagged resource, expected 0", got)
+	}
+}
+
+func TestUpdateResourcesTags(t *testing.T) {
+	arns := testTaggingAPIARNs(25)
+	oldTags := map[string]interface{}{"removed": "value", "unchanged": "value", "updated": "old", "aws:cloudformation:stack-name": "stack"}
+	newTags := map[string]interface{}{"unchanged": "value", "updated": "new"}
+
+	for i := 0; i < 60; i++ {
+		newTags[fmt.Sprintf("key%02d", i)] = "value"
+	}
+
+	conn, requests := newTaggingAPIConn(t, func(request taggingAPIRequest) interface{} {
+		failed := map[string]interface{}{}
+
+		if request.Operation == "TagResources" && request.ResourceARNList[0] == arns[0] {
+			failed[arns[1]] = map[string]interface{}{
+				"ErrorCode":    "InvalidParameterException",
+				"ErrorMessage": "tagging failed",
+				"StatusCode":   400,
+			}
+		}
+
+		return map[string]interface{}{"FailedResourcesMap": failed}
+	})
+
+	err := tfresourcegroupstaggingapi.UpdateResourcesTags(conn, arns, oldTags, newTags)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if expected := fmt.Sprintf("error tagging resource (%s): InvalidParameterException: tagging failed", arns[1]); !strings.Contains(err.Error(), expected) {
+		t.Errorf("expected error containing %q, got: %s", expected, err)
+	}
+
+	var untagRequests, tagRequests int
+	taggedKeys := map[string]bool{}
+
+	for _, request := range requests() {
+		if got, limit := len(request.ResourceARNList), 20; got > limit {
+			t.Errorf("got %d ARNs in %s request, expected at most %d", got, request.Operation, limit)
+		}
+
+		switch request.Operation {
+		case "UntagResources":
+			untagRequests++
+
+			if got, expected := strings.Join(request.TagKeys, ","), "removed"; got != expected {
+				t.Errorf("got untagged keys %q, expected %q", got, expected)
+			}
+		case "TagResources":
+			tagRequests++
+
+			if got, limit := len(request.Tags), 50; got > limit {
+				t.Errorf("got %d tags in request, expected at most %d", got, limit)
+			}
+
+			for k := range request.Tags {
+				taggedKeys[k] = true
+			}
+		default:
+			t.Errorf("unexpected operation: %s", request.Operation)
+		}
+	}
+
+	// 25 ARNs in 2 batches; 1 removed tag and 61 updated tags in 1 and 2 batches.
+	if got, expected := untagRequests, 2; got != expected {
+		t.Errorf("got %d UntagResources requests, expected %d", got, expected)
+	}
+
+	if got, expected := tagRequests, 4; got != expected {
+		t.Errorf("got %d TagResources requests, expected %d", got, expected)
+	}
+
+	if got, expected := len(taggedKeys), 61; got != expected {
+		t.Errorf("got %d tagged keys, expected %d", got, expected)
+	}
+
+	if taggedKeys["unchanged"] || taggedKeys["aws:cloudformation:stack-name"] {
+		t.Errorf("unexpected tagged keys: %v", taggedKeys)
+	}
+}
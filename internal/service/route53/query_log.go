@@ -31,7 +31,7 @@ func ResourceQueryLog() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("logs", "log-group"),
 			},
 
 			"zone_id": {
@@ -44,7 +44,7 @@ func ResourceQueryLog() *schema.Resource {
 }
 
 func resourceQueryLogCreate(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*conns.AWSClient).Route53Conn
+	r53 := meta.(*conns.AWSClient).Route53Conn()
 
 	input := &route53.CreateQueryLoggingConfigInput{
 		CloudWatchLogsLogGroupArn: aws.String(d.Get("cloudwatch_log_group_arn").(string)),
@@ -64,7 +64,7 @@ func resourceQueryLogCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceQueryLogRead(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*conns.AWSClient).Route53Conn
+	r53 := meta.(*conns.AWSClient).Route53Conn()
 
 	input := &route53.GetQueryLoggingConfigInput{
 		Id: aws.String(d.Id()),
@@ -95,7 +95,7 @@ func resourceQueryLogRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceQueryLogDelete(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*conns.AWSClient).Route53Conn
+	r53 := meta.(*conns.AWSClient).Route53Conn()
 
 	input := &route53.DeleteQueryLoggingConfigInput{
 		Id: aws.String(d.Id()),
@@ -121,7 +121,7 @@ func ResourceZone() *schema.Resource {
 }
 
 func resourceZoneCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	region := meta.(*conns.AWSClient).Region
@@ -181,7 +181,7 @@ func resourceZoneCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceZoneRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -273,7 +273,7 @@ func resourceZoneRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceZoneUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 	region := meta.(*conns.AWSClient).Region
 
 	if d.HasChange("comment") {
@@ -334,7 +334,7 @@ func resourceZoneUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceZoneDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 
 	if d.Get("force_destroy").(bool) {
 		if err := deleteAllRecordsInHostedZoneId(d.Id(), d.Get("name").(string), conn); err != nil {
@@ -49,7 +49,7 @@ func sweepClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).Route53RecoveryControlConfigConn
+	conn := client.(*conns.AWSClient).Route53RecoveryControlConfigConn()
 	input := &r53rcc.ListClustersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -92,7 +92,7 @@ func sweepControlPanels(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).Route53RecoveryControlConfigConn
+	conn := client.(*conns.AWSClient).Route53RecoveryControlConfigConn()
 	input := &r53rcc.ListClustersInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -162,7 +162,7 @@ func sweepRoutingControls(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).Route53RecoveryControlConfigConn
+	conn := client.(*conns.AWSClient).Route53RecoveryControlConfigConn()
 	input := &r53rcc.ListClustersInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -250,7 +250,7 @@ func sweepSafetyRules(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).Route53RecoveryControlConfigConn
+	conn := client.(*conns.AWSClient).Route53RecoveryControlConfigConn()
 	input := &r53rcc.ListClustersInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
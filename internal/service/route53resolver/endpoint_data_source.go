@@ -72,7 +72,7 @@ func DataSourceEndpoint() *schema.Resource {
 }
 
 func dataSourceEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 	req := &route53resolver.ListResolverEndpointsInput{}
 
 	resolvers := make([]*route53resolver.ResolverEndpoint, 0)
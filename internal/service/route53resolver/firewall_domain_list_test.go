@@ -163,7 +163,7 @@ func TestAccRoute53ResolverFirewallDomainList_tags(t *testing.T) {
 }
 
 func testAccCheckRoute53ResolverFirewallDomainListDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_resolver_firewall_domain_list" {
@@ -196,7 +196,7 @@ func testAccCheckRoute53ResolverFirewallDomainListExists(n string, v *route53res
 			return fmt.Errorf("No Route 53 Resolver DNS Firewall domain list ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn()
 		out, err := tfroute53resolver.FindFirewallDomainListByID(conn, rs.Primary.ID)
 		if err != nil {
 			return err
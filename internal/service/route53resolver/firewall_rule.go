@@ -83,7 +83,7 @@ func ResourceFirewallRule() *schema.Resource {
 }
 
 func resourceFirewallRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	firewallRuleGroupId := d.Get("firewall_rule_group_id").(string)
 	firewallDomainListId := d.Get("firewall_domain_list_id").(string)
@@ -124,7 +124,7 @@ func resourceFirewallRuleCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	rule, err := FindFirewallRuleByID(conn, d.Id())
 
@@ -158,7 +158,7 @@ func resourceFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceFirewallRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	input := &route53resolver.UpdateFirewallRuleInput{
 		Name:                 aws.String(d.Get("name").(string)),
@@ -194,7 +194,7 @@ func resourceFirewallRuleUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	_, err := conn.DeleteFirewallRule(&route53resolver.DeleteFirewallRuleInput{
 		FirewallRuleGroupId:  aws.String(d.Get("firewall_rule_group_id").(string)),
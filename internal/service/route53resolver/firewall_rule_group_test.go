@@ -126,7 +126,7 @@ func TestAccRoute53ResolverFirewallRuleGroup_tags(t *testing.T) {
 }
 
 func testAccCheckRoute53ResolverFirewallRuleGroupDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_resolver_firewall_rule_group" {
@@ -159,7 +159,7 @@ func testAccCheckRoute53ResolverFirewallRuleGroupExists(n string, v *route53reso
 			return fmt.Errorf("No Route 53 Resolver DNS Firewall rule group ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn()
 		out, err := tfroute53resolver.FindFirewallRuleGroupByID(conn, rs.Primary.ID)
 		if err != nil {
 			return err
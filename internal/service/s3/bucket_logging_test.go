@@ -299,7 +299,7 @@ func TestAccS3BucketLogging_TargetGrantByGroup(t *testing.T) {
 }
 
 func testAccCheckBucketLoggingDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_bucket_logging" {
@@ -348,7 +348,7 @@ func testAccCheckBucketLoggingExists(resourceName string) resource.TestCheckFunc
 			return fmt.Errorf("Resource (%s) ID not set", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		bucket, expectedBucketOwner, err := tfs3.ParseResourceID(rs.Primary.ID)
 		if err != nil {
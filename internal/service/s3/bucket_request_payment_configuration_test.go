@@ -107,7 +107,7 @@ func TestAccS3BucketRequestPaymentConfiguration_update(t *testing.T) {
 }
 
 func testAccCheckBucketRequestPaymentConfigurationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_bucket_request_payment_configuration" {
@@ -156,7 +156,7 @@ func testAccCheckBucketRequestPaymentConfigurationExists(resourceName string) re
 			return fmt.Errorf("Resource (%s) ID not set", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		bucket, expectedBucketOwner, err := tfs3.ParseResourceID(rs.Primary.ID)
 		if err != nil {
@@ -135,7 +135,7 @@ func TestAccS3BucketVersioning_MFADelete(t *testing.T) {
 }
 
 func testAccCheckBucketVersioningDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_bucket_versioning" {
@@ -175,7 +175,7 @@ func testAccCheckBucketVersioningExists(resourceName string) resource.TestCheckF
 			return fmt.Errorf("Resource (%s) ID not set", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		input := &s3.GetBucketVersioningInput{
 			Bucket: aws.String(rs.Primary.ID),
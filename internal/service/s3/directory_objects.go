@@ -0,0 +1,826 @@
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	directoryObjectsDefaultMaxConcurrency = 10
+	directoryObjectsDeleteBatchSize       = 1000
+)
+
+func ResourceDirectoryObjects() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDirectoryObjectsCreate,
+		Read:   resourceDirectoryObjectsRead,
+		Update: resourceDirectoryObjectsUpdate,
+		Delete: resourceDirectoryObjectsDelete,
+
+		CustomizeDiff: resourceDirectoryObjectsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Default:      s3.ObjectCannedACLPrivate,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"bucket_key_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"delete_orphans": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateDirectoryObjectsGlob,
+				},
+			},
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"include": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateDirectoryObjectsGlob,
+				},
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARNForService("kms", "key", "alias"),
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      directoryObjectsDefaultMaxConcurrency,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				ValidateFunc: validateMetadataIsLowerCase,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_disposition": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metadata": {
+							Type:         schema.TypeMap,
+							ValidateFunc: validateMetadataIsLowerCase,
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateDirectoryObjectsGlob,
+						},
+					},
+				},
+			},
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectStorageClass_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDirectoryObjectsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	files, err := directoryObjectsLocalFiles(d)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(DirectoryObjectsCreateResourceID(bucket, prefix))
+
+	// Only the uploaded objects are managed if any upload fails.
+	uploaded, err := uploadDirectoryObjects(conn, d, files, files.keys())
+
+	hashes := make(map[string]string, len(uploaded))
+
+	for _, key := range uploaded {
+		hashes[key] = files[key].hash
+	}
+
+	if err := d.Set("files", hashes); err != nil {
+		return fmt.Errorf("error setting files: %w", err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error uploading S3 Directory Objects (%s): %w", d.Id(), err)
+	}
+
+	if d.Get("delete_orphans").(bool) {
+		orphans, err := findDirectoryObjectsOrphans(conn, bucket, prefix, files.hashes())
+
+		if err != nil {
+			return fmt.Errorf("error listing S3 Directory Objects (%s) orphans: %w", d.Id(), err)
+		}
+
+		if err := deleteDirectoryObjects(conn, bucket, orphans); err != nil {
+			return fmt.Errorf("error deleting S3 Directory Objects (%s) orphans: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDirectoryObjectsRead(d, meta)
+}
+
+func resourceDirectoryObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	remote, err := findDirectoryObjectKeys(conn, bucket, prefix)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing S3 Directory Objects (%s) from state", bucket, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Directory Objects (%s): %w", d.Id(), err)
+	}
+
+	// Objects deleted outside of Terraform are removed, so that they are uploaded again.
+	// Unmanaged objects are added without a hash, so that they are deleted if delete_orphans is enabled.
+	files := make(map[string]string)
+
+	for key, hash := range d.Get("files").(map[string]interface{}) {
+		if remote[key] {
+			files[key] = hash.(string)
+		}
+	}
+
+	if d.Get("delete_orphans").(bool) {
+		for key := range remote {
+			if _, ok := files[key]; !ok {
+				files[key] = ""
+			}
+		}
+	}
+
+	if err := d.Set("files", files); err != nil {
+		return fmt.Errorf("error setting files: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDirectoryObjectsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+
+	files, err := directoryObjectsLocalFiles(d)
+
+	if err != nil {
+		return err
+	}
+
+	// If any upload or deletion fails, the prior state is kept so that all changes are planned again.
+	d.Partial(true)
+
+	o, _ := d.GetChange("files")
+	old := o.(map[string]interface{})
+
+	// Changes to upload settings apply to all objects.
+	uploadAll := d.HasChanges(directoryObjectsUploadSettingKeys...)
+
+	var uploads []string
+
+	for _, key := range files.keys() {
+		if hash, ok := old[key]; uploadAll || !ok || hash.(string) != files[key].hash {
+			uploads = append(uploads, key)
+		}
+	}
+
+	if _, err := uploadDirectoryObjects(conn, d, files, uploads); err != nil {
+		return fmt.Errorf("error uploading S3 Directory Objects (%s): %w", d.Id(), err)
+	}
+
+	var deletes []string
+
+	for key := range old {
+		if _, ok := files[key]; !ok {
+			deletes = append(deletes, key)
+		}
+	}
+
+	if d.Get("delete_orphans").(bool) {
+		orphans, err := findDirectoryObjectsOrphans(conn, bucket, d.Get("prefix").(string), files.hashes())
+
+		if err != nil {
+			return fmt.Errorf("error listing S3 Directory Objects (%s) orphans: %w", d.Id(), err)
+		}
+
+		for _, key := range orphans {
+			if _, ok := old[key]; !ok {
+				deletes = append(deletes, key)
+			}
+		}
+	}
+
+	sort.Strings(deletes)
+
+	if err := deleteDirectoryObjects(conn, bucket, deletes); err != nil {
+		return fmt.Errorf("error deleting S3 Directory Objects (%s): %w", d.Id(), err)
+	}
+
+	d.Partial(false)
+
+	if err := d.Set("files", files.hashes()); err != nil {
+		return fmt.Errorf("error setting files: %w", err)
+	}
+
+	return resourceDirectoryObjectsRead(d, meta)
+}
+
+func resourceDirectoryObjectsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+
+	var keys []string
+
+	for key := range d.Get("files").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	log.Printf("[DEBUG] Deleting S3 Directory Objects: %s", d.Id())
+	err := deleteDirectoryObjects(conn, bucket, keys)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Directory Objects (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceDirectoryObjectsCustomizeDiff plans the files to upload and delete by comparing
+// the hashes of the local files with those in state.
+func resourceDirectoryObjectsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Orphans are the objects listed by prefix, so a prefix such as "app" would also match sibling prefixes such as "app-v2/".
+	if d.NewValueKnown("prefix") && d.NewValueKnown("delete_orphans") && d.Get("delete_orphans").(bool) {
+		if prefix := d.Get("prefix").(string); prefix != "" && !strings.HasSuffix(prefix, "/") {
+			return fmt.Errorf("prefix (%s) must end with / when delete_orphans is enabled", prefix)
+		}
+	}
+
+	for _, key := range []string{"exclude", "include", "prefix", "source_dir"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("files")
+		}
+	}
+
+	files, err := directoryObjectsLocalFiles(d)
+
+	if err != nil {
+		return err
+	}
+
+	o, _ := d.GetChange("files")
+	old := o.(map[string]interface{})
+	hashes := files.hashes()
+
+	if len(old) == len(hashes) {
+		changed := false
+
+		for key, hash := range hashes {
+			if v, ok := old[key]; !ok || v.(string) != hash {
+				changed = true
+				break
+			}
+		}
+
+		if !changed {
+			return nil
+		}
+	}
+
+	return d.SetNew("files", hashes)
+}
+
+// directoryObjectsUploadSettingKeys are the arguments that apply to all uploaded objects.
+var directoryObjectsUploadSettingKeys = []string{
+	"acl",
+	"bucket_key_enabled",
+	"cache_control",
+	"kms_key_id",
+	"metadata",
+	"rule",
+	"server_side_encryption",
+	"storage_class",
+}
+
+func DirectoryObjectsCreateResourceID(bucket, prefix string) string {
+	return fmt.Sprintf("%s/%s", bucket, prefix)
+}
+
+// directoryObjectsFile is a local file to upload.
+type directoryObjectsFile struct {
+	path string
+	hash string
+
+	cacheControl       string
+	contentDisposition string
+	contentEncoding    string
+	contentType        string
+	metadata           map[string]interface{}
+}
+
+// directoryObjectsFiles are the local files to upload, keyed by object key.
+type directoryObjectsFiles map[string]*directoryObjectsFile
+
+func (files directoryObjectsFiles) keys() []string {
+	keys := make([]string, 0, len(files))
+
+	for key := range files {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (files directoryObjectsFiles) hashes() map[string]string {
+	hashes := make(map[string]string, len(files))
+
+	for key, file := range files {
+		hashes[key] = file.hash
+	}
+
+	return hashes
+}
+
+type directoryObjectsRule struct {
+	pattern *regexp.Regexp
+
+	cacheControl       string
+	contentDisposition string
+	contentEncoding    string
+	contentType        string
+	metadata           map[string]interface{}
+}
+
+// directoryObjectsLocalFiles walks the source directory and returns the files matching the include and exclude globs,
+// with the settings of matching rules applied.
+func directoryObjectsLocalFiles(d interface{ Get(string) interface{} }) (directoryObjectsFiles, error) {
+	sourceDir, err := homedir.Expand(d.Get("source_dir").(string))
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding homedir in source_dir (%s): %w", d.Get("source_dir").(string), err)
+	}
+
+	includes := expandGlobs(d.Get("include").([]interface{}))
+	excludes := expandGlobs(d.Get("exclude").([]interface{}))
+
+	var rules []directoryObjectsRule
+
+	for _, tfMapRaw := range d.Get("rule").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rules = append(rules, directoryObjectsRule{
+			pattern:            globRegexp(tfMap["pattern"].(string)),
+			cacheControl:       tfMap["cache_control"].(string),
+			contentDisposition: tfMap["content_disposition"].(string),
+			contentEncoding:    tfMap["content_encoding"].(string),
+			contentType:        tfMap["content_type"].(string),
+			metadata:           tfMap["metadata"].(map[string]interface{}),
+		})
+	}
+
+	return directoryObjectsWalk(sourceDir, d.Get("prefix").(string), includes, excludes, rules)
+}
+
+func directoryObjectsWalk(sourceDir, prefix string, includes, excludes []*regexp.Regexp, rules []directoryObjectsRule) (directoryObjectsFiles, error) {
+	files := make(directoryObjectsFiles)
+
+	err := filepath.WalkDir(sourceDir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDir, p)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		if len(includes) > 0 && !matchAnyGlob(includes, rel) {
+			return nil
+		}
+
+		if matchAnyGlob(excludes, rel) {
+			return nil
+		}
+
+		hash, err := directoryObjectsFileHash(p)
+
+		if err != nil {
+			return err
+		}
+
+		file := &directoryObjectsFile{
+			path:        p,
+			hash:        hash,
+			contentType: mime.TypeByExtension(path.Ext(rel)),
+		}
+
+		// Later rules override the settings of earlier rules.
+		for _, rule := range rules {
+			if !rule.pattern.MatchString(rel) {
+				continue
+			}
+
+			if rule.cacheControl != "" {
+				file.cacheControl = rule.cacheControl
+			}
+
+			if rule.contentDisposition != "" {
+				file.contentDisposition = rule.contentDisposition
+			}
+
+			if rule.contentEncoding != "" {
+				file.contentEncoding = rule.contentEncoding
+			}
+
+			if rule.contentType != "" {
+				file.contentType = rule.contentType
+			}
+
+			if len(rule.metadata) > 0 {
+				if file.metadata == nil {
+					file.metadata = make(map[string]interface{})
+				}
+
+				for k, v := range rule.metadata {
+					file.metadata[k] = v
+				}
+			}
+		}
+
+		files[prefix+rel] = file
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading source_dir (%s): %w", sourceDir, err)
+	}
+
+	return files, nil
+}
+
+func directoryObjectsFileHash(path string) (string, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer file.Close()
+
+	hash := md5.New()
+
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func validateDirectoryObjectsGlob(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+	}
+
+	if strings.HasPrefix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q (%s) must be relative to source_dir", k, value))
+	}
+
+	return
+}
+
+// uploadDirectoryObjects uploads the local files with the specified keys, at most max_concurrency at a time,
+// and returns the keys of the uploaded files.
+func uploadDirectoryObjects(conn *s3.S3, d *schema.ResourceData, files directoryObjectsFiles, keys []string) ([]string, error) {
+	uploader := s3manager.NewUploaderWithClient(conn)
+	bucket := d.Get("bucket").(string)
+
+	var (
+		errs     *multierror.Error
+		mu       sync.Mutex
+		uploaded []string
+		wg       sync.WaitGroup
+	)
+
+	sem := make(chan struct{}, d.Get("max_concurrency").(int))
+
+	for _, key := range keys {
+		input := &s3manager.UploadInput{
+			ACL:    aws.String(d.Get("acl").(string)),
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+
+		file := files[key]
+
+		if v, ok := d.GetOk("storage_class"); ok {
+			input.StorageClass = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("cache_control"); ok {
+			input.CacheControl = aws.String(v.(string))
+		}
+
+		if file.cacheControl != "" {
+			input.CacheControl = aws.String(file.cacheControl)
+		}
+
+		if file.contentDisposition != "" {
+			input.ContentDisposition = aws.String(file.contentDisposition)
+		}
+
+		if file.contentEncoding != "" {
+			input.ContentEncoding = aws.String(file.contentEncoding)
+		}
+
+		if file.contentType != "" {
+			input.ContentType = aws.String(file.contentType)
+		}
+
+		metadata := make(map[string]interface{})
+
+		for k, v := range d.Get("metadata").(map[string]interface{}) {
+			metadata[k] = v
+		}
+
+		for k, v := range file.metadata {
+			metadata[k] = v
+		}
+
+		if len(metadata) > 0 {
+			input.Metadata = flex.ExpandStringMap(metadata)
+		}
+
+		if v, ok := d.GetOk("bucket_key_enabled"); ok {
+			input.BucketKeyEnabled = aws.Bool(v.(bool))
+		}
+
+		if v, ok := d.GetOk("server_side_encryption"); ok {
+			input.ServerSideEncryption = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("kms_key_id"); ok {
+			input.SSEKMSKeyId = aws.String(v.(string))
+			input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func(path string, input *s3manager.UploadInput) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			err := uploadDirectoryObject(uploader, path, input)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error uploading S3 Object (%s) from %s: %w", aws.StringValue(input.Key), path, err))
+				return
+			}
+
+			uploaded = append(uploaded, aws.StringValue(input.Key))
+		}(file.path, input)
+	}
+
+	wg.Wait()
+
+	sort.Strings(uploaded)
+
+	return uploaded, errs.ErrorOrNil()
+}
+
+func uploadDirectoryObject(uploader *s3manager.Uploader, path string, input *s3manager.UploadInput) error {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
+		}
+	}()
+
+	input.Body = file
+
+	log.Printf("[DEBUG] Uploading S3 Object (%s) from %s", aws.StringValue(input.Key), path)
+	_, err = uploader.Upload(input)
+
+	return err
+}
+
+// deleteDirectoryObjects deletes the objects with the specified keys, in batches.
+func deleteDirectoryObjects(conn *s3.S3, bucket string, keys []string) error {
+	var errs *multierror.Error
+
+	for i := 0; i < len(keys); i += directoryObjectsDeleteBatchSize {
+		j := i + directoryObjectsDeleteBatchSize
+
+		if j > len(keys) {
+			j = len(keys)
+		}
+
+		var objects []*s3.ObjectIdentifier
+
+		for _, key := range keys[i:j] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+
+		output, err := conn.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.Errors {
+			errs = multierror.Append(errs, fmt.Errorf("error deleting S3 Object (%s): %s: %s", aws.StringValue(v.Key), aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// findDirectoryObjectKeys returns the keys of the objects under the prefix.
+func findDirectoryObjectKeys(conn *s3.S3, bucket, prefix string) (map[string]bool, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	keys := make(map[string]bool)
+
+	err := conn.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Contents {
+			keys[aws.StringValue(v.Key)] = true
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// findDirectoryObjectsOrphans returns the keys of the objects under the prefix without a local file.
+func findDirectoryObjectsOrphans(conn *s3.S3, bucket, prefix string, files map[string]string) ([]string, error) {
+	remote, err := findDirectoryObjectKeys(conn, bucket, prefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans []string
+
+	for key := range remote {
+		if _, ok := files[key]; !ok {
+			orphans = append(orphans, key)
+		}
+	}
+
+	sort.Strings(orphans)
+
+	return orphans, nil
+}
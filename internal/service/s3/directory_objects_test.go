@@ -0,0 +1,261 @@
+package s3_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccS3DirectoryObjects_basic(t *testing.T) {
+	resourceName := "aws_s3_directory_objects.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccDirectoryObjectsCreateSourceDir(t, map[string]string{
+		"index.html":         "<html></html>",
+		"assets/app.js":      "console.log('hello');",
+		"assets/app.js.map":  "{}",
+		"docs/guide/a.txt":   "a",
+		"docs/guide/b.txt":   "b",
+		"node_modules/x.txt": "x",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDirectoryObjectsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryObjectsConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "files.site/index.html", "c83301425b2ad1d496473a5ff3d9ecca"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/assets/app.js"),
+					resource.TestCheckNoResourceAttr(resourceName, "files.site/assets/app.js.map"),
+					resource.TestCheckNoResourceAttr(resourceName, "files.site/node_modules/x.txt"),
+					testAccCheckDirectoryObjectsObject(resourceName, "site/index.html", "text/html; charset=utf-8", "max-age=60"),
+					testAccCheckDirectoryObjectsObject(resourceName, "site/assets/app.js", "text/javascript; charset=utf-8", "max-age=31536000"),
+					testAccCheckDirectoryObjectsObject(resourceName, "site/docs/guide/a.txt", "text/plain; charset=utf-8", "max-age=60"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(filepath.Join(sourceDir, "docs/guide/a.txt"), []byte("updated"), 0644); err != nil {
+						t.Fatal(err)
+					}
+
+					if err := os.Remove(filepath.Join(sourceDir, "docs/guide/b.txt")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectoryObjectsConfig(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckNoResourceAttr(resourceName, "files.site/docs/guide/b.txt"),
+					testAccCheckDirectoryObjectsObjectNotExists(resourceName, "site/docs/guide/b.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectoryObjects_deleteOrphans(t *testing.T) {
+	resourceName := "aws_s3_directory_objects.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccDirectoryObjectsCreateSourceDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDirectoryObjectsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryObjectsDeleteOrphansConfig(rName, sourceDir, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+					_, err := conn.PutObject(&s3.PutObjectInput{
+						Body:   strings.NewReader("orphan"),
+						Bucket: aws.String(rName),
+						Key:    aws.String("site/orphan.txt"),
+					})
+
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectoryObjectsDeleteOrphansConfig(rName, sourceDir, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					testAccCheckDirectoryObjectsObjectNotExists(resourceName, "site/orphan.txt"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectoryObjectsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_directory_objects" {
+			continue
+		}
+
+		output, err := conn.ListObjectsV2(&s3.ListObjectsV2Input{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Prefix: aws.String(rs.Primary.Attributes["prefix"]),
+		})
+
+		if err != nil {
+			// The bucket is destroyed with the objects.
+			continue
+		}
+
+		if len(output.Contents) > 0 {
+			return fmt.Errorf("S3 Directory Objects %s still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDirectoryObjectsObject(n, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		output, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 Object (%s): %w", key, err)
+		}
+
+		if got := aws.StringValue(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object (%s) Content-Type: expected %s, got %s", key, contentType, got)
+		}
+
+		if got := aws.StringValue(output.CacheControl); got != cacheControl {
+			return fmt.Errorf("S3 Object (%s) Cache-Control: expected %s, got %s", key, cacheControl, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectoryObjectsObjectNotExists(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if err == nil {
+			return fmt.Errorf("S3 Object (%s) still exists", key)
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectoryObjectsCreateSourceDir(t *testing.T, files map[string]string) string {
+	sourceDir := t.TempDir()
+
+	for name, content := range files {
+		path := filepath.Join(sourceDir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return sourceDir
+}
+
+func testAccDirectoryObjectsConfig(rName, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_objects" "test" {
+  bucket        = aws_s3_bucket.test.bucket
+  prefix        = "site/"
+  source_dir    = %[2]q
+  exclude       = ["**/*.map", "node_modules/**"]
+  cache_control = "max-age=60"
+
+  rule {
+    pattern       = "assets/**"
+    cache_control = "max-age=31536000"
+  }
+
+  rule {
+    pattern      = "**/*.js"
+    content_type = "text/javascript; charset=utf-8"
+  }
+
+  rule {
+    pattern      = "**/*.html"
+    content_type = "text/html; charset=utf-8"
+  }
+
+  rule {
+    pattern      = "**/*.txt"
+    content_type = "text/plain; charset=utf-8"
+  }
+}
+`, rName, sourceDir)
+}
+
+func testAccDirectoryObjectsDeleteOrphansConfig(rName, sourceDir string, deleteOrphans bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_objects" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  prefix         = "site/"
+  source_dir     = %[2]q
+  delete_orphans = %[3]t
+}
+`, rName, sourceDir, deleteOrphans)
+}
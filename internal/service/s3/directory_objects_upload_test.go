@@ -0,0 +1,144 @@
+package s3
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestDirectoryObjectsCreateUploadFailure(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body) //nolint:errcheck
+
+		if r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/fail.txt") {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`)) //nolint:errcheck
+			return
+		}
+
+		w.Header().Set("ETag", `"d41d8cd98f00b204e9800998ecf8427e"`)
+	}))
+	defer ts.Close()
+
+	// Isolate the test from any AWS shared configuration in the environment.
+	for _, k := range []string{"AWS_CA_BUNDLE", "AWS_CONFIG_FILE", "AWS_PROFILE", "AWS_SHARED_CREDENTIALS_FILE"} {
+		t.Setenv(k, "")
+	}
+
+	writer := log.Writer()
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(writer)
+
+	client, err := (&conns.Config{
+		AccessKey:               "AKID",
+		Endpoints:               map[string]string{conns.S3: ts.URL},
+		Region:                  "us-west-2", //lintignore:AWSAT003
+		S3ForcePathStyle:        true,
+		SecretKey:               "SECRET",
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}).Client()
+
+	if err != nil {
+		t.Fatalf("error configuring client: %s", err)
+	}
+
+	sourceDir := t.TempDir()
+
+	for _, name := range []string{"fail.txt", "ok.txt"} {
+		if err := os.WriteFile(filepath.Join(sourceDir, name), []byte(name), 0600); err != nil {
+			t.Fatalf("error writing file: %s", err)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceDirectoryObjects().Schema, map[string]interface{}{
+		"bucket":     "example",
+		"prefix":     "site/",
+		"source_dir": sourceDir,
+	})
+
+	if err := resourceDirectoryObjectsCreate(d, client); err == nil {
+		t.Fatal("expected upload error")
+	}
+
+	if got, expected := d.Id(), "example/site/"; got != expected {
+		t.Errorf("got ID %s, expected %s", got, expected)
+	}
+
+	files := d.Get("files").(map[string]interface{})
+
+	if _, ok := files["site/ok.txt"]; !ok || len(files) != 1 {
+		t.Errorf("expected only the uploaded file in state, got %v", files)
+	}
+}
+
+func TestDirectoryObjectsCustomizeDiffPrefix(t *testing.T) {
+	sourceDir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(sourceDir, "index.html"), []byte("index"), 0600); err != nil {
+		t.Fatalf("error writing file: %s", err)
+	}
+
+	testCases := []struct {
+		Name          string
+		Prefix        string
+		DeleteOrphans bool
+		ExpectError   bool
+	}{
+		{
+			Name:          "sibling prefixes",
+			Prefix:        "app",
+			DeleteOrphans: true,
+			ExpectError:   true,
+		},
+		{
+			Name:          "directory prefix",
+			Prefix:        "app/",
+			DeleteOrphans: true,
+		},
+		{
+			Name:          "no prefix",
+			DeleteOrphans: true,
+		},
+		{
+			Name:   "sibling prefixes without delete_orphans",
+			Prefix: "app",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"bucket":         "example",
+				"delete_orphans": testCase.DeleteOrphans,
+				"source_dir":     sourceDir,
+			}
+
+			if testCase.Prefix != "" {
+				raw["prefix"] = testCase.Prefix
+			}
+
+			_, err := ResourceDirectoryObjects().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
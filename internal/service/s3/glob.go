@@ -0,0 +1,64 @@
+package s3
+
+import (
+	"regexp"
+	"strings"
+)
+
+func expandGlobs(tfList []interface{}) []*regexp.Regexp {
+	var globs []*regexp.Regexp
+
+	for _, tfListRaw := range tfList {
+		if v, ok := tfListRaw.(string); ok && v != "" {
+			globs = append(globs, globRegexp(v))
+		}
+	}
+
+	return globs
+}
+
+func matchAnyGlob(globs []*regexp.Regexp, rel string) bool {
+	for _, glob := range globs {
+		if glob.MatchString(rel) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// globRegexp returns a regular expression for a glob of slash-separated paths.
+// "*" matches any sequence of characters other than "/", "**" matches any sequence of characters
+// including "/", and "?" matches any character other than "/".
+func globRegexp(glob string) *regexp.Regexp {
+	var b strings.Builder
+
+	b.WriteString("^")
+
+	for i := 0; i < len(glob); i++ {
+		switch glob[i] {
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				i++
+
+				// "**/" also matches no directories.
+				if i+1 < len(glob) && glob[i+1] == '/' {
+					i++
+					b.WriteString("(.*/)?")
+				} else {
+					b.WriteString(".*")
+				}
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+
+	b.WriteString("$")
+
+	return regexp.MustCompile(b.String())
+}
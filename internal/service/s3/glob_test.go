@@ -0,0 +1,34 @@
+package s3
+
+import (
+	"testing"
+)
+
+func TestGlobRegexp(t *testing.T) {
+	testCases := []struct {
+		glob     string
+		path     string
+		expected bool
+	}{
+		{glob: "*.html", path: "index.html", expected: true},
+		{glob: "*.html", path: "docs/index.html", expected: false},
+		{glob: "**/*.html", path: "index.html", expected: true},
+		{glob: "**/*.html", path: "docs/guides/index.html", expected: true},
+		{glob: "**.html", path: "docs/index.html", expected: true},
+		{glob: "docs/**", path: "docs/guides/index.html", expected: true},
+		{glob: "docs/**", path: "assets/docs/index.html", expected: false},
+		{glob: "assets/*.js", path: "assets/app.js", expected: true},
+		{glob: "assets/*.js", path: "assets/vendor/app.js", expected: false},
+		{glob: "?.txt", path: "a.txt", expected: true},
+		{glob: "?.txt", path: "ab.txt", expected: false},
+		{glob: "file[1].txt", path: "file[1].txt", expected: true},
+		{glob: "file[1].txt", path: "file1.txt", expected: false},
+		{glob: ".well-known/*", path: ".well-known/security.txt", expected: true},
+	}
+
+	for _, testCase := range testCases {
+		if got := globRegexp(testCase.glob).MatchString(testCase.path); got != testCase.expected {
+			t.Errorf("glob %q matching %q: expected %t, got %t", testCase.glob, testCase.path, testCase.expected, got)
+		}
+	}
+}
@@ -99,12 +99,13 @@ func ResourceObject() *schema.Resource {
 			},
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with SSE-C and SSE-KMS encryption.
+				// The Etag then won't match raw-file MD5.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
-				Optional:      true,
-				Computed:      true,
-				ConflictsWith: []string{"kms_key_id"},
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"kms_key_id"},
+				DiffSuppressFunc: suppressObjectMultipartETagDiff,
 			},
 			"force_destroy": {
 				Type:     schema.TypeBool,
@@ -121,7 +122,7 @@ func ResourceObject() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("kms", "key", "alias"),
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					// ignore diffs where the user hasn't specified a kms_key_id but the bucket has a default KMS key configured
 					if new == "" && d.Get("server_side_encryption") == s3.ServerSideEncryptionAwsKms {
@@ -136,6 +137,21 @@ func ResourceObject() *schema.Resource {
 				Optional:     true,
 				Elem:         &schema.Schema{Type: schema.TypeString},
 			},
+			"multipart_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"multipart_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateObjectMultipartSize,
+			},
+			"multipart_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateObjectMultipartSize,
+			},
 			"object_lock_legal_hold_status": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -191,7 +207,7 @@ func resourceObjectCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceObjectRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -309,7 +325,7 @@ func resourceObjectUpdate(d *schema.ResourceData, meta interface{}) error {
 		return resourceObjectUpload(d, meta)
 	}
 
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -376,7 +392,7 @@ func resourceObjectUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceObjectDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -419,47 +435,39 @@ func resourceObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.R
 }
 
 func resourceObjectUpload(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
-	uploader := s3manager.NewUploaderWithClient(conn)
+	conn := meta.(*conns.AWSClient).S3Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	var body io.ReadSeeker
+	body, closeBody, err := objectUploadBody(d)
 
-	if v, ok := d.GetOk("source"); ok {
-		source := v.(string)
-		path, err := homedir.Expand(source)
-		if err != nil {
-			return fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
-		}
-		file, err := os.Open(path)
-		if err != nil {
-			return fmt.Errorf("Error opening S3 object source (%s): %s", path, err)
-		}
+	if err != nil {
+		return err
+	}
 
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
-			}
-		}()
-	} else if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		// We can't do streaming decoding here (with base64.NewDecoder) because
-		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return fmt.Errorf("error decoding content_base64: %s", err)
-		}
-		body = bytes.NewReader(contentRaw)
-	} else {
-		body = bytes.NewReader([]byte{})
+	defer closeBody()
+
+	size, err := aws.SeekerLen(body)
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 object content: %w", err)
 	}
 
+	threshold, partSize, concurrency := objectMultipartSettings(d, objectMultipartDefaultPartSize, objectMultipartDefaultPartSize)
+
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		u.Concurrency = concurrency
+		u.PartSize = partSize
+
+		// The uploader puts any object that fits in one part in a single request.
+		if size <= threshold {
+			u.PartSize = threshold
+		}
+
+		// Abort incomplete multipart uploads so that no parts are left behind.
+		u.LeavePartsOnError = false
+	})
+
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
@@ -541,11 +549,51 @@ func resourceObjectUpload(d *schema.ResourceData, meta interface{}) error {
 	return resourceObjectRead(d, meta)
 }
 
+// objectUploadBody returns the content of the object and a function that releases it.
+func objectUploadBody(d *schema.ResourceData) (io.ReadSeeker, func(), error) {
+	if v, ok := d.GetOk("source"); ok {
+		source := v.(string)
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error opening S3 object source (%s): %s", path, err)
+		}
+
+		return file, func() {
+			err := file.Close()
+			if err != nil {
+				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
+			}
+		}, nil
+	}
+
+	if v, ok := d.GetOk("content"); ok {
+		content := v.(string)
+		return bytes.NewReader([]byte(content)), func() {}, nil
+	}
+
+	if v, ok := d.GetOk("content_base64"); ok {
+		content := v.(string)
+		// We can't do streaming decoding here (with base64.NewDecoder) because
+		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
+		contentRaw, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error decoding content_base64: %s", err)
+		}
+		return bytes.NewReader(contentRaw), func() {}, nil
+	}
+
+	return bytes.NewReader([]byte{}), func() {}, nil
+}
+
 func resourceObjectSetKMS(d *schema.ResourceData, meta interface{}, sseKMSKeyId *string) error {
 	// Only set non-default KMS key ID (one that doesn't match default)
 	if sseKMSKeyId != nil {
 		// retrieve S3 KMS Default Master Key
-		conn := meta.(*conns.AWSClient).KMSConn
+		conn := meta.(*conns.AWSClient).KMSConn()
 		keyMetadata, err := kms.FindKeyByID(conn, DefaultKmsKeyAlias)
 		if err != nil {
 			return fmt.Errorf("Failed to describe default S3 KMS key (%s): %s", DefaultKmsKeyAlias, err)
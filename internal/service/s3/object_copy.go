@@ -188,7 +188,7 @@ func ResourceObjectCopy() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("kms", "key", "alias"),
 				Sensitive:    true,
 			},
 			"last_modified": {
@@ -207,6 +207,21 @@ func ResourceObjectCopy() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice(s3.MetadataDirective_Values(), false),
 			},
+			"multipart_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"multipart_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateObjectMultipartSize,
+			},
+			"multipart_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateObjectMultipartSize,
+			},
 			"object_lock_legal_hold_status": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -296,7 +311,7 @@ func resourceObjectCopyCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceObjectCopyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -449,7 +464,7 @@ func resourceObjectCopyUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceObjectCopyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -472,7 +487,7 @@ func resourceObjectCopyDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceObjectCopyDoCopy(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -625,7 +640,7 @@ func resourceObjectCopyDoCopy(d *schema.ResourceData, meta interface{}) error {
 		input.WebsiteRedirectLocation = aws.String(v.(string))
 	}
 
-	output, err := conn.CopyObject(input)
+	output, err := copyObject(conn, d, input)
 	if err != nil {
 		return fmt.Errorf("error copying S3 object (bucket: %s; key: %s; source: %s): %w", aws.StringValue(input.Bucket), aws.StringValue(input.Key), aws.StringValue(input.CopySource), err)
 	}
@@ -650,6 +665,50 @@ func resourceObjectCopyDoCopy(d *schema.ResourceData, meta interface{}) error {
 	return resourceObjectRead(d, meta)
 }
 
+// copyObject copies the object in a single request, or in parts if the source is larger than multipart_threshold.
+// Without a configured threshold, the source is only read to copy it in parts if it is too large to be copied in a single request.
+func copyObject(conn *s3.S3, d *schema.ResourceData, input *s3.CopyObjectInput) (*s3.CopyObjectOutput, error) {
+	source, ok := parseObjectCopySource(d.Get("source").(string))
+
+	if !ok {
+		return conn.CopyObject(input)
+	}
+
+	if _, ok := d.GetOk("multipart_threshold"); !ok {
+		output, err := conn.CopyObject(input)
+
+		if !tfawserr.ErrMessageContains(err, ErrCodeInvalidRequest, "copy source is larger than the maximum allowable size") {
+			return output, err
+		}
+	}
+
+	// Objects up to 5 GiB can be copied in a single request.
+	threshold, partSize, concurrency := objectMultipartSettings(d, objectMultipartMaxPartSize, objectCopyMultipartDefaultPartSize)
+
+	head, err := conn.HeadObject(&s3.HeadObjectInput{
+		Bucket:               aws.String(source.bucket),
+		ExpectedBucketOwner:  input.ExpectedSourceBucketOwner,
+		Key:                  aws.String(source.key),
+		RequestPayer:         input.RequestPayer,
+		SSECustomerAlgorithm: input.CopySourceSSECustomerAlgorithm,
+		SSECustomerKey:       input.CopySourceSSECustomerKey,
+		SSECustomerKeyMD5:    input.CopySourceSSECustomerKeyMD5,
+	})
+
+	// The source may be in another region or not readable by HeadObject, e.g. without s3:GetObject on the source.
+	if err != nil {
+		log.Printf("[WARN] Unable to read S3 object copy source (%s), copying in a single request: %s", d.Get("source").(string), err)
+
+		return conn.CopyObject(input)
+	}
+
+	if aws.Int64Value(head.ContentLength) <= threshold {
+		return conn.CopyObject(input)
+	}
+
+	return copyObjectMultipart(conn, input, source, head, partSize, concurrency)
+}
+
 type s3Grants struct {
 	FullControl *string
 	Read        *string
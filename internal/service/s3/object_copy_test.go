@@ -2,12 +2,16 @@ package s3_test
 
 import (
 	"fmt"
+	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -83,8 +87,64 @@ func TestAccS3ObjectCopy_BucketKeyEnabled_object(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectCopy_multipart(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object_copy.test"
+	sourceName := "aws_s3_object.source"
+
+	// 11 MiB, copied in three parts.
+	source := testAccObjectCreateTempFile(t, strings.Repeat("0123456789abcdef", 11*1024*1024/16))
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectCopyConfig_multipart(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectCopyExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`^[0-9a-f]{32}-3$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "content_type", sourceName, "content_type"),
+					resource.TestCheckResourceAttrPair(resourceName, "metadata.%", sourceName, "metadata.%"),
+					resource.TestCheckResourceAttrPair(resourceName, "metadata.source", sourceName, "metadata.source"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectCopy_crossRegion(t *testing.T) {
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object_copy.test"
+	sourceName := "aws_s3_object.source"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectCopyConfig_crossRegion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source", fmt.Sprintf("%s-source/source", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "etag", sourceName, "etag"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObjectCopyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_object_copy" {
@@ -115,7 +175,7 @@ func testAccCheckObjectCopyExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No S3 Object ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 		_, err := conn.GetObject(
 			&s3.GetObjectInput{
 				Bucket:  aws.String(rs.Primary.Attributes["bucket"]),
@@ -234,3 +294,60 @@ resource "aws_s3_object_copy" "test" {
 }
 `, rName)
 }
+
+func testAccObjectCopyConfig_multipart(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "source" {
+  bucket       = aws_s3_bucket.test.bucket
+  key          = "source"
+  source       = %[2]q
+  content_type = "text/plain"
+
+  metadata = {
+    source = "multipart"
+  }
+}
+
+resource "aws_s3_object_copy" "test" {
+  bucket              = aws_s3_bucket.test.bucket
+  key                 = "target"
+  source              = "${aws_s3_bucket.test.bucket}/${aws_s3_object.source.key}"
+  multipart_part_size = 5242880
+  multipart_threshold = 5242880
+}
+`, rName, source)
+}
+
+func testAccObjectCopyConfig_crossRegion(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  provider = "awsalternate"
+
+  bucket = "%[1]s-source"
+}
+
+resource "aws_s3_object" "source" {
+  provider = "awsalternate"
+
+  bucket  = aws_s3_bucket.source.bucket
+  key     = "source"
+  content = "Ingen ko på isen"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+# The source cannot be read from the destination region, so the object is copied in a single request.
+resource "aws_s3_object_copy" "test" {
+  bucket              = aws_s3_bucket.test.bucket
+  key                 = "target"
+  source              = "${aws_s3_bucket.source.bucket}/${aws_s3_object.source.key}"
+  multipart_threshold = 5242880
+}
+`, rName))
+}
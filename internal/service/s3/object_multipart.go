@@ -0,0 +1,381 @@
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// objectMultipartMaxPartSize is the largest part of a multipart upload,
+	// and the largest object that can be put or copied in a single request.
+	objectMultipartMaxPartSize int64 = 5 * 1024 * 1024 * 1024
+
+	objectMultipartDefaultConcurrency  = s3manager.DefaultUploadConcurrency
+	objectMultipartDefaultPartSize     = s3manager.DefaultUploadPartSize
+	objectCopyMultipartDefaultPartSize = 64 * 1024 * 1024
+)
+
+// objectMultipartETagRegexp matches the ETag of an object uploaded in parts,
+// the MD5 of the concatenated MD5s of the parts followed by the number of parts.
+var objectMultipartETagRegexp = regexp.MustCompile(`^[0-9a-f]{32}-[0-9]+$`)
+
+func validateObjectMultipartSize(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(int)
+
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be integer", k))
+		return ws, errors
+	}
+
+	if int64(value) < s3manager.MinUploadPartSize || int64(value) > objectMultipartMaxPartSize {
+		errors = append(errors, fmt.Errorf("expected %q to be in the range (%d - %d), got %d", k, s3manager.MinUploadPartSize, objectMultipartMaxPartSize, value))
+	}
+
+	return ws, errors
+}
+
+// objectMultipartSettings returns the configured multipart threshold, part size and concurrency,
+// or the given defaults for those not configured.
+func objectMultipartSettings(d *schema.ResourceData, defaultThreshold, defaultPartSize int64) (int64, int64, int) {
+	threshold, partSize, concurrency := defaultThreshold, defaultPartSize, objectMultipartDefaultConcurrency
+
+	if v, ok := d.GetOk("multipart_threshold"); ok {
+		threshold = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("multipart_part_size"); ok {
+		partSize = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("multipart_concurrency"); ok {
+		concurrency = v.(int)
+	}
+
+	return threshold, partSize, concurrency
+}
+
+// objectMultipartPartSize returns the part size used for an object of the given size,
+// raised if necessary for the object to fit in the maximum number of parts.
+func objectMultipartPartSize(size, partSize int64) int64 {
+	if size/partSize >= s3manager.MaxUploadParts {
+		return size/s3manager.MaxUploadParts + 1
+	}
+
+	return partSize
+}
+
+// objectUploadIsMultipart returns whether an object of the given size is uploaded in parts.
+// As with s3manager, an object no larger than one part is always uploaded in a single request.
+func objectUploadIsMultipart(size, threshold, partSize int64) bool {
+	return size > threshold && size > partSize
+}
+
+// objectETags returns the MD5 of the content and the ETag that S3 computes for the
+// content when it is uploaded with the given multipart threshold and part size.
+func objectETags(r io.Reader, size, threshold, partSize int64) (string, string, error) {
+	whole := md5.New()
+
+	if !objectUploadIsMultipart(size, threshold, partSize) {
+		if _, err := io.Copy(whole, r); err != nil {
+			return "", "", err
+		}
+
+		sum := hex.EncodeToString(whole.Sum(nil))
+
+		return sum, sum, nil
+	}
+
+	partSize = objectMultipartPartSize(size, partSize)
+	parts := md5.New()
+	n := 0
+
+	for {
+		part := md5.New()
+		written, err := io.CopyN(io.MultiWriter(whole, part), r, partSize)
+
+		if written > 0 {
+			parts.Write(part.Sum(nil))
+			n++
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	return hex.EncodeToString(whole.Sum(nil)), fmt.Sprintf("%s-%d", hex.EncodeToString(parts.Sum(nil)), n), nil
+}
+
+// suppressObjectMultipartETagDiff suppresses the difference between a configured etag,
+// typically filemd5() of the source, and the ETag of the same content uploaded in parts.
+func suppressObjectMultipartETagDiff(k, old, new string, d *schema.ResourceData) bool {
+	if new == "" || !objectMultipartETagRegexp.MatchString(old) {
+		return false
+	}
+
+	body, closeBody, err := objectUploadBody(d)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to read S3 object content for ETag comparison: %s", err)
+		return false
+	}
+
+	defer closeBody()
+
+	size, err := aws.SeekerLen(body)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to read S3 object content for ETag comparison: %s", err)
+		return false
+	}
+
+	threshold, partSize, _ := objectMultipartSettings(d, objectMultipartDefaultPartSize, objectMultipartDefaultPartSize)
+	sum, etag, err := objectETags(body, size, threshold, partSize)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to read S3 object content for ETag comparison: %s", err)
+		return false
+	}
+
+	return sum == new && etag == old
+}
+
+// objectCopySource is the bucket and key of a copy's source.
+type objectCopySource struct {
+	bucket string
+	key    string
+}
+
+func parseObjectCopySource(source string) (*objectCopySource, bool) {
+	// Access point and Outposts ARNs are always copied in a single request.
+	if strings.HasPrefix(source, "arn:") {
+		return nil, false
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(source, "/"), "/", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, false
+	}
+
+	return &objectCopySource{
+		bucket: parts[0],
+		key:    parts[1],
+	}, true
+}
+
+// copyObjectMultipart copies an object in parts with UploadPartCopy, with the same semantics as CopyObject.
+// Incomplete copies are aborted so that no parts are left behind.
+func copyObjectMultipart(conn *s3.S3, input *s3.CopyObjectInput, source *objectCopySource, head *s3.HeadObjectOutput, partSize int64, concurrency int) (*s3.CopyObjectOutput, error) {
+	createInput := &s3.CreateMultipartUploadInput{
+		ACL:                       input.ACL,
+		Bucket:                    input.Bucket,
+		BucketKeyEnabled:          input.BucketKeyEnabled,
+		CacheControl:              input.CacheControl,
+		ContentDisposition:        input.ContentDisposition,
+		ContentEncoding:           input.ContentEncoding,
+		ContentLanguage:           input.ContentLanguage,
+		ContentType:               input.ContentType,
+		ExpectedBucketOwner:       input.ExpectedBucketOwner,
+		Expires:                   input.Expires,
+		GrantFullControl:          input.GrantFullControl,
+		GrantRead:                 input.GrantRead,
+		GrantReadACP:              input.GrantReadACP,
+		GrantWriteACP:             input.GrantWriteACP,
+		Key:                       input.Key,
+		Metadata:                  input.Metadata,
+		ObjectLockLegalHoldStatus: input.ObjectLockLegalHoldStatus,
+		ObjectLockMode:            input.ObjectLockMode,
+		ObjectLockRetainUntilDate: input.ObjectLockRetainUntilDate,
+		RequestPayer:              input.RequestPayer,
+		SSECustomerAlgorithm:      input.SSECustomerAlgorithm,
+		SSECustomerKey:            input.SSECustomerKey,
+		SSECustomerKeyMD5:         input.SSECustomerKeyMD5,
+		SSEKMSEncryptionContext:   input.SSEKMSEncryptionContext,
+		SSEKMSKeyId:               input.SSEKMSKeyId,
+		ServerSideEncryption:      input.ServerSideEncryption,
+		StorageClass:              input.StorageClass,
+		Tagging:                   input.Tagging,
+		WebsiteRedirectLocation:   input.WebsiteRedirectLocation,
+	}
+
+	// CopyObject copies the source's metadata and tags unless told to replace them.
+	if aws.StringValue(input.MetadataDirective) != s3.MetadataDirectiveReplace {
+		createInput.CacheControl = head.CacheControl
+		createInput.ContentDisposition = head.ContentDisposition
+		createInput.ContentEncoding = head.ContentEncoding
+		createInput.ContentLanguage = head.ContentLanguage
+		createInput.ContentType = head.ContentType
+		createInput.Expires = nil
+
+		if t, err := http.ParseTime(aws.StringValue(head.Expires)); err == nil {
+			createInput.Expires = aws.Time(t)
+		}
+
+		createInput.Metadata = head.Metadata
+		createInput.WebsiteRedirectLocation = head.WebsiteRedirectLocation
+	}
+
+	if aws.StringValue(input.TaggingDirective) != s3.TaggingDirectiveReplace {
+		tags, err := ObjectListTags(conn, source.bucket, source.key)
+
+		if err != nil {
+			return nil, fmt.Errorf("error listing tags for S3 Bucket (%s) Object (%s): %w", source.bucket, source.key, err)
+		}
+
+		createInput.Tagging = nil
+
+		if len(tags) > 0 {
+			createInput.Tagging = aws.String(tags.IgnoreAWS().UrlEncode())
+		}
+	}
+
+	createOutput, err := conn.CreateMultipartUpload(createInput)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating multipart upload: %w", err)
+	}
+
+	uploadID := createOutput.UploadId
+	size := aws.Int64Value(head.ContentLength)
+	partSize = objectMultipartPartSize(size, partSize)
+
+	var (
+		mu          sync.Mutex
+		errs        *multierror.Error
+		parts       []*s3.CompletedPart
+		wg          sync.WaitGroup
+		sourceVerID *string
+	)
+
+	sem := make(chan struct{}, concurrency)
+
+	for partNumber, start := int64(1), int64(0); start < size; partNumber, start = partNumber+1, start+partSize {
+		end := start + partSize - 1
+
+		if end >= size {
+			end = size - 1
+		}
+
+		partInput := &s3.UploadPartCopyInput{
+			Bucket:                         input.Bucket,
+			CopySource:                     input.CopySource,
+			CopySourceIfMatch:              input.CopySourceIfMatch,
+			CopySourceIfModifiedSince:      input.CopySourceIfModifiedSince,
+			CopySourceIfNoneMatch:          input.CopySourceIfNoneMatch,
+			CopySourceIfUnmodifiedSince:    input.CopySourceIfUnmodifiedSince,
+			CopySourceRange:                aws.String(fmt.Sprintf("bytes=%d-%d", start, end)),
+			CopySourceSSECustomerAlgorithm: input.CopySourceSSECustomerAlgorithm,
+			CopySourceSSECustomerKey:       input.CopySourceSSECustomerKey,
+			CopySourceSSECustomerKeyMD5:    input.CopySourceSSECustomerKeyMD5,
+			ExpectedBucketOwner:            input.ExpectedBucketOwner,
+			ExpectedSourceBucketOwner:      input.ExpectedSourceBucketOwner,
+			Key:                            input.Key,
+			PartNumber:                     aws.Int64(partNumber),
+			RequestPayer:                   input.RequestPayer,
+			SSECustomerAlgorithm:           input.SSECustomerAlgorithm,
+			SSECustomerKey:                 input.SSECustomerKey,
+			SSECustomerKeyMD5:              input.SSECustomerKeyMD5,
+			UploadId:                       uploadID,
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			output, err := conn.UploadPartCopy(partInput)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error copying part %d: %w", aws.Int64Value(partInput.PartNumber), err))
+				return
+			}
+
+			parts = append(parts, &s3.CompletedPart{
+				ETag:       output.CopyPartResult.ETag,
+				PartNumber: partInput.PartNumber,
+			})
+			sourceVerID = output.CopySourceVersionId
+		}()
+	}
+
+	wg.Wait()
+
+	if err := errs.ErrorOrNil(); err != nil {
+		abortObjectMultipartUpload(conn, input, uploadID)
+
+		return nil, err
+	}
+
+	sort.Slice(parts, func(i, j int) bool {
+		return aws.Int64Value(parts[i].PartNumber) < aws.Int64Value(parts[j].PartNumber)
+	})
+
+	completeOutput, err := conn.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:              input.Bucket,
+		ExpectedBucketOwner: input.ExpectedBucketOwner,
+		Key:                 input.Key,
+		MultipartUpload:     &s3.CompletedMultipartUpload{Parts: parts},
+		RequestPayer:        input.RequestPayer,
+		UploadId:            uploadID,
+	})
+
+	if err != nil {
+		abortObjectMultipartUpload(conn, input, uploadID)
+
+		return nil, fmt.Errorf("error completing multipart upload: %w", err)
+	}
+
+	return &s3.CopyObjectOutput{
+		BucketKeyEnabled:        completeOutput.BucketKeyEnabled,
+		CopyObjectResult:        &s3.CopyObjectResult{ETag: completeOutput.ETag},
+		CopySourceVersionId:     sourceVerID,
+		Expiration:              completeOutput.Expiration,
+		RequestCharged:          completeOutput.RequestCharged,
+		SSECustomerAlgorithm:    createOutput.SSECustomerAlgorithm,
+		SSECustomerKeyMD5:       createOutput.SSECustomerKeyMD5,
+		SSEKMSEncryptionContext: createOutput.SSEKMSEncryptionContext,
+		SSEKMSKeyId:             completeOutput.SSEKMSKeyId,
+		ServerSideEncryption:    completeOutput.ServerSideEncryption,
+		VersionId:               completeOutput.VersionId,
+	}, nil
+}
+
+func abortObjectMultipartUpload(conn *s3.S3, input *s3.CopyObjectInput, uploadID *string) {
+	_, err := conn.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+		Bucket:              input.Bucket,
+		ExpectedBucketOwner: input.ExpectedBucketOwner,
+		Key:                 input.Key,
+		RequestPayer:        input.RequestPayer,
+		UploadId:            uploadID,
+	})
+
+	if err != nil {
+		log.Printf("[WARN] Error aborting S3 multipart upload (%s): %s", aws.StringValue(uploadID), err)
+	}
+}
@@ -0,0 +1,161 @@
+package s3
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestObjectETags(t *testing.T) {
+	testCases := []struct {
+		TestName     string
+		Content      string
+		Threshold    int64
+		PartSize     int64
+		ExpectedMD5  string
+		ExpectedETag string
+	}{
+		{
+			TestName:     "empty",
+			Content:      "",
+			Threshold:    10,
+			PartSize:     10,
+			ExpectedMD5:  "d41d8cd98f00b204e9800998ecf8427e",
+			ExpectedETag: "d41d8cd98f00b204e9800998ecf8427e",
+		},
+		{
+			TestName:     "at threshold",
+			Content:      "abcdefghij",
+			Threshold:    10,
+			PartSize:     4,
+			ExpectedMD5:  "a925576942e94b2ef57a066101b48876",
+			ExpectedETag: "a925576942e94b2ef57a066101b48876",
+		},
+		{
+			TestName:     "above threshold",
+			Content:      "abcdefghijk",
+			Threshold:    10,
+			PartSize:     4,
+			ExpectedMD5:  "92b9cccc0b98c3a0b8d0df25a421c0e3",
+			ExpectedETag: "d9aa5005608b13d5e1cf29fbcf6e098f-3",
+		},
+		{
+			TestName:     "whole parts",
+			Content:      "abcdefghijkl",
+			Threshold:    10,
+			PartSize:     4,
+			ExpectedMD5:  "9fc9d606912030dca86582ed62595cf7",
+			ExpectedETag: "17ca064a842163311e72510a0a5e810c-3",
+		},
+		{
+			TestName:     "within one part",
+			Content:      "abcdefghijk",
+			Threshold:    4,
+			PartSize:     20,
+			ExpectedMD5:  "92b9cccc0b98c3a0b8d0df25a421c0e3",
+			ExpectedETag: "92b9cccc0b98c3a0b8d0df25a421c0e3",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotMD5, gotETag, err := objectETags(strings.NewReader(testCase.Content), int64(len(testCase.Content)), testCase.Threshold, testCase.PartSize)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotMD5 != testCase.ExpectedMD5 {
+				t.Errorf("got MD5 %s, expected %s", gotMD5, testCase.ExpectedMD5)
+			}
+
+			if gotETag != testCase.ExpectedETag {
+				t.Errorf("got ETag %s, expected %s", gotETag, testCase.ExpectedETag)
+			}
+
+			if !objectMultipartETagRegexp.MatchString(gotETag) && gotETag != gotMD5 {
+				t.Errorf("ETag %s is neither the MD5 nor a multipart ETag", gotETag)
+			}
+		})
+	}
+}
+
+func TestObjectMultipartPartSize(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Size     int64
+		PartSize int64
+		Expected int64
+	}{
+		{
+			TestName: "fits",
+			Size:     100 * 1024 * 1024,
+			PartSize: 5 * 1024 * 1024,
+			Expected: 5 * 1024 * 1024,
+		},
+		{
+			TestName: "too many parts",
+			Size:     100 * 1024 * 1024 * 1024,
+			PartSize: 5 * 1024 * 1024,
+			Expected: 100*1024*1024*1024/10000 + 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := objectMultipartPartSize(testCase.Size, testCase.PartSize); got != testCase.Expected {
+				t.Errorf("got %d, expected %d", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestParseObjectCopySource(t *testing.T) {
+	testCases := []struct {
+		TestName       string
+		Source         string
+		ExpectedOK     bool
+		ExpectedBucket string
+		ExpectedKey    string
+	}{
+		{
+			TestName:       "bucket and key",
+			Source:         "example/path/to/key",
+			ExpectedOK:     true,
+			ExpectedBucket: "example",
+			ExpectedKey:    "path/to/key",
+		},
+		{
+			TestName:       "leading slash",
+			Source:         "/example/key",
+			ExpectedOK:     true,
+			ExpectedBucket: "example",
+			ExpectedKey:    "key",
+		},
+		{
+			TestName: "no key",
+			Source:   "example",
+		},
+		{
+			TestName: "access point",
+			Source:   "arn:aws:s3:us-west-2:123456789012:accesspoint/example/object/key", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, ok := parseObjectCopySource(testCase.Source)
+
+			if ok != testCase.ExpectedOK {
+				t.Fatalf("got ok %t, expected %t", ok, testCase.ExpectedOK)
+			}
+
+			if !ok {
+				return
+			}
+
+			if got.bucket != testCase.ExpectedBucket || got.key != testCase.ExpectedKey {
+				t.Errorf("got %s/%s, expected %s/%s", got.bucket, got.key, testCase.ExpectedBucket, testCase.ExpectedKey)
+			}
+		})
+	}
+}
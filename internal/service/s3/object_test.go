@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -72,7 +73,7 @@ func TestAccS3Object_empty(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"acl", "force_destroy"},
+				ImportStateVerifyIgnore: []string{"acl", "force_destroy", "multipart_concurrency", "multipart_part_size", "multipart_threshold"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -104,7 +105,7 @@ func TestAccS3Object_source(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"acl", "source", "force_destroy"},
+				ImportStateVerifyIgnore: []string{"acl", "source", "force_destroy", "multipart_concurrency", "multipart_part_size", "multipart_threshold"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -134,7 +135,7 @@ func TestAccS3Object_content(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"acl", "content", "content_base64", "force_destroy"},
+				ImportStateVerifyIgnore: []string{"acl", "content", "content_base64", "force_destroy", "multipart_concurrency", "multipart_part_size", "multipart_threshold"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -167,7 +168,7 @@ func TestAccS3Object_etagEncryption(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"acl", "source", "force_destroy"},
+				ImportStateVerifyIgnore: []string{"acl", "source", "force_destroy", "multipart_concurrency", "multipart_part_size", "multipart_threshold"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -246,7 +247,7 @@ func TestAccS3Object_sourceHashTrigger(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"acl", "content", "content_base64", "force_destroy", "source", "source_hash"},
+				ImportStateVerifyIgnore: []string{"acl", "content", "content_base64", "force_destroy", "source", "source_hash", "multipart_concurrency", "multipart_part_size", "multipart_threshold"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -305,7 +306,7 @@ func TestAccS3Object_nonVersioned(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"acl", "source", "force_destroy"},
+				ImportStateVerifyIgnore: []string{"acl", "source", "force_destroy", "multipart_concurrency", "multipart_part_size", "multipart_threshold"},
 				ImportStateId:           fmt.Sprintf("s3://%s/updateable-key", rName),
 			},
 		},
@@ -354,7 +355,7 @@ func TestAccS3Object_updates(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"acl", "source", "force_destroy"},
+				ImportStateVerifyIgnore: []string{"acl", "source", "force_destroy", "multipart_concurrency", "multipart_part_size", "multipart_threshold"},
 				ImportStateId:           fmt.Sprintf("s3://%s/updateable-key", rName),
 			},
 		},
@@ -445,7 +446,7 @@ func TestAccS3Object_updatesWithVersioning(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"acl", "source", "force_destroy"},
+				ImportStateVerifyIgnore: []string{"acl", "source", "force_destroy", "multipart_concurrency", "multipart_part_size", "multipart_threshold"},
 				ImportStateId:           fmt.Sprintf("s3://%s/updateable-key", rName),
 			},
 		},
@@ -518,7 +519,7 @@ func TestAccS3Object_kms(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"acl", "source", "force_destroy"},
+				ImportStateVerifyIgnore: []string{"acl", "source", "force_destroy", "multipart_concurrency", "multipart_part_size", "multipart_threshold"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -552,7 +553,7 @@ func TestAccS3Object_sse(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"acl", "source", "force_destroy"},
+				ImportStateVerifyIgnore: []string{"acl", "source", "force_destroy", "multipart_concurrency", "multipart_part_size", "multipart_threshold"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -603,7 +604,7 @@ func TestAccS3Object_acl(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"acl", "content", "force_destroy"},
+				ImportStateVerifyIgnore: []string{"acl", "content", "force_destroy", "multipart_concurrency", "multipart_part_size", "multipart_threshold"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -650,7 +651,7 @@ func TestAccS3Object_metadata(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"acl", "force_destroy"},
+				ImportStateVerifyIgnore: []string{"acl", "force_destroy", "multipart_concurrency", "multipart_part_size", "multipart_threshold"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -713,7 +714,7 @@ func TestAccS3Object_storageClass(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"content", "acl", "force_destroy"},
+				ImportStateVerifyIgnore: []string{"content", "acl", "force_destroy", "multipart_concurrency", "multipart_part_size", "multipart_threshold"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -785,7 +786,7 @@ func TestAccS3Object_tags(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"content", "acl", "force_destroy"},
+				ImportStateVerifyIgnore: []string{"content", "acl", "force_destroy", "multipart_concurrency", "multipart_part_size", "multipart_threshold"},
 				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
 			},
 		},
@@ -857,7 +858,7 @@ func TestAccS3Object_tagsLeadingSingleSlash(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"content", "acl", "force_destroy"},
+				ImportStateVerifyIgnore: []string{"content", "acl", "force_destroy", "multipart_concurrency", "multipart_part_size", "multipart_threshold"},
 				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
 			},
 		},
@@ -1308,6 +1309,40 @@ func TestAccS3Object_ignoreTags(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_multipart(t *testing.T) {
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// 11 MiB, uploaded in three parts.
+	source := testAccObjectCreateTempFile(t, strings.Repeat("0123456789abcdef", 11*1024*1024/16))
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_multipart(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(resourceName, &obj),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`^[0-9a-f]{32}-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "multipart_concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_part_size", "5242880"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_threshold", "5242880"),
+				),
+			},
+			{
+				// The configured MD5 must not cause a diff against the multipart ETag.
+				Config:   testAccObjectConfig_multipart(rName, source),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckObjectVersionIdDiffers(first, second *s3.GetObjectOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if first.VersionId == nil {
@@ -1343,7 +1378,7 @@ func testAccCheckObjectVersionIdEquals(first, second *s3.GetObjectOutput) resour
 }
 
 func testAccCheckObjectDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_object" {
@@ -1374,7 +1409,7 @@ func testAccCheckObjectExists(n string, obj *s3.GetObjectOutput) resource.TestCh
 			return fmt.Errorf("No S3 Object ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		input := &s3.GetObjectInput{
 			Bucket:  aws.String(rs.Primary.Attributes["bucket"]),
@@ -1433,7 +1468,7 @@ func testAccCheckObjectBody(obj *s3.GetObjectOutput, want string) resource.TestC
 func testAccCheckObjectACL(n string, expectedPerms []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		out, err := conn.GetObjectAcl(&s3.GetObjectAclInput{
 			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
@@ -1461,7 +1496,7 @@ func testAccCheckObjectACL(n string, expectedPerms []string) resource.TestCheckF
 func testAccCheckObjectStorageClass(n, expectedClass string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		out, err := conn.HeadObject(&s3.HeadObjectInput{
 			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
@@ -1491,7 +1526,7 @@ func testAccCheckObjectStorageClass(n, expectedClass string) resource.TestCheckF
 func testAccCheckObjectSSE(n, expectedSSE string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		out, err := conn.HeadObject(&s3.HeadObjectInput{
 			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
@@ -1535,7 +1570,7 @@ func testAccObjectCreateTempFile(t *testing.T, data string) string {
 func testAccCheckObjectUpdateTags(n string, oldTags, newTags map[string]string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		return tfs3.ObjectUpdateTags(conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key"], oldTags, newTags)
 	}
@@ -1544,7 +1579,7 @@ func testAccCheckObjectUpdateTags(n string, oldTags, newTags map[string]string)
 func testAccCheckObjectCheckTags(n string, expectedTags map[string]string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		got, err := tfs3.ObjectListTags(conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key"])
 		if err != nil {
@@ -2115,3 +2150,21 @@ resource "aws_s3_object" "object" {
 }
 `, rName, content)
 }
+
+func testAccObjectConfig_multipart(rName string, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket                = aws_s3_bucket.test.bucket
+  key                   = "test-key"
+  source                = %[2]q
+  etag                  = filemd5(%[2]q)
+  multipart_concurrency = 2
+  multipart_part_size   = 5242880
+  multipart_threshold   = 5242880
+}
+`, rName, source)
+}
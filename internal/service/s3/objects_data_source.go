@@ -2,11 +2,16 @@ package s3
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 const keyRequestPageSize = 1000
@@ -45,6 +50,41 @@ func DataSourceObjects() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"include_details": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"include_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"key_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"last_modified_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"last_modified_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"storage_classes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(s3.ObjectStorageClass_Values(), false),
+				},
+			},
+			"versions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"keys": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -55,6 +95,55 @@ func DataSourceObjects() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delete_marker": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_latest": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"owners": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -65,17 +154,165 @@ func DataSourceObjects() *schema.Resource {
 }
 
 func dataSourceObjectsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	bucket := d.Get("bucket").(string)
-	prefix := d.Get("prefix").(string)
 
+	filter, err := expandObjectsFilter(d)
+
+	if err != nil {
+		return err
+	}
+
+	var objects []*objectsDataSourceObject
+	var commonPrefixes []string
+
+	if d.Get("versions").(bool) {
+		objects, commonPrefixes, err = listObjectsDataSourceVersions(conn, d, filter)
+	} else {
+		objects, commonPrefixes, err = listObjectsDataSourceObjects(conn, d, filter)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Bucket (%s) Objects: %w", bucket, err)
+	}
+
+	var keys []string
+	var owners []string
+
+	for _, object := range objects {
+		keys = append(keys, object.key)
+
+		if object.owner != "" {
+			owners = append(owners, object.owner)
+		}
+	}
+
+	if d.Get("include_tags").(bool) {
+		for _, object := range objects {
+			if object.deleteMarker {
+				continue
+			}
+
+			tags, err := objectVersionListTags(conn, bucket, object.key, object.versionID)
+
+			if err != nil {
+				return fmt.Errorf("error listing tags for S3 Bucket (%s) Object (%s): %w", bucket, object.key, err)
+			}
+
+			object.tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()
+		}
+	}
+
+	d.SetId(bucket)
+
+	if err := d.Set("common_prefixes", commonPrefixes); err != nil {
+		return fmt.Errorf("error setting common_prefixes: %w", err)
+	}
+
+	if err := d.Set("keys", keys); err != nil {
+		return fmt.Errorf("error setting keys: %w", err)
+	}
+
+	if d.Get("include_details").(bool) || d.Get("include_tags").(bool) {
+		if err := d.Set("objects", flattenObjectsDataSourceObjects(objects)); err != nil {
+			return fmt.Errorf("error setting objects: %w", err)
+		}
+	} else {
+		d.Set("objects", nil)
+	}
+
+	if err := d.Set("owners", owners); err != nil {
+		return fmt.Errorf("error setting owners: %w", err)
+	}
+
+	return nil
+}
+
+// objectsDataSourceObject is an object or object version listed by the data source.
+type objectsDataSourceObject struct {
+	deleteMarker bool
+	etag         string
+	isLatest     bool
+	key          string
+	lastModified *time.Time
+	owner        string
+	size         int64
+	storageClass string
+	tags         map[string]string
+	versionID    string
+}
+
+// objectsFilter selects the listed objects to return.
+type objectsFilter struct {
+	keyRegex           *regexp.Regexp
+	lastModifiedAfter  *time.Time
+	lastModifiedBefore *time.Time
+	storageClasses     map[string]bool
+}
+
+func expandObjectsFilter(d *schema.ResourceData) (*objectsFilter, error) {
+	filter := &objectsFilter{}
+
+	if v, ok := d.GetOk("key_regex"); ok {
+		re, err := regexp.Compile(v.(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing key_regex: %w", err)
+		}
+
+		filter.keyRegex = re
+	}
+
+	if v, ok := d.GetOk("last_modified_after"); ok {
+		filter.lastModifiedAfter = expandS3ObjectDate(v.(string))
+	}
+
+	if v, ok := d.GetOk("last_modified_before"); ok {
+		filter.lastModifiedBefore = expandS3ObjectDate(v.(string))
+	}
+
+	if v, ok := d.GetOk("storage_classes"); ok && v.(*schema.Set).Len() > 0 {
+		filter.storageClasses = make(map[string]bool)
+
+		for _, storageClass := range v.(*schema.Set).List() {
+			filter.storageClasses[storageClass.(string)] = true
+		}
+	}
+
+	return filter, nil
+}
+
+// match returns whether the object passes the filter.
+// Delete markers have no storage class and never match a storage class filter.
+func (f *objectsFilter) match(object *objectsDataSourceObject) bool {
+	if f.keyRegex != nil && !f.keyRegex.MatchString(object.key) {
+		return false
+	}
+
+	if f.lastModifiedAfter != nil && (object.lastModified == nil || !object.lastModified.After(*f.lastModifiedAfter)) {
+		return false
+	}
+
+	if f.lastModifiedBefore != nil && (object.lastModified == nil || !object.lastModified.Before(*f.lastModifiedBefore)) {
+		return false
+	}
+
+	if f.storageClasses != nil && !f.storageClasses[object.storageClass] {
+		return false
+	}
+
+	return true
+}
+
+func listObjectsDataSourceObjects(conn *s3.S3, d *schema.ResourceData, filter *objectsFilter) ([]*objectsDataSourceObject, []string, error) {
 	listInput := s3.ListObjectsV2Input{
-		Bucket: aws.String(bucket),
+		Bucket: aws.String(d.Get("bucket").(string)),
 	}
 
-	if prefix != "" {
-		listInput.Prefix = aws.String(prefix)
+	if s, ok := d.GetOk("prefix"); ok {
+		listInput.Prefix = aws.String(s.(string))
 	}
 
 	if s, ok := d.GetOk("delimiter"); ok {
@@ -103,48 +340,192 @@ func dataSourceObjectsRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	var commonPrefixes []string
-	var keys []string
-	var owners []string
+	var objects []*objectsDataSourceObject
 
 	err := conn.ListObjectsV2Pages(&listInput, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
 		for _, commonPrefix := range page.CommonPrefixes {
 			commonPrefixes = append(commonPrefixes, aws.StringValue(commonPrefix.Prefix))
+			maxKeys--
 		}
 
-		for _, object := range page.Contents {
-			keys = append(keys, aws.StringValue(object.Key))
+		for _, v := range page.Contents {
+			object := &objectsDataSourceObject{
+				etag:         aws.StringValue(v.ETag),
+				isLatest:     true,
+				key:          aws.StringValue(v.Key),
+				lastModified: v.LastModified,
+				size:         aws.Int64Value(v.Size),
+				storageClass: aws.StringValue(v.StorageClass),
+			}
 
-			if object.Owner != nil {
-				owners = append(owners, aws.StringValue(object.Owner.ID))
+			if v.Owner != nil {
+				object.owner = aws.StringValue(v.Owner.ID)
+			}
+
+			// Keys filtered out do not count towards max_keys.
+			if !filter.match(object) {
+				continue
 			}
-		}
 
-		maxKeys = maxKeys - aws.Int64Value(page.KeyCount)
+			objects = append(objects, object)
+			maxKeys--
+		}
 
 		if maxKeys <= keyRequestPageSize {
 			listInput.MaxKeys = aws.Int64(maxKeys)
 		}
 
-		return !lastPage
+		return !lastPage && maxKeys > 0
 	})
 
-	if err != nil {
-		return fmt.Errorf("error listing S3 Bucket (%s) Objects: %w", bucket, err)
+	return objects, commonPrefixes, err
+}
+
+func listObjectsDataSourceVersions(conn *s3.S3, d *schema.ResourceData, filter *objectsFilter) ([]*objectsDataSourceObject, []string, error) {
+	listInput := s3.ListObjectVersionsInput{
+		Bucket: aws.String(d.Get("bucket").(string)),
 	}
 
-	d.SetId(bucket)
+	if s, ok := d.GetOk("prefix"); ok {
+		listInput.Prefix = aws.String(s.(string))
+	}
 
-	if err := d.Set("common_prefixes", commonPrefixes); err != nil {
-		return fmt.Errorf("error setting common_prefixes: %w", err)
+	if s, ok := d.GetOk("delimiter"); ok {
+		listInput.Delimiter = aws.String(s.(string))
 	}
 
-	if err := d.Set("keys", keys); err != nil {
-		return fmt.Errorf("error setting keys: %w", err)
+	if s, ok := d.GetOk("encoding_type"); ok {
+		listInput.EncodingType = aws.String(s.(string))
 	}
 
-	if err := d.Set("owners", owners); err != nil {
-		return fmt.Errorf("error setting owners: %w", err)
+	maxKeys := int64(d.Get("max_keys").(int))
+	if maxKeys <= keyRequestPageSize {
+		listInput.MaxKeys = aws.Int64(maxKeys)
 	}
 
-	return nil
+	// Versions are listed starting after the key marker, like start_after.
+	if s, ok := d.GetOk("start_after"); ok {
+		listInput.KeyMarker = aws.String(s.(string))
+	}
+
+	var commonPrefixes []string
+	var objects []*objectsDataSourceObject
+
+	err := conn.ListObjectVersionsPages(&listInput, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+		for _, commonPrefix := range page.CommonPrefixes {
+			commonPrefixes = append(commonPrefixes, aws.StringValue(commonPrefix.Prefix))
+			maxKeys--
+		}
+
+		var pageObjects []*objectsDataSourceObject
+
+		for _, v := range page.Versions {
+			object := &objectsDataSourceObject{
+				etag:         aws.StringValue(v.ETag),
+				isLatest:     aws.BoolValue(v.IsLatest),
+				key:          aws.StringValue(v.Key),
+				lastModified: v.LastModified,
+				size:         aws.Int64Value(v.Size),
+				storageClass: aws.StringValue(v.StorageClass),
+				versionID:    aws.StringValue(v.VersionId),
+			}
+
+			if v.Owner != nil {
+				object.owner = aws.StringValue(v.Owner.ID)
+			}
+
+			pageObjects = append(pageObjects, object)
+		}
+
+		for _, v := range page.DeleteMarkers {
+			object := &objectsDataSourceObject{
+				deleteMarker: true,
+				isLatest:     aws.BoolValue(v.IsLatest),
+				key:          aws.StringValue(v.Key),
+				lastModified: v.LastModified,
+				versionID:    aws.StringValue(v.VersionId),
+			}
+
+			if v.Owner != nil {
+				object.owner = aws.StringValue(v.Owner.ID)
+			}
+
+			pageObjects = append(pageObjects, object)
+		}
+
+		// Versions and delete markers are returned separately, each ordered by key and then newest first.
+		sortObjectsDataSourceObjects(pageObjects)
+
+		for _, object := range pageObjects {
+			if !filter.match(object) {
+				continue
+			}
+
+			objects = append(objects, object)
+			maxKeys--
+		}
+
+		if maxKeys <= keyRequestPageSize {
+			listInput.MaxKeys = aws.Int64(maxKeys)
+		}
+
+		return !lastPage && maxKeys > 0
+	})
+
+	return objects, commonPrefixes, err
+}
+
+// sortObjectsDataSourceObjects sorts object versions by key and then newest first, as S3 lists them.
+func sortObjectsDataSourceObjects(objects []*objectsDataSourceObject) {
+	sort.SliceStable(objects, func(i, j int) bool {
+		if objects[i].key != objects[j].key {
+			return objects[i].key < objects[j].key
+		}
+
+		if objects[i].lastModified == nil || objects[j].lastModified == nil {
+			return false
+		}
+
+		return objects[i].lastModified.After(*objects[j].lastModified)
+	})
+}
+
+func flattenObjectsDataSourceObjects(objects []*objectsDataSourceObject) []interface{} {
+	tfList := make([]interface{}, 0, len(objects))
+
+	for _, object := range objects {
+		tfList = append(tfList, map[string]interface{}{
+			"delete_marker": object.deleteMarker,
+			"etag":          object.etag,
+			"is_latest":     object.isLatest,
+			"key":           object.key,
+			"last_modified": flattenS3ObjectDate(object.lastModified),
+			"owner":         object.owner,
+			"size":          int(object.size),
+			"storage_class": object.storageClass,
+			"tags":          object.tags,
+			"version_id":    object.versionID,
+		})
+	}
+
+	return tfList
+}
+
+// objectVersionListTags lists the tags of an object version, or of the current version if versionID is empty.
+func objectVersionListTags(conn *s3.S3, bucket, key, versionID string) (tftags.KeyValueTags, error) {
+	if versionID == "" {
+		return ObjectListTags(conn, bucket, key)
+	}
+
+	output, err := conn.GetObjectTagging(&s3.GetObjectTaggingInput{
+		Bucket:    aws.String(bucket),
+		Key:       aws.String(key),
+		VersionId: aws.String(versionID),
+	})
+
+	if err != nil {
+		return tftags.New(nil), err
+	}
+
+	return KeyValueTags(output.TagSet), nil
 }
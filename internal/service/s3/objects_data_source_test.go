@@ -2,13 +2,16 @@ package s3_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 func TestAccS3ObjectsDataSource_basic(t *testing.T) {
@@ -226,6 +229,125 @@ func TestAccS3ObjectsDataSource_fetchOwner(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectsDataSource_details(t *testing.T) {
+	rInt := sdkacctest.RandInt()
+	dataSourceName := "data.aws_s3_objects.yesh"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(t) },
+		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:                 acctest.Providers,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsResourcesDataSourceConfig(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccObjectsDetailsDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectsExistsDataSource(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.key", "arch/navajo/north_window"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.size", "13"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.storage_class", s3.ObjectStorageClassStandard),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.delete_marker", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.is_latest", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.tags.%", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.etag"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.last_modified"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.key", "arch/navajo/sand_dune"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsDataSource_lastModified(t *testing.T) {
+	rInt := sdkacctest.RandInt()
+	dataSourceName := "data.aws_s3_objects.yesh"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(t) },
+		ErrorCheck:                acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:                 acctest.Providers,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsResourcesDataSourceConfig(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccObjectsLastModifiedDataSourceConfig(rInt, "2000-01-01T00:00:00Z", "2100-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "7"),
+				),
+			},
+			{
+				Config: testAccObjectsLastModifiedDataSourceConfig(rInt, "1990-01-01T00:00:00Z", "2000-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsDataSource_versions(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_objects.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsVersionsResourcesDataSourceConfig(rName), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+					_, err := conn.PutObject(&s3.PutObjectInput{
+						Body:   strings.NewReader("deleted"),
+						Bucket: aws.String(rName),
+						Key:    aws.String("deleted"),
+					})
+
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					// Deleting the object in a versioned bucket adds a delete marker.
+					_, err = conn.DeleteObject(&s3.DeleteObjectInput{
+						Bucket: aws.String(rName),
+						Key:    aws.String("deleted"),
+					})
+
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectsVersionsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.delete_marker", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.is_latest", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.version_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.delete_marker", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.is_latest", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.size", "7"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.1.version_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObjectsExistsDataSource(addr string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[addr]
@@ -401,3 +523,57 @@ data "aws_s3_objects" "yesh" {
 }
 `, testAccObjectsResourcesDataSourceConfig(randInt))
 }
+
+func testAccObjectsDetailsDataSourceConfig(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_objects" "yesh" {
+  bucket          = aws_s3_bucket.objects_bucket.id
+  key_regex       = "^arch/navajo/"
+  include_details = true
+  include_tags    = true
+}
+`, testAccObjectsResourcesDataSourceConfig(randInt))
+}
+
+func testAccObjectsLastModifiedDataSourceConfig(randInt int, after, before string) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_objects" "yesh" {
+  bucket               = aws_s3_bucket.objects_bucket.id
+  last_modified_after  = %[2]q
+  last_modified_before = %[3]q
+  storage_classes      = ["STANDARD"]
+}
+`, testAccObjectsResourcesDataSourceConfig(randInt), after, before)
+}
+
+func testAccObjectsVersionsResourcesDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+`, rName)
+}
+
+func testAccObjectsVersionsDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccObjectsVersionsResourcesDataSourceConfig(rName), `
+data "aws_s3_objects" "test" {
+  bucket          = aws_s3_bucket.test.id
+  prefix          = "deleted"
+  versions        = true
+  include_details = true
+}
+`)
+}
@@ -9,7 +9,6 @@ import (
 	"log"
 	"regexp"
 	"strings"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -44,7 +43,7 @@ func sweepObjects(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).S3ConnURICleaningDisabled
+	conn := client.(*conns.AWSClient).S3ConnURICleaningDisabled()
 	input := &s3.ListBucketsInput{}
 
 	output, err := conn.ListBuckets(input)
@@ -117,7 +116,7 @@ func sweepBuckets(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).S3Conn
+	conn := client.(*conns.AWSClient).S3Conn()
 	input := &s3.ListBucketsInput{}
 
 	output, err := conn.ListBuckets(input)
@@ -131,11 +130,7 @@ func sweepBuckets(region string) error {
 		return fmt.Errorf("error listing S3 Buckets: %s", err)
 	}
 
-	if len(output.Buckets) == 0 {
-		log.Print("[DEBUG] No S3 Buckets to sweep")
-		return nil
-	}
-
+	sweepResources := make([]*sweep.SweepResource, 0)
 	defaultNameRegexp := regexp.MustCompile(`^terraform-\d+$`)
 	for _, bucket := range output.Buckets {
 		name := aws.StringValue(bucket.Name)
@@ -171,32 +166,42 @@ func sweepBuckets(region string) error {
 			continue
 		}
 
-		input := &s3.DeleteBucketInput{
-			Bucket: bucket.Name,
+		objectLockEnabled, err := objectLockEnabled(conn, name)
+
+		if err != nil {
+			log.Printf("[ERROR] Error getting S3 Bucket (%s) Object Lock: %s", name, err)
+			continue
 		}
 
-		log.Printf("[INFO] Deleting S3 Bucket: %s", name)
-		err = resource.Retry(1*time.Minute, func() *resource.RetryError {
-			_, err := conn.DeleteBucket(input)
+		r := ResourceBucket()
+		d := r.Data(nil)
+		d.SetId(name)
+		d.Set("force_destroy", true)
 
-			if tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchBucket, "") {
-				return nil
-			}
+		if objectLockEnabled {
+			d.Set("object_lock_configuration", []interface{}{map[string]interface{}{"object_lock_enabled": s3.ObjectLockEnabledEnabled}})
+		}
 
-			if tfawserr.ErrMessageContains(err, "BucketNotEmpty", "") {
-				return resource.RetryableError(err)
-			}
+		sweepResource := sweep.NewSweepResource(r, d, client).
+			WithCreationTime(aws.TimeValue(bucket.CreationDate)).
+			WithName(name)
 
-			if err != nil {
-				return resource.NonRetryableError(err)
+		// ListBuckets does not return tags.
+		if sweep.TagsFiltered() {
+			if tags, err := BucketListTags(conn, name); err != nil {
+				log.Printf("[WARN] Error listing S3 Bucket (%s) tags: %s", name, err)
+			} else {
+				sweepResource.WithTags(tags.IgnoreAWS().Map())
 			}
+		}
+
+		sweepResources = append(sweepResources, sweepResource)
+	}
 
-			return nil
-		})
+	err = sweep.SweepOrchestrator(sweepResources)
 
-		if err != nil {
-			return fmt.Errorf("error deleting S3 Bucket (%s): %s", name, err)
-		}
+	if err != nil {
+		return fmt.Errorf("error sweeping S3 Buckets (%s): %w", region, err)
 	}
 
 	return nil
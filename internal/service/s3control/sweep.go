@@ -41,7 +41,7 @@ func sweepAccessPoints(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).S3ControlConn
+	conn := client.(*conns.AWSClient).S3ControlConn()
 	accountID := client.(*conns.AWSClient).AccountID
 	input := &s3control.ListAccessPointsInput{
 		AccountId: aws.String(accountID),
@@ -106,7 +106,7 @@ func sweepMultiRegionAccessPoints(region string) error {
 		log.Printf("[WARN] Skipping S3 Multi-Region Access Point sweep for region: %s", region)
 		return nil
 	}
-	conn := client.(*conns.AWSClient).S3ControlConn
+	conn := client.(*conns.AWSClient).S3ControlConn()
 	accountID := client.(*conns.AWSClient).AccountID
 	input := &s3control.ListMultiRegionAccessPointsInput{
 		AccountId: aws.String(accountID),
@@ -152,7 +152,7 @@ func sweepObjectLambdaAccessPoints(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).S3ControlConn
+	conn := client.(*conns.AWSClient).S3ControlConn()
 	accountID := client.(*conns.AWSClient).AccountID
 	input := &s3control.ListAccessPointsForObjectLambdaInput{
 		AccountId: aws.String(accountID),
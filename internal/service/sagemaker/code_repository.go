@@ -60,7 +60,7 @@ func ResourceCodeRepository() *schema.Resource {
 						"secret_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("secretsmanager", "secret"),
 						},
 					},
 				},
@@ -74,7 +74,7 @@ func ResourceCodeRepository() *schema.Resource {
 }
 
 func resourceCodeRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SageMakerConn
+	conn := meta.(*conns.AWSClient).SageMakerConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	name := d.Get("code_repository_name").(string)
@@ -100,7 +100,7 @@ func resourceCodeRepositoryCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceCodeRepositoryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SageMakerConn
+	conn := meta.(*conns.AWSClient).SageMakerConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -143,7 +143,7 @@ func resourceCodeRepositoryRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceCodeRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SageMakerConn
+	conn := meta.(*conns.AWSClient).SageMakerConn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -170,7 +170,7 @@ func resourceCodeRepositoryUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceCodeRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SageMakerConn
+	conn := meta.(*conns.AWSClient).SageMakerConn()
 
 	input := &sagemaker.DeleteCodeRepositoryInput{
 		CodeRepositoryName: aws.String(d.Id()),
@@ -325,7 +325,7 @@ func TestAccSageMakerEndpointConfiguration_Async_client(t *testing.T) {
 }
 
 func testAccCheckSagemakerEndpointConfigurationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_endpoint_configuration" {
@@ -359,7 +359,7 @@ func testAccCheckSagemakerEndpointConfigurationExists(n string) resource.TestChe
 			return fmt.Errorf("no SageMaker endpoint config ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
 		_, err := tfsagemaker.FindEndpointConfigByName(conn, rs.Primary.ID)
 
 		if err != nil {
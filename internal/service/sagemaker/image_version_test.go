@@ -113,7 +113,7 @@ func TestAccSageMakerImageVersion_Disappears_image(t *testing.T) {
 }
 
 func testAccCheckImageVersionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_image_version" {
@@ -149,7 +149,7 @@ func testAccCheckImageVersionExists(n string, image *sagemaker.DescribeImageVers
 			return fmt.Errorf("No sagmaker Image ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
 		resp, err := tfsagemaker.FindImageVersionByName(conn, rs.Primary.ID)
 		if err != nil {
 			return err
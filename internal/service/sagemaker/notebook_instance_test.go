@@ -298,7 +298,7 @@ func TestAccSageMakerNotebookInstance_disappears(t *testing.T) {
 }
 
 func testAccCheckNotebookInstanceDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_notebook_instance" {
@@ -337,7 +337,7 @@ func testAccCheckNotebookInstanceExists(n string, notebook *sagemaker.DescribeNo
 			return fmt.Errorf("No sagmaker Notebook Instance ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
 		opts := &sagemaker.DescribeNotebookInstanceInput{
 			NotebookInstanceName: aws.String(rs.Primary.ID),
 		}
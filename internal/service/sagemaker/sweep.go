@@ -154,7 +154,7 @@ func sweepAppImagesConfig(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 	input := &sagemaker.ListAppImageConfigsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -201,7 +201,7 @@ func sweepApps(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListAppsPages(&sagemaker.ListAppsInput{}, func(page *sagemaker.ListAppsOutput, lastPage bool) bool {
@@ -247,7 +247,7 @@ func sweepCodeRepositories(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 
 	err = conn.ListCodeRepositoriesPages(&sagemaker.ListCodeRepositoriesInput{}, func(page *sagemaker.ListCodeRepositoriesOutput, lastPage bool) bool {
 		for _, instance := range page.CodeRepositorySummaryList {
@@ -284,7 +284,7 @@ func sweepDeviceFleets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListDeviceFleetsPages(&sagemaker.ListDeviceFleetsInput{}, func(page *sagemaker.ListDeviceFleetsOutput, lastPage bool) bool {
@@ -322,7 +322,7 @@ func sweepDeviceFleets(region string) error {
 // 	if err != nil {
 // 		return fmt.Errorf("error getting client: %s", err)
 // 	}
-// 	conn := client.(*conns.AWSClient).SageMakerConn
+// 	conn := client.(*conns.AWSClient).SageMakerConn()
 // 	var sweeperErrs *multierror.Error
 
 // 	err = conn.ListDevicesPages(&sagemaker.ListDevicesInput{}, func(page *sagemaker.ListDevicesOutput, lastPage bool) bool {
@@ -360,7 +360,7 @@ func sweepDomains(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListDomainsPages(&sagemaker.ListDomainsInput{}, func(page *sagemaker.ListDomainsOutput, lastPage bool) bool {
@@ -398,7 +398,7 @@ func sweepEndpointConfigurations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 	var sweeperErrs *multierror.Error
 
 	req := &sagemaker.ListEndpointConfigsInput{
@@ -438,7 +438,7 @@ func sweepEndpoints(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 
 	req := &sagemaker.ListEndpointsInput{
 		NameContains: aws.String(sweep.ResourcePrefix),
@@ -472,7 +472,7 @@ func sweepFeatureGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 
 	err = conn.ListFeatureGroupsPages(&sagemaker.ListFeatureGroupsInput{}, func(page *sagemaker.ListFeatureGroupsOutput, lastPage bool) bool {
 		for _, group := range page.FeatureGroupSummaries {
@@ -509,7 +509,7 @@ func sweepFlowDefinitions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListFlowDefinitionsPages(&sagemaker.ListFlowDefinitionsInput{}, func(page *sagemaker.ListFlowDefinitionsOutput, lastPage bool) bool {
@@ -546,7 +546,7 @@ func sweepHumanTaskUIs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListHumanTaskUisPages(&sagemaker.ListHumanTaskUisInput{}, func(page *sagemaker.ListHumanTaskUisOutput, lastPage bool) bool {
@@ -583,7 +583,7 @@ func sweepImages(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 
 	err = conn.ListImagesPages(&sagemaker.ListImagesInput{}, func(page *sagemaker.ListImagesOutput, lastPage bool) bool {
 		for _, Image := range page.Images {
@@ -620,7 +620,7 @@ func sweepModelPackageGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 
 	err = conn.ListModelPackageGroupsPages(&sagemaker.ListModelPackageGroupsInput{}, func(page *sagemaker.ListModelPackageGroupsOutput, lastPage bool) bool {
 		for _, ModelPackageGroup := range page.ModelPackageGroupSummaryList {
@@ -657,7 +657,7 @@ func sweepModels(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListModelsPages(&sagemaker.ListModelsInput{}, func(page *sagemaker.ListModelsOutput, lastPage bool) bool {
@@ -693,7 +693,7 @@ func sweepNotebookInstanceLifecycleConfiguration(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 
 	input := &sagemaker.ListNotebookInstanceLifecycleConfigsInput{}
 	err = conn.ListNotebookInstanceLifecycleConfigsPages(input, func(page *sagemaker.ListNotebookInstanceLifecycleConfigsOutput, lastPage bool) bool {
@@ -734,7 +734,7 @@ func sweepNotebookInstances(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 
 	err = conn.ListNotebookInstancesPages(&sagemaker.ListNotebookInstancesInput{}, func(page *sagemaker.ListNotebookInstancesOutput, lastPage bool) bool {
 		for _, instance := range page.NotebookInstances {
@@ -785,7 +785,7 @@ func sweepStudioLifecyclesConfig(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListStudioLifecycleConfigsPages(&sagemaker.ListStudioLifecycleConfigsInput{}, func(page *sagemaker.ListStudioLifecycleConfigsOutput, lastPage bool) bool {
@@ -822,7 +822,7 @@ func sweepUserProfiles(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListUserProfilesPages(&sagemaker.ListUserProfilesInput{}, func(page *sagemaker.ListUserProfilesOutput, lastPage bool) bool {
@@ -862,7 +862,7 @@ func sweepWorkforces(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListWorkforcesPages(&sagemaker.ListWorkforcesInput{}, func(page *sagemaker.ListWorkforcesOutput, lastPage bool) bool {
@@ -899,7 +899,7 @@ func sweepWorkteams(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListWorkteamsPages(&sagemaker.ListWorkteamsInput{}, func(page *sagemaker.ListWorkteamsOutput, lastPage bool) bool {
@@ -936,7 +936,7 @@ func sweepProjects(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).SageMakerConn
+	conn := client.(*conns.AWSClient).SageMakerConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListProjectsPages(&sagemaker.ListProjectsInput{}, func(page *sagemaker.ListProjectsOutput, lastPage bool) bool {
@@ -32,7 +32,7 @@ func ResourceSecretPolicy() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("secretsmanager", "secret"),
 			},
 			"policy": {
 				Type:             schema.TypeString,
@@ -53,7 +53,7 @@ func ResourceSecretPolicy() *schema.Resource {
 }
 
 func resourceSecretPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SecretsManagerConn
+	conn := meta.(*conns.AWSClient).SecretsManagerConn()
 
 	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
 
@@ -98,7 +98,7 @@ func resourceSecretPolicyCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceSecretPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SecretsManagerConn
+	conn := meta.(*conns.AWSClient).SecretsManagerConn()
 
 	input := &secretsmanager.GetResourcePolicyInput{
 		SecretId: aws.String(d.Id()),
@@ -141,7 +141,7 @@ func resourceSecretPolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSecretPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SecretsManagerConn
+	conn := meta.(*conns.AWSClient).SecretsManagerConn()
 
 	if d.HasChanges("policy", "block_public_policy") {
 		policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
@@ -178,7 +178,7 @@ func resourceSecretPolicyUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceSecretPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SecretsManagerConn
+	conn := meta.(*conns.AWSClient).SecretsManagerConn()
 
 	input := &secretsmanager.DeleteResourcePolicyInput{
 		SecretId: aws.String(d.Id()),
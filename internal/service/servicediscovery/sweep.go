@@ -51,7 +51,7 @@ func sweepHTTPNamespaces(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ServiceDiscoveryConn
+	conn := client.(*conns.AWSClient).ServiceDiscoveryConn()
 	var sweeperErrs *multierror.Error
 
 	input := &servicediscovery.ListNamespacesInput{
@@ -119,7 +119,7 @@ func sweepPrivateDNSNamespaces(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ServiceDiscoveryConn
+	conn := client.(*conns.AWSClient).ServiceDiscoveryConn()
 	var sweeperErrs *multierror.Error
 
 	input := &servicediscovery.ListNamespacesInput{
@@ -187,7 +187,7 @@ func sweepPublicDNSNamespaces(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ServiceDiscoveryConn
+	conn := client.(*conns.AWSClient).ServiceDiscoveryConn()
 	var sweeperErrs *multierror.Error
 
 	input := &servicediscovery.ListNamespacesInput{
@@ -255,7 +255,7 @@ func sweepServices(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ServiceDiscoveryConn
+	conn := client.(*conns.AWSClient).ServiceDiscoveryConn()
 	input := &servicediscovery.ListServicesInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
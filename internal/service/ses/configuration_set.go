@@ -12,6 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceConfigurationSet() *schema.Resource {
@@ -64,12 +67,18 @@ func ResourceConfigurationSet() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
 		},
+
+		CustomizeDiff: verify.SetTagsDiff,
 	}
 }
 
 func resourceConfigurationSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SESConn
+	conn := meta.(*conns.AWSClient).SESConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	configurationSetName := d.Get("name").(string)
 
@@ -122,11 +131,22 @@ func resourceConfigurationSetCreate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	// The SES API does not support tagging configuration sets on creation.
+	if len(tags) > 0 {
+		arn := configurationSetARN(meta.(*conns.AWSClient), configurationSetName)
+
+		if err := tfresourcegroupstaggingapi.UpdateTags(meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn(), arn, nil, tags); err != nil {
+			return fmt.Errorf("error adding SES configuration set (%s) tags: %w", configurationSetName, err)
+		}
+	}
+
 	return resourceConfigurationSetRead(d, meta)
 }
 
 func resourceConfigurationSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SESConn
+	conn := meta.(*conns.AWSClient).SESConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	configSetInput := &ses.DescribeConfigurationSetInput{
 		ConfigurationSetName: aws.String(d.Id()),
@@ -159,20 +179,31 @@ func resourceConfigurationSetRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("last_fresh_start", aws.TimeValue(repOpts.LastFreshStart).Format(time.RFC3339))
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   "ses",
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("configuration-set/%s", d.Id()),
-	}.String()
+	arn := configurationSetARN(meta.(*conns.AWSClient), d.Id())
 	d.Set("arn", arn)
 
+	tags, err := tfresourcegroupstaggingapi.ListTags(meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn(), arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for SES configuration set (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
 	return nil
 }
 
 func resourceConfigurationSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SESConn
+	conn := meta.(*conns.AWSClient).SESConn()
 
 	if d.HasChange("delivery_options") {
 		input := &ses.PutConfigurationSetDeliveryOptionsInput{
@@ -210,11 +241,19 @@ func resourceConfigurationSetUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := tfresourcegroupstaggingapi.UpdateTags(meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn(), d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating SES configuration set (%s) tags: %w", d.Id(), err)
+		}
+	}
+
 	return resourceConfigurationSetRead(d, meta)
 }
 
 func resourceConfigurationSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SESConn
+	conn := meta.(*conns.AWSClient).SESConn()
 
 	log.Printf("[DEBUG] SES Delete Configuration Rule Set: %s", d.Id())
 	input := &ses.DeleteConfigurationSetInput{
@@ -230,6 +269,16 @@ func resourceConfigurationSetDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+func configurationSetARN(client *conns.AWSClient, name string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   "ses",
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("configuration-set/%s", name),
+	}.String()
+}
+
 func expandSesConfigurationSetDeliveryOptions(l []interface{}) *ses.DeliveryOptions {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -148,7 +148,7 @@ func ResourceReceiptRule() *schema.Resource {
 						"topic_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("sns", "topic"),
 						},
 
 						"position": {
@@ -186,7 +186,7 @@ func ResourceReceiptRule() *schema.Resource {
 						"function_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("lambda", "function"),
 						},
 
 						"invocation_type": {
@@ -199,7 +199,7 @@ func ResourceReceiptRule() *schema.Resource {
 						"topic_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("sns", "topic"),
 						},
 
 						"position": {
@@ -240,7 +240,7 @@ func ResourceReceiptRule() *schema.Resource {
 						"kms_key_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("kms", "key", "alias"),
 						},
 
 						"object_key_prefix": {
@@ -251,7 +251,7 @@ func ResourceReceiptRule() *schema.Resource {
 						"topic_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("sns", "topic"),
 						},
 
 						"position": {
@@ -298,7 +298,7 @@ func ResourceReceiptRule() *schema.Resource {
 						"topic_arn": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("sns", "topic"),
 						},
 
 						"position": {
@@ -332,7 +332,7 @@ func ResourceReceiptRule() *schema.Resource {
 						"topic_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("sns", "topic"),
 						},
 
 						"position": {
@@ -370,7 +370,7 @@ func ResourceReceiptRule() *schema.Resource {
 						"topic_arn": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							ValidateFunc: verify.ValidARNForService("sns", "topic"),
 						},
 
 						"position": {
@@ -414,7 +414,7 @@ func resourceReceiptRuleImport(d *schema.ResourceData, meta interface{}) ([]*sch
 }
 
 func resourceReceiptRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SESConn
+	conn := meta.(*conns.AWSClient).SESConn()
 
 	createOpts := &ses.CreateReceiptRuleInput{
 		Rule:        buildReceiptRule(d),
@@ -436,7 +436,7 @@ func resourceReceiptRuleCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceReceiptRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SESConn
+	conn := meta.(*conns.AWSClient).SESConn()
 
 	updateOpts := &ses.UpdateReceiptRuleInput{
 		Rule:        buildReceiptRule(d),
@@ -465,7 +465,7 @@ func resourceReceiptRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceReceiptRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SESConn
+	conn := meta.(*conns.AWSClient).SESConn()
 
 	ruleSetName := d.Get("rule_set_name").(string)
 	describeOpts := &ses.DescribeReceiptRuleInput{
@@ -654,7 +654,7 @@ func resourceReceiptRuleRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceReceiptRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SESConn
+	conn := meta.(*conns.AWSClient).SESConn()
 
 	deleteOpts := &ses.DeleteReceiptRuleInput{
 		RuleName:    aws.String(d.Id()),
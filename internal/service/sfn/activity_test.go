@@ -97,7 +97,7 @@ func testAccCheckActivityExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Step Function ID set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SFNConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SFNConn()
 
 		_, err := conn.DescribeActivity(&sfn.DescribeActivityInput{
 			ActivityArn: aws.String(rs.Primary.ID),
@@ -108,7 +108,7 @@ func testAccCheckActivityExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckActivityDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SFNConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SFNConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sfn_activity" {
@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -UpdateTags
+//go:generate go run ../../generate/waiters/main.go -Resource=StateMachine -Op=DescribeStateMachine -IDElem=StateMachineArn -IDName=ARN -StatusElem=Status -NotFoundCodes=ErrCodeStateMachineDoesNotExist -DeletePending=StateMachineStatusActive,StateMachineStatusDeleting
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package sfn
@@ -79,7 +79,7 @@ func ResourceStateMachine() *schema.Resource {
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 
 			"status": {
@@ -120,7 +120,7 @@ func ResourceStateMachine() *schema.Resource {
 }
 
 func resourceStateMachineCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SFNConn
+	conn := meta.(*conns.AWSClient).SFNConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -182,7 +182,7 @@ func resourceStateMachineCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceStateMachineRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SFNConn
+	conn := meta.(*conns.AWSClient).SFNConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -251,7 +251,7 @@ func resourceStateMachineRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceStateMachineUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SFNConn
+	conn := meta.(*conns.AWSClient).SFNConn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		// "You must include at least one of definition or roleArn or you will receive a MissingRequiredParameter error"
@@ -315,7 +315,7 @@ func resourceStateMachineUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceStateMachineDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SFNConn
+	conn := meta.(*conns.AWSClient).SFNConn()
 
 	_, err := conn.DeleteStateMachine(&sfn.DeleteStateMachineInput{
 		StateMachineArn: aws.String(d.Id()),
@@ -325,7 +325,7 @@ func resourceStateMachineDelete(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error deleting Step Function State Machine (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitStateMachineDeleted(conn, d.Id()); err != nil {
+	if _, err := waitStateMachineDeleted(conn, d.Id(), stateMachineDeletedTimeout); err != nil {
 		return fmt.Errorf("error waiting for Step Function State Machine (%s) deletion: %w", d.Id(), err)
 	}
 
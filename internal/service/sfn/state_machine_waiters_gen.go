@@ -0,0 +1,71 @@
+// Code generated by "internal/generate/waiters/main.go -Resource=StateMachine -Op=DescribeStateMachine -IDElem=StateMachineArn -IDName=ARN -StatusElem=Status -NotFoundCodes=ErrCodeStateMachineDoesNotExist -DeletePending=StateMachineStatusActive,StateMachineStatusDeleting"; DO NOT EDIT.
+
+package sfn
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindStateMachineByARN(conn *sfn.SFN, id string) (*sfn.DescribeStateMachineOutput, error) {
+	input := &sfn.DescribeStateMachineInput{
+		StateMachineArn: aws.String(id),
+	}
+
+	output, err := conn.DescribeStateMachine(input)
+
+	if tfawserr.ErrCodeEquals(err, sfn.ErrCodeStateMachineDoesNotExist) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusStateMachine(conn *sfn.SFN, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindStateMachineByARN(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitStateMachineDeleted(conn *sfn.SFN, id string, timeout time.Duration) (*sfn.DescribeStateMachineOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{sfn.StateMachineStatusActive, sfn.StateMachineStatusDeleting},
+		Target:  []string{},
+		Refresh: statusStateMachine(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*sfn.DescribeStateMachineOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -136,7 +136,7 @@ func ResourceSMSPreferences() *schema.Resource {
 }
 
 func resourceSMSPreferencesSet(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	attributes, err := SMSPreferencesAttributeMap.ResourceDataToApiAttributesCreate(d)
 
@@ -159,7 +159,7 @@ func resourceSMSPreferencesSet(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSMSPreferencesGet(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	output, err := conn.GetSMSAttributes(&sns.GetSMSAttributesInput{})
 
@@ -177,7 +177,7 @@ func resourceSMSPreferencesGet(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSMSPreferencesDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	// Reset the attributes to their default value.
 	attributes := make(map[string]string)
@@ -50,7 +50,7 @@ func ResourceTopicPolicy() *schema.Resource {
 }
 
 func resourceTopicPolicyUpsert(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
 
@@ -74,7 +74,7 @@ func resourceTopicPolicyUpsert(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTopicPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	attributes, err := FindTopicAttributesByARN(conn, d.Id())
 
@@ -112,7 +112,7 @@ func resourceTopicPolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTopicPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	// It is impossible to delete a policy or set to empty
 	// (confirmed by AWS Support representative)
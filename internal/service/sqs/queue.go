@@ -185,7 +185,7 @@ func ResourceQueue() *schema.Resource {
 }
 
 func resourceQueueCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SQSConn
+	conn := meta.(*conns.AWSClient).SQSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -259,7 +259,7 @@ func resourceQueueCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceQueueRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SQSConn
+	conn := meta.(*conns.AWSClient).SQSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -333,7 +333,7 @@ func resourceQueueRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceQueueUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SQSConn
+	conn := meta.(*conns.AWSClient).SQSConn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		attributes, err := sqsQueueAttributeMap.ResourceDataToApiAttributesUpdate(d)
@@ -380,7 +380,7 @@ func resourceQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceQueueDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SQSConn
+	conn := meta.(*conns.AWSClient).SQSConn()
 
 	log.Printf("[DEBUG] Deleting SQS Queue: %s", d.Id())
 	_, err := conn.DeleteQueue(&sqs.DeleteQueueInput{
@@ -62,7 +62,7 @@ func ResourceMaintenanceWindowTask() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 
 			"targets": {
@@ -262,7 +262,7 @@ func ResourceMaintenanceWindowTask() *schema.Resource {
 									"service_role_arn": {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("iam", "role"),
 									},
 
 									"timeout_seconds": {
@@ -651,7 +651,7 @@ func flattenTaskInvocationCommonParameters(parameters map[string][]*string) []in
 }
 
 func resourceMaintenanceWindowTaskCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSMConn
+	conn := meta.(*conns.AWSClient).SSMConn()
 
 	log.Printf("[INFO] Registering SSM Maintenance Window Task")
 
@@ -698,7 +698,7 @@ func resourceMaintenanceWindowTaskCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceMaintenanceWindowTaskRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSMConn
+	conn := meta.(*conns.AWSClient).SSMConn()
 	windowID := d.Get("window_id").(string)
 
 	params := &ssm.GetMaintenanceWindowTaskInput{
@@ -739,7 +739,7 @@ func resourceMaintenanceWindowTaskRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceMaintenanceWindowTaskUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSMConn
+	conn := meta.(*conns.AWSClient).SSMConn()
 	windowID := d.Get("window_id").(string)
 
 	params := &ssm.UpdateMaintenanceWindowTaskInput{
@@ -784,7 +784,7 @@ func resourceMaintenanceWindowTaskUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceMaintenanceWindowTaskDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSMConn
+	conn := meta.(*conns.AWSClient).SSMConn()
 
 	log.Printf("[INFO] Deregistering SSM Maintenance Window Task: %s", d.Id())
 
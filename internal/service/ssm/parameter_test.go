@@ -691,7 +691,7 @@ func testAccCheckParameterExists(n string, param *ssm.Parameter) resource.TestCh
 			return fmt.Errorf("No SSM Parameter ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
 
 		paramInput := &ssm.GetParametersInput{
 			Names: []*string{
@@ -716,7 +716,7 @@ func testAccCheckParameterExists(n string, param *ssm.Parameter) resource.TestCh
 }
 
 func testAccCheckParameterDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_parameter" {
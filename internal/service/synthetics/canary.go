@@ -60,7 +60,7 @@ func ResourceCanary() *schema.Resource {
 									"kms_key_arn": {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ValidateFunc: verify.ValidARN,
+										ValidateFunc: verify.ValidARNForService("kms", "key", "alias"),
 									},
 								},
 							},
@@ -82,7 +82,7 @@ func ResourceCanary() *schema.Resource {
 			"execution_role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNForService("iam", "role"),
 			},
 			"failure_retention_period": {
 				Type:         schema.TypeInt,
@@ -253,7 +253,7 @@ func ResourceCanary() *schema.Resource {
 }
 
 func resourceCanaryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SyntheticsConn
+	conn := meta.(*conns.AWSClient).SyntheticsConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -346,7 +346,7 @@ func resourceCanaryCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCanaryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SyntheticsConn
+	conn := meta.(*conns.AWSClient).SyntheticsConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -416,7 +416,7 @@ func resourceCanaryRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCanaryUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SyntheticsConn
+	conn := meta.(*conns.AWSClient).SyntheticsConn()
 
 	if d.HasChangesExcept("tags", "tags_all", "start_canary") {
 		input := &synthetics.UpdateCanaryInput{
@@ -530,7 +530,7 @@ func resourceCanaryUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCanaryDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SyntheticsConn
+	conn := meta.(*conns.AWSClient).SyntheticsConn()
 
 	if status := d.Get("status").(string); status == synthetics.CanaryStateRunning {
 		if err := syntheticsStopCanary(d.Id(), conn); err != nil {
@@ -35,7 +35,7 @@ func sweepDatabases(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).TimestreamWriteConn
+	conn := client.(*conns.AWSClient).TimestreamWriteConn()
 	ctx := context.Background()
 
 	var sweeperErrs *multierror.Error
@@ -92,7 +92,7 @@ func sweepTables(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).TimestreamWriteConn
+	conn := client.(*conns.AWSClient).TimestreamWriteConn()
 	ctx := context.Background()
 
 	var sweeperErrs *multierror.Error
@@ -54,7 +54,7 @@ func sweepIPSets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).WAFV2Conn
+	conn := client.(*conns.AWSClient).WAFV2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -106,7 +106,7 @@ func sweepRegexPatternSets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).WAFV2Conn
+	conn := client.(*conns.AWSClient).WAFV2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -158,7 +158,7 @@ func sweepRuleGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).WAFV2Conn
+	conn := client.(*conns.AWSClient).WAFV2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -212,7 +212,7 @@ func sweepWebACLs(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).WAFV2Conn
+	conn := client.(*conns.AWSClient).WAFV2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -1613,7 +1613,7 @@ func testAccCheckWebACLDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn()
 		resp, err := conn.GetWebACL(
 			&wafv2.GetWebACLInput{
 				Id:    aws.String(rs.Primary.ID),
@@ -1652,7 +1652,7 @@ func testAccCheckWebACLExists(n string, v *wafv2.WebACL) resource.TestCheckFunc
 			return fmt.Errorf("No WAFv2 WebACL ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn()
 		resp, err := conn.GetWebACL(&wafv2.GetWebACLInput{
 			Id:    aws.String(rs.Primary.ID),
 			Name:  aws.String(rs.Primary.Attributes["name"]),
@@ -38,7 +38,7 @@ func sweepDirectories(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).WorkSpacesConn
+	conn := client.(*conns.AWSClient).WorkSpacesConn()
 	input := &workspaces.DescribeWorkspaceDirectoriesInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -81,7 +81,7 @@ func sweepIPGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).WorkSpacesConn
+	conn := client.(*conns.AWSClient).WorkSpacesConn()
 	input := &workspaces.DescribeIpGroupsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -124,7 +124,7 @@ func sweepWorkspace(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).WorkSpacesConn
+	conn := client.(*conns.AWSClient).WorkSpacesConn()
 
 	var errors error
 	input := &workspaces.DescribeWorkspacesInput{}
@@ -0,0 +1,203 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// EnvVarConcurrency is the maximum number of resources SweepOrchestrator deletes concurrently.
+	EnvVarConcurrency = "SWEEP_CONCURRENCY"
+
+	// EnvVarDryRun enables sweeper dry runs, which list the resources that would be swept without deleting them.
+	EnvVarDryRun = "SWEEP_DRY_RUN"
+
+	// EnvVarInventoryDir is the directory to which JSON inventory reports of swept resources are written, one per region.
+	EnvVarInventoryDir = "SWEEP_INVENTORY_DIR"
+
+	// EnvVarMinAge is the minimum age, as a Go duration, of resources to sweep, e.g. "6h".
+	EnvVarMinAge = "SWEEP_MIN_AGE"
+
+	// EnvVarNamePrefixes is a comma-separated list of name prefixes of resources to sweep.
+	EnvVarNamePrefixes = "SWEEP_NAME_PREFIXES"
+
+	// EnvVarTags is a comma-separated list of tags, key=value or key, of resources to sweep.
+	EnvVarTags = "SWEEP_TAGS"
+)
+
+// Filter selects the resources to sweep. Resources must match all configured criteria.
+// A resource whose name, tags or creation time is unknown does not match a criterion on it.
+type Filter struct {
+	MinAge       time.Duration
+	NamePrefixes []string
+	// Tags maps tag keys to values. An empty value matches any value.
+	Tags map[string]string
+}
+
+// Match returns whether the resource matches the filter and, if not, why.
+func (f *Filter) Match(r *SweepResource, now time.Time) (bool, string) {
+	if f == nil {
+		return true, ""
+	}
+
+	if len(f.NamePrefixes) > 0 {
+		name := r.Name()
+		matched := false
+
+		for _, prefix := range f.NamePrefixes {
+			if strings.HasPrefix(name, prefix) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false, fmt.Sprintf("name (%s) does not match prefixes (%s)", name, strings.Join(f.NamePrefixes, ", "))
+		}
+	}
+
+	if len(f.Tags) > 0 {
+		tags := r.Tags()
+
+		for key, value := range f.Tags {
+			v, ok := tags[key]
+
+			if !ok {
+				return false, fmt.Sprintf("tag (%s) not found", key)
+			}
+
+			if value != "" && v != value {
+				return false, fmt.Sprintf("tag (%s) value (%s) is not %s", key, v, value)
+			}
+		}
+	}
+
+	if f.MinAge > 0 {
+		if r.creationTime.IsZero() {
+			return false, "creation time unknown"
+		}
+
+		if age := now.Sub(r.creationTime); age < f.MinAge {
+			return false, fmt.Sprintf("age (%s) less than minimum (%s)", age.Round(time.Second), f.MinAge)
+		}
+	}
+
+	return true, ""
+}
+
+const DefaultConcurrency = 10
+
+// Settings is the sweeper framework configuration.
+type Settings struct {
+	Concurrency  int
+	DryRun       bool
+	Filter       *Filter
+	InventoryDir string
+}
+
+// TagsFiltered returns whether the configured filter has tag criteria,
+// i.e. whether sweepers must look up the tags of resources whose list operations do not return them.
+func TagsFiltered() bool {
+	settings, err := SweeperSettings()
+
+	return err == nil && settings.Filter != nil && len(settings.Filter.Tags) > 0
+}
+
+var (
+	settings     *Settings
+	settingsErr  error
+	settingsOnce sync.Once
+)
+
+// SweeperSettings returns the sweeper framework configuration from environment variables.
+func SweeperSettings() (*Settings, error) {
+	settingsOnce.Do(func() {
+		settings, settingsErr = settingsFromEnv(os.Getenv)
+	})
+
+	return settings, settingsErr
+}
+
+func settingsFromEnv(getenv func(string) string) (*Settings, error) {
+	s := &Settings{
+		Concurrency:  DefaultConcurrency,
+		InventoryDir: getenv(EnvVarInventoryDir),
+	}
+
+	if v := getenv(EnvVarConcurrency); v != "" {
+		concurrency, err := strconv.Atoi(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", EnvVarConcurrency, err)
+		}
+
+		if concurrency < 1 {
+			return nil, fmt.Errorf("environment variable %s: must be at least 1, got %d", EnvVarConcurrency, concurrency)
+		}
+
+		s.Concurrency = concurrency
+	}
+
+	if v := getenv(EnvVarDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", EnvVarDryRun, err)
+		}
+
+		s.DryRun = dryRun
+	}
+
+	filter := &Filter{}
+
+	if v := getenv(EnvVarMinAge); v != "" {
+		minAge, err := time.ParseDuration(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", EnvVarMinAge, err)
+		}
+
+		filter.MinAge = minAge
+	}
+
+	for _, v := range strings.Split(getenv(EnvVarNamePrefixes), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			filter.NamePrefixes = append(filter.NamePrefixes, v)
+		}
+	}
+
+	for _, v := range strings.Split(getenv(EnvVarTags), ",") {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+
+		if filter.Tags == nil {
+			filter.Tags = make(map[string]string)
+		}
+
+		parts := strings.SplitN(v, "=", 2)
+
+		if parts[0] == "" {
+			return nil, fmt.Errorf("environment variable %s: empty tag key in (%s)", EnvVarTags, v)
+		}
+
+		if len(parts) == 2 {
+			filter.Tags[parts[0]] = parts[1]
+		} else {
+			filter.Tags[parts[0]] = ""
+		}
+	}
+
+	if filter.MinAge > 0 || len(filter.NamePrefixes) > 0 || len(filter.Tags) > 0 {
+		s.Filter = filter
+	}
+
+	return s, nil
+}
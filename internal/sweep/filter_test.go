@@ -0,0 +1,262 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testSweepResource(t *testing.T, id, name string, tags map[string]interface{}) *SweepResource {
+	t.Helper()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Optional: true},
+			"tags": {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error { return nil },
+	}
+	d := r.Data(nil)
+	d.SetId(id)
+
+	if name != "" {
+		if err := d.Set("name", name); err != nil {
+			t.Fatalf("error setting name: %s", err)
+		}
+	}
+
+	if tags != nil {
+		if err := d.Set("tags", tags); err != nil {
+			t.Fatalf("error setting tags: %s", err)
+		}
+	}
+
+	return NewSweepResource(r, d, &conns.AWSClient{Region: "us-west-2"}) //lintignore:AWSAT003
+}
+
+func TestSettingsFromEnv(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Env       map[string]string
+		Expected  *Settings
+		ExpectErr bool
+	}{
+		{
+			Name:     "empty",
+			Expected: &Settings{Concurrency: DefaultConcurrency},
+		},
+		{
+			Name: "all",
+			Env: map[string]string{
+				EnvVarConcurrency:  "4",
+				EnvVarDryRun:       "true",
+				EnvVarInventoryDir: "/tmp/inventory",
+				EnvVarMinAge:       "6h",
+				EnvVarNamePrefixes: "tf-acc-test, tf-test-,",
+				EnvVarTags:         "Owner=ci,Ephemeral",
+			},
+			Expected: &Settings{
+				Concurrency:  4,
+				DryRun:       true,
+				InventoryDir: "/tmp/inventory",
+				Filter: &Filter{
+					MinAge:       6 * time.Hour,
+					NamePrefixes: []string{"tf-acc-test", "tf-test-"},
+					Tags:         map[string]string{"Owner": "ci", "Ephemeral": ""},
+				},
+			},
+		},
+		{
+			Name:      "invalid concurrency",
+			Env:       map[string]string{EnvVarConcurrency: "0"},
+			ExpectErr: true,
+		},
+		{
+			Name:      "invalid dry run",
+			Env:       map[string]string{EnvVarDryRun: "maybe"},
+			ExpectErr: true,
+		},
+		{
+			Name:      "invalid min age",
+			Env:       map[string]string{EnvVarMinAge: "6"},
+			ExpectErr: true,
+		},
+		{
+			Name:      "invalid tag",
+			Env:       map[string]string{EnvVarTags: "=ci"},
+			ExpectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := settingsFromEnv(func(key string) string { return testCase.Env[key] })
+
+			if testCase.ExpectErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			gotJSON, _ := json.Marshal(got)
+			expectedJSON, _ := json.Marshal(testCase.Expected)
+
+			if string(gotJSON) != string(expectedJSON) {
+				t.Errorf("expected %s, got %s", expectedJSON, gotJSON)
+			}
+		})
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		Name     string
+		Filter   *Filter
+		Resource *SweepResource
+		Expected bool
+	}{
+		{
+			Name:     "no filter",
+			Resource: testSweepResource(t, "id", "", nil),
+			Expected: true,
+		},
+		{
+			Name:     "name prefix from ID",
+			Filter:   &Filter{NamePrefixes: []string{"tf-acc-test"}},
+			Resource: testSweepResource(t, "tf-acc-test-123", "", nil),
+			Expected: true,
+		},
+		{
+			Name:     "name prefix from name",
+			Filter:   &Filter{NamePrefixes: []string{"other", "tf-acc-test"}},
+			Resource: testSweepResource(t, "i-123", "tf-acc-test-123", nil),
+			Expected: true,
+		},
+		{
+			Name:     "name prefix from WithName",
+			Filter:   &Filter{NamePrefixes: []string{"tf-acc-test"}},
+			Resource: testSweepResource(t, "i-123", "", nil).WithName("tf-acc-test-123"),
+			Expected: true,
+		},
+		{
+			Name:     "name prefix mismatch",
+			Filter:   &Filter{NamePrefixes: []string{"tf-acc-test"}},
+			Resource: testSweepResource(t, "i-123", "production", nil),
+			Expected: false,
+		},
+		{
+			Name:     "tags",
+			Filter:   &Filter{Tags: map[string]string{"Owner": "ci", "Ephemeral": ""}},
+			Resource: testSweepResource(t, "i-123", "", map[string]interface{}{"Owner": "ci", "Ephemeral": "yes"}),
+			Expected: true,
+		},
+		{
+			Name:     "tags from WithTags",
+			Filter:   &Filter{Tags: map[string]string{"Owner": "ci"}},
+			Resource: testSweepResource(t, "i-123", "", nil).WithTags(map[string]string{"Owner": "ci"}),
+			Expected: true,
+		},
+		{
+			Name:     "tag value mismatch",
+			Filter:   &Filter{Tags: map[string]string{"Owner": "ci"}},
+			Resource: testSweepResource(t, "i-123", "", map[string]interface{}{"Owner": "production"}),
+			Expected: false,
+		},
+		{
+			Name:     "tags unknown",
+			Filter:   &Filter{Tags: map[string]string{"Ephemeral": ""}},
+			Resource: testSweepResource(t, "i-123", "", nil),
+			Expected: false,
+		},
+		{
+			Name:     "min age",
+			Filter:   &Filter{MinAge: time.Hour},
+			Resource: testSweepResource(t, "i-123", "", nil).WithCreationTime(now.Add(-2 * time.Hour)),
+			Expected: true,
+		},
+		{
+			Name:     "too new",
+			Filter:   &Filter{MinAge: time.Hour},
+			Resource: testSweepResource(t, "i-123", "", nil).WithCreationTime(now.Add(-time.Minute)),
+			Expected: false,
+		},
+		{
+			Name:     "creation time unknown",
+			Filter:   &Filter{MinAge: time.Hour},
+			Resource: testSweepResource(t, "i-123", "", nil),
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, reason := testCase.Filter.Match(testCase.Resource, now)
+
+			if got != testCase.Expected {
+				t.Errorf("expected %t, got %t (%s)", testCase.Expected, got, reason)
+			}
+
+			if !got && reason == "" {
+				t.Error("expected reason")
+			}
+		})
+	}
+}
+
+func TestInventoryWrite(t *testing.T) {
+	dir := t.TempDir()
+	i := &inventory{reports: make(map[string]*InventoryReport)}
+
+	i.record(testSweepResource(t, "tf-acc-test-1", "", nil), true, InventoryActionDryRun, "", nil)
+	i.record(testSweepResource(t, "other", "", nil), true, InventoryActionFiltered, "name (other) does not match prefixes (tf-acc-test)", nil)
+	region := i.record(testSweepResource(t, "tf-acc-test-2", "", nil), true, InventoryActionFailed, "", errors.New("boom"))
+
+	if err := i.write(filepath.Join(dir, "inventory"), []string{region}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, "inventory", region+".json"))
+
+	if err != nil {
+		t.Fatalf("error reading inventory: %s", err)
+	}
+
+	var report InventoryReport
+
+	if err := json.Unmarshal(b, &report); err != nil {
+		t.Fatalf("error parsing inventory: %s", err)
+	}
+
+	if !report.DryRun || report.Region != region || len(report.Resources) != 3 {
+		t.Fatalf("unexpected inventory: %s", b)
+	}
+
+	if got, expected := report.Resources[1].Action, InventoryActionFiltered; got != expected {
+		t.Errorf("expected action %s, got %s", expected, got)
+	}
+
+	if got, expected := report.Resources[2].Error, "boom"; got != expected {
+		t.Errorf("expected error %s, got %s", expected, got)
+	}
+
+	if report.Resources[0].Resource == "" {
+		t.Error("expected resource")
+	}
+}
@@ -0,0 +1,153 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	InventoryActionDeleted  = "deleted"
+	InventoryActionDryRun   = "would_delete"
+	InventoryActionFailed   = "failed"
+	InventoryActionFiltered = "filtered"
+)
+
+const inventoryUnknownRegion = "unknown"
+
+// InventoryEntry records what happened to a resource handed to the sweeper framework.
+type InventoryEntry struct {
+	Action       string            `json:"action"`
+	CreationTime *time.Time        `json:"creation_time,omitempty"`
+	Error        string            `json:"error,omitempty"`
+	ID           string            `json:"id"`
+	Name         string            `json:"name,omitempty"`
+	Reason       string            `json:"reason,omitempty"`
+	Resource     string            `json:"resource"`
+	Tags         map[string]string `json:"tags,omitempty"`
+	Time         time.Time         `json:"time"`
+}
+
+// InventoryReport is the JSON inventory report for a region.
+type InventoryReport struct {
+	DryRun    bool              `json:"dry_run"`
+	Region    string            `json:"region"`
+	Resources []*InventoryEntry `json:"resources"`
+}
+
+type inventory struct {
+	mutex   sync.Mutex
+	reports map[string]*InventoryReport
+}
+
+var sweepInventory = &inventory{reports: make(map[string]*InventoryReport)}
+
+// record adds an entry for the resource to the report for the resource's region and returns the region.
+func (i *inventory) record(r *SweepResource, dryRun bool, action, reason string, err error) string {
+	entry := &InventoryEntry{
+		Action:   action,
+		ID:       r.d.Id(),
+		Name:     r.Name(),
+		Reason:   reason,
+		Resource: resourceDeleteFuncName(r.resource),
+		Tags:     r.Tags(),
+		Time:     time.Now().UTC(),
+	}
+
+	if !r.creationTime.IsZero() {
+		creationTime := r.creationTime.UTC()
+		entry.CreationTime = &creationTime
+	}
+
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	region := inventoryUnknownRegion
+
+	if client, ok := r.meta.(*conns.AWSClient); ok {
+		region = client.Region
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	report, ok := i.reports[region]
+
+	if !ok {
+		report = &InventoryReport{DryRun: dryRun, Region: region}
+		i.reports[region] = report
+	}
+
+	report.Resources = append(report.Resources, entry)
+
+	return region
+}
+
+// write writes the reports for the regions to <dir>/<region>.json.
+func (i *inventory) write(dir string, regions []string) error {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("error creating sweeper inventory directory (%s): %w", dir, err)
+	}
+
+	sort.Strings(regions)
+
+	for _, region := range regions {
+		report, ok := i.reports[region]
+
+		if !ok {
+			continue
+		}
+
+		b, err := json.MarshalIndent(report, "", "  ")
+
+		if err != nil {
+			return fmt.Errorf("error encoding sweeper inventory for %s: %w", region, err)
+		}
+
+		path := filepath.Join(dir, region+".json")
+
+		if err := os.WriteFile(path, b, 0644); err != nil {
+			return fmt.Errorf("error writing sweeper inventory (%s): %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceDeleteFuncName returns the package-qualified name of the resource's delete function, e.g. "ec2.resourceVPCDelete",
+// as *schema.Resource does not know its resource type name.
+func resourceDeleteFuncName(r *schema.Resource) string {
+	var fn interface{}
+
+	switch {
+	case r.DeleteWithoutTimeout != nil:
+		fn = r.DeleteWithoutTimeout
+	case r.DeleteContext != nil:
+		fn = r.DeleteContext
+	case r.Delete != nil:
+		fn = r.Delete
+	default:
+		return ""
+	}
+
+	name := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()).Name()
+
+	return name[strings.LastIndex(name, "/")+1:]
+}
@@ -0,0 +1,191 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testOrchestratorResources returns sweep resources whose deletion fails with a dependency error
+// until the resources they depend on are deleted.
+func testOrchestratorResources(dependencies map[string][]string, deleted *sync.Map, active, maxActive *int32) []*SweepResource {
+	var sweepResources []*SweepResource
+
+	for id := range dependencies {
+		id := id
+		r := &schema.Resource{
+			DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				n := atomic.AddInt32(active, 1)
+				defer atomic.AddInt32(active, -1)
+
+				for {
+					m := atomic.LoadInt32(maxActive)
+
+					if n <= m || atomic.CompareAndSwapInt32(maxActive, m, n) {
+						break
+					}
+				}
+
+				time.Sleep(10 * time.Millisecond)
+
+				for _, dependency := range dependencies[id] {
+					if _, ok := deleted.Load(dependency); !ok {
+						return diag.FromErr(fmt.Errorf("error deleting (%s): %w", id, awserr.New("DependencyViolation", "resource has a dependent object", nil)))
+					}
+				}
+
+				deleted.Store(id, true)
+
+				return nil
+			},
+		}
+		d := r.Data(nil)
+		d.SetId(id)
+
+		sweepResources = append(sweepResources, NewSweepResource(r, d, nil))
+	}
+
+	return sweepResources
+}
+
+func TestSweepOrchestrate(t *testing.T) {
+	sweepRequeueDelay = 0
+
+	testCases := []struct {
+		Name         string
+		Dependencies map[string][]string
+		Concurrency  int
+		ExpectErr    bool
+	}{
+		{
+			Name: "independent",
+			Dependencies: map[string][]string{
+				"a": nil, "b": nil, "c": nil, "d": nil, "e": nil, "f": nil,
+			},
+			Concurrency: 2,
+		},
+		{
+			Name: "dependencies",
+			Dependencies: map[string][]string{
+				"vpc":    {"subnet", "sg", "eni"},
+				"subnet": {"eni"},
+				"sg":     {"eni"},
+				"eni":    nil,
+			},
+			Concurrency: 4,
+		},
+		{
+			Name: "cycle",
+			Dependencies: map[string][]string{
+				"a": {"b"},
+				"b": {"a"},
+				"c": nil,
+			},
+			Concurrency: 1,
+			ExpectErr:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var deleted sync.Map
+			var active, maxActive int32
+
+			sweepResources := testOrchestratorResources(testCase.Dependencies, &deleted, &active, &maxActive)
+			err := sweepOrchestrate(context.Background(), &Settings{Concurrency: testCase.Concurrency}, sweepResources, 0, 0, 0, 0, time.Minute)
+
+			if testCase.ExpectErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for id := range testCase.Dependencies {
+				_, ok := deleted.Load(id)
+				expected := !testCase.ExpectErr || len(testCase.Dependencies[id]) == 0
+
+				if ok != expected {
+					t.Errorf("expected %s deleted %t, got %t", id, expected, ok)
+				}
+			}
+
+			if got := atomic.LoadInt32(&maxActive); got > int32(testCase.Concurrency) {
+				t.Errorf("expected at most %d concurrent deletions, got %d", testCase.Concurrency, got)
+			}
+		})
+	}
+}
+
+func TestSweepOrchestrateDryRun(t *testing.T) {
+	var deleted sync.Map
+	var active, maxActive int32
+
+	sweepResources := testOrchestratorResources(map[string][]string{"a": nil}, &deleted, &active, &maxActive)
+
+	if err := sweepOrchestrate(context.Background(), &Settings{Concurrency: 1, DryRun: true}, sweepResources, 0, 0, 0, 0, time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, ok := deleted.Load("a"); ok {
+		t.Error("expected resource not to be deleted")
+	}
+}
+
+func TestSweepErrorClassification(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Err        error
+		Throttling bool
+		Dependency bool
+	}{
+		{
+			Name:       "AWS throttling",
+			Err:        awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil),
+			Throttling: true,
+		},
+		{
+			Name:       "wrapped AWS throttling",
+			Err:        fmt.Errorf("error deleting: %w", awserr.New("ThrottlingException", "Rate exceeded", nil)),
+			Throttling: true,
+		},
+		{
+			Name:       "diagnostic dependency",
+			Err:        errors.New("error deleting resource: error deleting EC2 Security Group (sg-1): DependencyViolation: resource sg-1 has a dependent object"),
+			Dependency: true,
+		},
+		{
+			Name:       "AWS resource in use",
+			Err:        awserr.New("ResourceInUseException", "in use", nil),
+			Dependency: true,
+		},
+		{
+			Name: "other",
+			Err:  awserr.New("AccessDenied", "denied", nil),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := isSweepThrottlingError(testCase.Err); got != testCase.Throttling {
+				t.Errorf("expected throttling %t, got %t", testCase.Throttling, got)
+			}
+
+			if got := isSweepDependencyError(testCase.Err); got != testCase.Dependency {
+				t.Errorf("expected dependency %t, got %t", testCase.Dependency, got)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -34,10 +36,30 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 // This prevents client re-initialization for every resource with no benefit.
 var SweeperClients map[string]interface{}
 
+// sweeperDeleteClients is a shared cache of regional conns.AWSClient used by SweepOrchestrator
+// to delete the resources matching the configured filter when SweeperClients are read-only.
+var sweeperDeleteClients = make(map[string]interface{})
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper
 // functions for a given region
 func SharedRegionalSweepClient(region string) (interface{}, error) {
-	if client, ok := SweeperClients[region]; ok {
+	settings, err := SweeperSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	// In a dry run, API calls that may modify resources fail, including those made by sweepers that do not use SweepOrchestrator.
+	// Only SweepOrchestrator applies the filter, so API calls that may modify resources also fail when a filter is configured.
+	return sharedRegionalSweepClient(region, settings.DryRun || settings.Filter != nil, SweeperClients)
+}
+
+// sharedRegionalSweepDeleteClient returns the conns.AWSClient with which SweepOrchestrator deletes resources in the region.
+func sharedRegionalSweepDeleteClient(region string) (interface{}, error) {
+	return sharedRegionalSweepClient(region, false, sweeperDeleteClients)
+}
+
+func sharedRegionalSweepClient(region string, readOnly bool, clients map[string]interface{}) (interface{}, error) {
+	if client, ok := clients[region]; ok {
 		return client, nil
 	}
 
@@ -55,6 +77,7 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 
 	conf := &conns.Config{
 		MaxRetries: 5,
+		ReadOnly:   readOnly,
 		Region:     region,
 	}
 
@@ -85,15 +108,18 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 		return nil, fmt.Errorf("error getting AWS client: %w", err)
 	}
 
-	SweeperClients[region] = client
+	clients[region] = client
 
 	return client, nil
 }
 
 type SweepResource struct {
-	d        *schema.ResourceData
-	meta     interface{}
-	resource *schema.Resource
+	creationTime time.Time
+	d            *schema.ResourceData
+	meta         interface{}
+	name         string
+	resource     *schema.Resource
+	tags         map[string]string
 }
 
 func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) *SweepResource {
@@ -104,41 +130,298 @@ func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta in
 	}
 }
 
+// WithCreationTime sets the resource's creation time, used by the minimum age filter.
+func (r *SweepResource) WithCreationTime(creationTime time.Time) *SweepResource {
+	r.creationTime = creationTime
+
+	return r
+}
+
+// WithName sets the resource's name, used by the name prefix filter.
+func (r *SweepResource) WithName(name string) *SweepResource {
+	r.name = name
+
+	return r
+}
+
+// WithTags sets the resource's tags, used by the tag filter.
+func (r *SweepResource) WithTags(tags map[string]string) *SweepResource {
+	r.tags = tags
+
+	return r
+}
+
+// Name returns the resource's name: the name set with WithName, its name argument or its ID.
+func (r *SweepResource) Name() string {
+	if r.name != "" {
+		return r.name
+	}
+
+	if _, ok := r.resource.Schema["name"]; ok {
+		if v, ok := r.d.Get("name").(string); ok && v != "" {
+			return v
+		}
+	}
+
+	return r.d.Id()
+}
+
+// Tags returns the resource's tags: the tags set with WithTags or its tags argument.
+func (r *SweepResource) Tags() map[string]string {
+	if r.tags != nil {
+		return r.tags
+	}
+
+	if _, ok := r.resource.Schema["tags"]; !ok {
+		return nil
+	}
+
+	v, ok := r.d.Get("tags").(map[string]interface{})
+
+	if !ok || len(v) == 0 {
+		return nil
+	}
+
+	tags := make(map[string]string, len(v))
+
+	for key, value := range v {
+		tags[key], _ = value.(string)
+	}
+
+	return tags
+}
+
 func SweepOrchestrator(sweepResources []*SweepResource) error {
 	return SweepOrchestratorContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
 }
 
+// SweepOrchestratorContext deletes the resources matching the configured filter, at most the configured concurrency at a time.
+// Deletions failing with throttling errors are retried until timeout. Resources failing with dependency errors are
+// re-queued, after the others have been deleted, until all are deleted or a round deletes none of them.
+// In a dry run, the resources that would be deleted are logged instead.
+// If an inventory directory is configured, what happened to each resource is recorded in its region's inventory report.
 func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
-	var g multierror.Group
+	settings, err := SweeperSettings()
+
+	if err != nil {
+		return err
+	}
+
+	return sweepOrchestrate(ctx, settings, sweepResources, delay, delayRand, minTimeout, pollInterval, timeout)
+}
+
+func sweepOrchestrate(ctx context.Context, settings *Settings, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+	var errs *multierror.Error
+	var mutex sync.Mutex
+	var pending []*SweepResource
+	regions := make(map[string]bool)
+	now := time.Now()
+
+	record := func(sweepResource *SweepResource, action, reason string, err error) {
+		region := sweepInventory.record(sweepResource, settings.DryRun, action, reason, err)
+
+		mutex.Lock()
+		regions[region] = true
+		mutex.Unlock()
+	}
 
 	for _, sweepResource := range sweepResources {
-		sweepResource := sweepResource
+		if ok, reason := settings.Filter.Match(sweepResource, now); !ok {
+			log.Printf("[INFO] Not sweeping resource (%s): %s", sweepResource.d.Id(), reason)
+			record(sweepResource, InventoryActionFiltered, reason, nil)
 
-		g.Go(func() error {
-			err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
-				err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+			continue
+		}
+
+		if settings.DryRun {
+			log.Printf("[INFO] Dry run: would sweep resource (%s)", sweepResource.d.Id())
+			record(sweepResource, InventoryActionDryRun, "", nil)
+
+			continue
+		}
+
+		// With a filter configured, the sweeper's client is read-only.
+		if client, ok := sweepResource.meta.(*conns.AWSClient); ok && settings.Filter != nil {
+			meta, err := sharedRegionalSweepDeleteClient(client.Region)
+
+			if err != nil {
+				record(sweepResource, InventoryActionFailed, "", err)
+				errs = multierror.Append(errs, err)
+
+				continue
+			}
+
+			sweepResource.meta = meta
+		}
+
+		pending = append(pending, sweepResource)
+	}
+
+	semaphore := make(tfsync.Semaphore, settings.Concurrency)
 
-				if err != nil {
-					if strings.Contains(err.Error(), "Throttling") {
-						log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
-						return resource.RetryableError(err)
-					}
+	for round := 1; len(pending) > 0; round++ {
+		if round > 1 {
+			log.Printf("[INFO] Retrying sweep of %d resources with dependency errors (round %d)", len(pending), round)
 
-					return resource.NonRetryableError(err)
+			if err := sleepContext(ctx, sweepRequeueDelay); err != nil {
+				for _, sweepResource := range pending {
+					record(sweepResource, InventoryActionFailed, "", err)
 				}
 
-				return nil
-			})
+				errs = multierror.Append(errs, err)
 
-			if tfresource.TimedOut(err) {
-				err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+				break
 			}
+		}
+
+		results := make([]error, len(pending))
+		var wg sync.WaitGroup
 
-			return err
-		})
+		for i, sweepResource := range pending {
+			i, sweepResource := i, sweepResource
+
+			semaphore.Wait()
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+				defer semaphore.Notify()
+
+				results[i] = sweepResourceWithRetry(ctx, sweepResource, delay, delayRand, minTimeout, pollInterval, timeout)
+			}()
+		}
+
+		wg.Wait()
+
+		var requeue []*SweepResource
+		var requeueErrs []error
+
+		for i, err := range results {
+			sweepResource := pending[i]
+
+			switch {
+			case err == nil:
+				record(sweepResource, InventoryActionDeleted, "", nil)
+			case isSweepDependencyError(err):
+				log.Printf("[INFO] While sweeping resource (%s), encountered dependency error (%s). Re-queueing...", sweepResource.d.Id(), err)
+				requeue = append(requeue, sweepResource)
+				requeueErrs = append(requeueErrs, err)
+			default:
+				record(sweepResource, InventoryActionFailed, "", err)
+				errs = multierror.Append(errs, err)
+			}
+		}
+
+		// Stop at a fixed point, when no re-queued resource could be deleted.
+		if len(requeue) == len(pending) {
+			for i, sweepResource := range requeue {
+				record(sweepResource, InventoryActionFailed, "", requeueErrs[i])
+				errs = multierror.Append(errs, requeueErrs[i])
+			}
+
+			break
+		}
+
+		pending = requeue
+	}
+
+	if settings.InventoryDir != "" {
+		var inventoryRegions []string
+
+		for region := range regions {
+			inventoryRegions = append(inventoryRegions, region)
+		}
+
+		if err := sweepInventory.write(settings.InventoryDir, inventoryRegions); err != nil {
+			errs = multierror.Append(errs, err)
+		}
 	}
 
-	return g.Wait().ErrorOrNil()
+	return errs.ErrorOrNil()
+}
+
+// sweepResourceWithRetry deletes the resource, retrying on throttling errors.
+func sweepResourceWithRetry(ctx context.Context, sweepResource *SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+	err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
+		err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+
+		if err != nil {
+			if isSweepThrottlingError(err) {
+				log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+	}
+
+	return err
+}
+
+// sweepRequeueDelay is how long to wait before retrying the deletion of resources that failed with dependency errors,
+// to allow the deletion of their dependencies to complete.
+var sweepRequeueDelay = 30 * time.Second
+
+var sweepThrottlingErrorCodes = []string{
+	"PriorRequestNotComplete",
+	"ProvisionedThroughputExceededException",
+	"RequestLimitExceeded",
+	"RequestThrottled",
+	"RequestThrottledException",
+	"SlowDown",
+	"ThrottledException",
+	"Throttling",
+	"ThrottlingException",
+	"TooManyRequestsException",
+}
+
+var sweepDependencyErrorCodes = []string{
+	"DeleteConflict",
+	"DependencyViolation",
+	"ResourceInUse",
+	"ResourceInUseException",
+}
+
+func isSweepThrottlingError(err error) bool {
+	return sweepErrorCodeEquals(err, sweepThrottlingErrorCodes)
+}
+
+func isSweepDependencyError(err error) bool {
+	return sweepErrorCodeEquals(err, sweepDependencyErrorCodes)
+}
+
+// sweepErrorCodeEquals returns whether the error has one of the AWS error codes.
+// Errors from resources' diagnostics have lost their AWS error, so they are matched on the "Code: message" form of the error message.
+func sweepErrorCodeEquals(err error, codes []string) bool {
+	if tfawserr.ErrCodeEquals(err, codes...) {
+		return true
+	}
+
+	for _, code := range codes {
+		if strings.Contains(err.Error(), code+":") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
 
 // Check sweeper API call error for reasons to skip sweeping
@@ -207,7 +490,20 @@ func SkipSweepError(err error) bool {
 	return false
 }
 
+// DeleteResource deletes the resource, unless this is a dry run.
 func DeleteResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	settings, err := SweeperSettings()
+
+	if err != nil {
+		return err
+	}
+
+	if settings.DryRun {
+		log.Printf("[INFO] Dry run: would delete resource (%s)", d.Id())
+
+		return nil
+	}
+
 	if resource.DeleteContext != nil || resource.DeleteWithoutTimeout != nil {
 		var diags diag.Diagnostics
 
@@ -19,9 +19,34 @@ const (
 	ServerlessApplicationRepositoryTagKeyPrefix = `serverlessrepo:`
 )
 
+const (
+	// DefaultTagsPrecedenceProvider causes provider default tags to take precedence over resource tags.
+	DefaultTagsPrecedenceProvider = "provider"
+	// DefaultTagsPrecedenceResource causes resource tags to take precedence over provider default tags.
+	DefaultTagsPrecedenceResource = "resource"
+)
+
+func DefaultTagsPrecedence_Values() []string {
+	return []string{
+		DefaultTagsPrecedenceProvider,
+		DefaultTagsPrecedenceResource,
+	}
+}
+
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	Precedence         string
+	ResourceTypeScopes []DefaultResourceTypeScope
+	Tags               KeyValueTags
+}
+
+// DefaultResourceTypeScope restricts the resource types to which default tags are applied.
+// Keys limits the scope to specific default tag keys; if empty, all default tags are in scope.
+// Resource types may end with a "*" wildcard, e.g. "aws_s3_*".
+type DefaultResourceTypeScope struct {
+	ExcludeResourceTypes []string
+	IncludeResourceTypes []string
+	Keys                 []string
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -60,15 +85,154 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
-// overriding the value of any tag with a matching key.
+// overriding the value of any tag with a matching key unless the
+// DefaultConfig takes precedence over resource tags.
 func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
 	if dc == nil || dc.Tags == nil {
 		return tags
 	}
 
+	if dc.ProviderPrecedence() {
+		return tags.Merge(dc.Tags)
+	}
+
 	return dc.Tags.Merge(tags)
 }
 
+// ProviderPrecedence returns true if the DefaultConfig's Tags take
+// precedence over resource tags.
+func (dc *DefaultConfig) ProviderPrecedence() bool {
+	return dc != nil && dc.Precedence == DefaultTagsPrecedenceProvider
+}
+
+// OverriddenTags returns the given tags whose values conflict with the
+// DefaultConfig's Tags.
+func (dc *DefaultConfig) OverriddenTags(tags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	if dc == nil {
+		return result
+	}
+
+	for k, v := range tags {
+		if defaultVal, ok := dc.Tags[k]; ok && !v.Equal(defaultVal) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// RestoreOverriddenTags returns the resource tags read from a resource, tags,
+// with the prior resource tags, priorTags, whose values are overridden by the
+// DefaultConfig's Tags restored. Reading a resource omits such tags from its
+// resource tags as their values are those of the DefaultConfig's Tags.
+// allTags are all the tags read from the resource.
+func (dc *DefaultConfig) RestoreOverriddenTags(tags, allTags, priorTags KeyValueTags) KeyValueTags {
+	if !dc.ProviderPrecedence() {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	for k, v := range dc.OverriddenTags(priorTags) {
+		if _, ok := tags[k]; ok {
+			continue
+		}
+
+		// Tags changed or removed outside of Terraform are not restored.
+		if allValue, ok := allTags[k]; !ok || !allValue.Equal(dc.Tags[k]) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// HasResourceTypeScopes returns true if the DefaultConfig's Tags are
+// scoped by resource type.
+func (dc *DefaultConfig) HasResourceTypeScopes() bool {
+	return dc != nil && len(dc.ResourceTypeScopes) > 0
+}
+
+// ForResourceType returns a DefaultConfig containing only the Tags
+// within scope for the given resource type.
+func (dc *DefaultConfig) ForResourceType(resourceType string) *DefaultConfig {
+	if !dc.HasResourceTypeScopes() {
+		return dc
+	}
+
+	result := &DefaultConfig{
+		Precedence: dc.Precedence,
+	}
+
+	for k, v := range dc.Tags {
+		inScope := true
+
+		for _, scope := range dc.ResourceTypeScopes {
+			if !scope.includes(k, resourceType) {
+				inScope = false
+				break
+			}
+		}
+
+		if !inScope {
+			continue
+		}
+
+		if result.Tags == nil {
+			result.Tags = make(KeyValueTags)
+		}
+
+		result.Tags[k] = v
+	}
+
+	return result
+}
+
+// includes returns true if the default tag key is applied to the resource type.
+func (scope DefaultResourceTypeScope) includes(key, resourceType string) bool {
+	if len(scope.Keys) > 0 && !stringInSlice(key, scope.Keys) {
+		return true
+	}
+
+	if len(scope.IncludeResourceTypes) > 0 && !resourceTypeMatchesAny(resourceType, scope.IncludeResourceTypes) {
+		return false
+	}
+
+	return !resourceTypeMatchesAny(resourceType, scope.ExcludeResourceTypes)
+}
+
+func resourceTypeMatchesAny(resourceType string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if strings.HasSuffix(pattern, "*") {
+			if strings.HasPrefix(resourceType, strings.TrimSuffix(pattern, "*")) {
+				return true
+			}
+		} else if resourceType == pattern {
+			return true
+		}
+	}
+
+	return false
+}
+
+func stringInSlice(s string, l []string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
+
 // TagsEqual returns true if the given configuration's Tags
 // are equal to those passed in as an argument;
 // otherwise returns false
@@ -173,6 +173,25 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 				"key6": "value6",
 			},
 		},
+		{
+			name: "keys some overridden provider precedence",
+			tags: New(map[string]string{
+				"key1": "value2",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			defaultConfig: &DefaultConfig{
+				Precedence: DefaultTagsPrecedenceProvider,
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -183,6 +202,256 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigOverriddenTags(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name:          "no config",
+			tags:          New(map[string]string{"key1": "value1"}),
+			defaultConfig: nil,
+			want:          map[string]string{},
+		},
+		{
+			name: "keys matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{"key1": "value1"}),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "keys overridden",
+			tags: New(map[string]string{
+				"key1": "value2",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{"key1": "value1"}),
+			},
+			want: map[string]string{
+				"key1": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.OverriddenTags(testCase.tags)
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsDefaultConfigRestoreOverriddenTags(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		allTags       KeyValueTags
+		priorTags     KeyValueTags
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name:      "resource precedence",
+			tags:      New(map[string]string{"key2": "value2"}),
+			allTags:   New(map[string]string{"key1": "value1", "key2": "value2"}),
+			priorTags: New(map[string]string{"key1": "value3", "key2": "value2"}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{"key1": "value1"}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name:      "provider precedence overridden",
+			tags:      New(map[string]string{"key2": "value2"}),
+			allTags:   New(map[string]string{"key1": "value1", "key2": "value2"}),
+			priorTags: New(map[string]string{"key1": "value3", "key2": "value2"}),
+			defaultConfig: &DefaultConfig{
+				Precedence: DefaultTagsPrecedenceProvider,
+				Tags:       New(map[string]string{"key1": "value1"}),
+			},
+			want: map[string]string{
+				"key1": "value3",
+				"key2": "value2",
+			},
+		},
+		{
+			name:      "provider precedence changed outside of Terraform",
+			tags:      New(map[string]string{"key1": "value4", "key2": "value2"}),
+			allTags:   New(map[string]string{"key1": "value4", "key2": "value2"}),
+			priorTags: New(map[string]string{"key1": "value3", "key2": "value2"}),
+			defaultConfig: &DefaultConfig{
+				Precedence: DefaultTagsPrecedenceProvider,
+				Tags:       New(map[string]string{"key1": "value1"}),
+			},
+			want: map[string]string{
+				"key1": "value4",
+				"key2": "value2",
+			},
+		},
+		{
+			name:      "provider precedence removed outside of Terraform",
+			tags:      New(map[string]string{"key2": "value2"}),
+			allTags:   New(map[string]string{"key2": "value2"}),
+			priorTags: New(map[string]string{"key1": "value3", "key2": "value2"}),
+			defaultConfig: &DefaultConfig{
+				Precedence: DefaultTagsPrecedenceProvider,
+				Tags:       New(map[string]string{"key1": "value1"}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name:      "provider precedence not overridden",
+			tags:      New(map[string]string{"key2": "value2"}),
+			allTags:   New(map[string]string{"key1": "value1", "key2": "value2"}),
+			priorTags: New(map[string]string{"key1": "value1", "key2": "value2"}),
+			defaultConfig: &DefaultConfig{
+				Precedence: DefaultTagsPrecedenceProvider,
+				Tags:       New(map[string]string{"key1": "value1"}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.RestoreOverriddenTags(testCase.tags, testCase.allTags, testCase.priorTags)
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsDefaultConfigForResourceType(t *testing.T) {
+	tags := New(map[string]string{
+		"CostCenter":  "1234",
+		"Environment": "test",
+		"Team":        "platform",
+	})
+
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		resourceType  string
+		want          map[string]string
+	}{
+		{
+			name:          "no scopes",
+			defaultConfig: &DefaultConfig{Tags: tags},
+			resourceType:  "aws_autoscaling_group",
+			want: map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "test",
+				"Team":        "platform",
+			},
+		},
+		{
+			name: "key excluded",
+			defaultConfig: &DefaultConfig{
+				ResourceTypeScopes: []DefaultResourceTypeScope{
+					{
+						ExcludeResourceTypes: []string{"aws_autoscaling_group"},
+						Keys:                 []string{"CostCenter"},
+					},
+				},
+				Tags: tags,
+			},
+			resourceType: "aws_autoscaling_group",
+			want: map[string]string{
+				"Environment": "test",
+				"Team":        "platform",
+			},
+		},
+		{
+			name: "key excluded other resource type",
+			defaultConfig: &DefaultConfig{
+				ResourceTypeScopes: []DefaultResourceTypeScope{
+					{
+						ExcludeResourceTypes: []string{"aws_autoscaling_group"},
+						Keys:                 []string{"CostCenter"},
+					},
+				},
+				Tags: tags,
+			},
+			resourceType: "aws_instance",
+			want: map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "test",
+				"Team":        "platform",
+			},
+		},
+		{
+			name: "all keys included wildcard",
+			defaultConfig: &DefaultConfig{
+				ResourceTypeScopes: []DefaultResourceTypeScope{
+					{
+						IncludeResourceTypes: []string{"aws_s3_*"},
+					},
+				},
+				Tags: tags,
+			},
+			resourceType: "aws_s3_bucket",
+			want: map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "test",
+				"Team":        "platform",
+			},
+		},
+		{
+			name: "all keys not included",
+			defaultConfig: &DefaultConfig{
+				ResourceTypeScopes: []DefaultResourceTypeScope{
+					{
+						IncludeResourceTypes: []string{"aws_s3_*"},
+					},
+				},
+				Tags: tags,
+			},
+			resourceType: "aws_instance",
+			want:         map[string]string{},
+		},
+		{
+			name: "multiple scopes",
+			defaultConfig: &DefaultConfig{
+				ResourceTypeScopes: []DefaultResourceTypeScope{
+					{
+						IncludeResourceTypes: []string{"aws_s3_*"},
+						Keys:                 []string{"Team"},
+					},
+					{
+						ExcludeResourceTypes: []string{"aws_s3_bucket_object"},
+					},
+				},
+				Tags: tags,
+			},
+			resourceType: "aws_instance",
+			want: map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "test",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.ForResourceType(testCase.resourceType)
+			testKeyValueTagsVerifyMap(t, got.GetTags().Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -0,0 +1,168 @@
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// Policy is a local evaluation of an AWS Organizations tag policy.
+// Reference: https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_example-tag-policies.html
+type Policy struct {
+	RequiredKeys []string
+	Rules        []*PolicyRule
+}
+
+// PolicyRule is the compliance rule for a single tag key.
+// Key is the required capitalization of the tag key.
+// Values are the allowed tag values, which may end with a "*" wildcard. If empty, any value is allowed.
+type PolicyRule struct {
+	Key    string
+	Values []string
+}
+
+// ParsePolicy parses a tag policy document in the AWS Organizations tag policy syntax.
+// The @@assign, @@append and @@remove value setting operators are supported; other elements, such as enforced_for, are ignored.
+// Reference: https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_inheritance_syntax.html
+func ParsePolicy(document string) (*Policy, error) {
+	var doc struct {
+		Tags map[string]map[string]json.RawMessage `json:"tags"`
+	}
+
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		return nil, fmt.Errorf("error parsing tag policy: %w", err)
+	}
+
+	policy := &Policy{}
+
+	for policyKey, elements := range doc.Tags {
+		rule := &PolicyRule{
+			Key: policyKey,
+		}
+
+		if v, ok := elements["tag_key"]; ok {
+			var tagKey struct {
+				Assign string `json:"@@assign"`
+			}
+
+			if err := json.Unmarshal(v, &tagKey); err != nil {
+				return nil, fmt.Errorf("error parsing tag policy (%s) tag_key: %w", policyKey, err)
+			}
+
+			if tagKey.Assign != "" {
+				rule.Key = tagKey.Assign
+			}
+		}
+
+		if !strings.EqualFold(rule.Key, policyKey) {
+			return nil, fmt.Errorf("tag policy (%s) tag_key (%s) must match the policy key ignoring case", policyKey, rule.Key)
+		}
+
+		if v, ok := elements["tag_value"]; ok {
+			var tagValue struct {
+				Append []string `json:"@@append"`
+				Assign []string `json:"@@assign"`
+				Remove []string `json:"@@remove"`
+			}
+
+			if err := json.Unmarshal(v, &tagValue); err != nil {
+				return nil, fmt.Errorf("error parsing tag policy (%s) tag_value: %w", policyKey, err)
+			}
+
+			rule.Values = append(rule.Values, tagValue.Assign...)
+			rule.Values = append(rule.Values, tagValue.Append...)
+
+			for _, remove := range tagValue.Remove {
+				for i := 0; i < len(rule.Values); i++ {
+					if rule.Values[i] == remove {
+						rule.Values = append(rule.Values[:i], rule.Values[i+1:]...)
+						i--
+					}
+				}
+			}
+		}
+
+		policy.Rules = append(policy.Rules, rule)
+	}
+
+	sort.Slice(policy.Rules, func(i, j int) bool {
+		return policy.Rules[i].Key < policy.Rules[j].Key
+	})
+
+	return policy, nil
+}
+
+// Validate returns an error describing all tags not compliant with the policy.
+func (p *Policy) Validate(tags KeyValueTags) error {
+	if p == nil {
+		return nil
+	}
+
+	var errs *multierror.Error
+
+	for _, key := range p.RequiredKeys {
+		if !tags.KeyExists(key) {
+			errs = multierror.Append(errs, fmt.Errorf("required tag (%s) is missing", key))
+		}
+	}
+
+	keys := tags.Keys()
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		rule := p.rule(key)
+
+		if rule == nil {
+			continue
+		}
+
+		if key != rule.Key {
+			errs = multierror.Append(errs, fmt.Errorf("tag (%s) does not match the capitalization of the tag policy key (%s)", key, rule.Key))
+		}
+
+		var value string
+
+		if v := tags.KeyValue(key); v != nil {
+			value = *v
+		}
+
+		if !rule.allows(value) {
+			errs = multierror.Append(errs, fmt.Errorf("tag (%s) value (%s) is not allowed by the tag policy: allowed values are %s", key, value, strings.Join(rule.Values, ", ")))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// rule returns the policy rule matching the tag key ignoring case.
+func (p *Policy) rule(key string) *PolicyRule {
+	for _, rule := range p.Rules {
+		if strings.EqualFold(rule.Key, key) {
+			return rule
+		}
+	}
+
+	return nil
+}
+
+// allows returns true if the tag value is allowed by the rule.
+func (rule *PolicyRule) allows(value string) bool {
+	if len(rule.Values) == 0 {
+		return true
+	}
+
+	for _, v := range rule.Values {
+		if strings.HasSuffix(v, "*") {
+			if strings.HasPrefix(value, strings.TrimSuffix(v, "*")) {
+				return true
+			}
+		} else if value == v {
+			return true
+		}
+	}
+
+	return false
+}
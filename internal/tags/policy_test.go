@@ -0,0 +1,205 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+func TestParsePolicy(t *testing.T) {
+	testCases := []struct {
+		name      string
+		document  string
+		want      []*PolicyRule
+		wantError bool
+	}{
+		{
+			name:      "invalid JSON",
+			document:  `{`,
+			wantError: true,
+		},
+		{
+			name:     "no tags",
+			document: `{}`,
+			want:     nil,
+		},
+		{
+			name: "key only",
+			document: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {
+        "@@assign": "CostCenter"
+      }
+    }
+  }
+}`,
+			want: []*PolicyRule{
+				{Key: "CostCenter"},
+			},
+		},
+		{
+			name: "value operators",
+			document: `{
+  "tags": {
+    "project": {
+      "tag_key": {
+        "@@assign": "Project",
+        "@@operators_allowed_for_child_policies": ["@@none"]
+      },
+      "tag_value": {
+        "@@assign": ["Alpha", "Beta"],
+        "@@append": ["Gamma*"],
+        "@@remove": ["Beta"]
+      },
+      "enforced_for": {
+        "@@assign": ["ec2:instance"]
+      }
+    },
+    "environment": {
+      "tag_value": {
+        "@@assign": ["prod", "test"]
+      }
+    }
+  }
+}`,
+			want: []*PolicyRule{
+				{Key: "Project", Values: []string{"Alpha", "Gamma*"}},
+				{Key: "environment", Values: []string{"prod", "test"}},
+			},
+		},
+		{
+			name: "mismatched key",
+			document: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {
+        "@@assign": "Owner"
+      }
+    }
+  }
+}`,
+			wantError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := ParsePolicy(testCase.document)
+
+			if testCase.wantError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got.Rules, testCase.want) {
+				t.Errorf("got %#v, want %#v", got.Rules, testCase.want)
+			}
+		})
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	policy := &Policy{
+		RequiredKeys: []string{"CostCenter"},
+		Rules: []*PolicyRule{
+			{Key: "CostCenter", Values: []string{"100", "200*"}},
+			{Key: "Owner"},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		policy     *Policy
+		tags       KeyValueTags
+		wantErrors int
+	}{
+		{
+			name:       "nil policy",
+			policy:     nil,
+			tags:       New(map[string]string{}),
+			wantErrors: 0,
+		},
+		{
+			name:   "compliant",
+			policy: policy,
+			tags: New(map[string]string{
+				"CostCenter": "100",
+				"Owner":      "team",
+				"Other":      "value",
+			}),
+			wantErrors: 0,
+		},
+		{
+			name:   "wildcard value",
+			policy: policy,
+			tags: New(map[string]string{
+				"CostCenter": "2001",
+			}),
+			wantErrors: 0,
+		},
+		{
+			name:   "missing required key",
+			policy: policy,
+			tags: New(map[string]string{
+				"Owner": "team",
+			}),
+			wantErrors: 1,
+		},
+		{
+			name:   "capitalization",
+			policy: policy,
+			tags: New(map[string]string{
+				"CostCenter": "100",
+				"owner":      "team",
+			}),
+			wantErrors: 1,
+		},
+		{
+			name:   "disallowed value",
+			policy: policy,
+			tags: New(map[string]string{
+				"CostCenter": "300",
+			}),
+			wantErrors: 1,
+		},
+		{
+			name:   "multiple violations",
+			policy: policy,
+			tags: New(map[string]string{
+				"costcenter": "300",
+			}),
+			wantErrors: 3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.policy.Validate(testCase.tags)
+
+			if testCase.wantErrors == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if got := len(err.(*multierror.Error).Errors); got != testCase.wantErrors {
+				t.Errorf("got %d errors, want %d: %s", got, testCase.wantErrors, err)
+			}
+		})
+	}
+}
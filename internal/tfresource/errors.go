@@ -16,26 +16,34 @@ func NotFound(err error) bool {
 
 // TimedOut returns true if the error represents a "wait timed out" condition.
 // Specifically, TimedOut returns true if the error matches all these conditions:
-//  * err is of type resource.TimeoutError
+//  * err is of type resource.TimeoutError, or a StateHistoryError wrapping one
 //  * TimeoutError.LastError is nil
 func TimedOut(err error) bool {
+	// The state history of a waiter is the only wrapper matched
+	if historyErr, ok := err.(*StateHistoryError); ok { //nolint:errorlint // Explicitly does *not* match wrapped StateHistoryErrors
+		err = historyErr.Err
+	}
+
 	// This explicitly does *not* match wrapped TimeoutErrors
 	timeoutErr, ok := err.(*resource.TimeoutError) //nolint:errorlint // Explicitly does *not* match wrapped TimeoutErrors
 	return ok && timeoutErr.LastError == nil
 }
 
-// SetLastError sets the LastError field on the error if supported.
+// SetLastError sets the LastError field on the error, or a wrapped error, if supported.
 // If lastErr is nil it is ignored.
 func SetLastError(err, lastErr error) {
-	switch err := err.(type) {
-	case *resource.TimeoutError:
-		if err.LastError == nil {
-			err.LastError = lastErr
+	var timeoutErr *resource.TimeoutError
+	var unexpectedStateErr *resource.UnexpectedStateError
+
+	switch {
+	case errors.As(err, &timeoutErr):
+		if timeoutErr.LastError == nil {
+			timeoutErr.LastError = lastErr
 		}
 
-	case *resource.UnexpectedStateError:
-		if err.LastError == nil {
-			err.LastError = lastErr
+	case errors.As(err, &unexpectedStateErr):
+		if unexpectedStateErr.LastError == nil {
+			unexpectedStateErr.LastError = lastErr
 		}
 	}
 }
@@ -86,6 +86,19 @@ func TestTimedOut(t *testing.T) {
 			Name: "wrapped timeout error non-nil last error",
 			Err:  fmt.Errorf("test: %w", &resource.TimeoutError{LastError: errors.New("test")}),
 		},
+		{
+			Name:     "state history timeout error",
+			Err:      &tfresource.StateHistoryError{Err: &resource.TimeoutError{}},
+			Expected: true,
+		},
+		{
+			Name: "state history timeout error non-nil last error",
+			Err:  &tfresource.StateHistoryError{Err: &resource.TimeoutError{LastError: errors.New("test")}},
+		},
+		{
+			Name: "wrapped state history timeout error",
+			Err:  fmt.Errorf("test: %w", &tfresource.StateHistoryError{Err: &resource.TimeoutError{}}),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -152,6 +165,18 @@ func TestSetLastError(t *testing.T) {
 			Err:     &resource.UnexpectedStateError{LastError: errors.New("test")},
 			LastErr: errors.New("lasttest"),
 		},
+		{
+			Name:     "wrapped timeout error",
+			Err:      &tfresource.StateHistoryError{Err: &resource.TimeoutError{}},
+			LastErr:  errors.New("lasttest"),
+			Expected: true,
+		},
+		{
+			Name:     "wrapped unexpected state error",
+			Err:      &tfresource.StateHistoryError{Err: &resource.UnexpectedStateError{}},
+			LastErr:  errors.New("lasttest"),
+			Expected: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,182 @@
+package tfresource
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// ProgressInterval is the minimum interval between progress reports while the state is unchanged.
+	ProgressInterval = 1 * time.Minute
+
+	// progressWarnFraction is the fraction of the timeout remaining below which progress is reported as a warning.
+	progressWarnFraction = 0.2
+
+	// progressMaxHistory is the maximum number of state observations retained.
+	progressMaxHistory = 20
+)
+
+// StateObservation is a state observed while waiting and how long it was observed for.
+type StateObservation struct {
+	State    string
+	Duration time.Duration
+}
+
+func (o StateObservation) String() string {
+	state := o.State
+
+	if state == "" {
+		state = "<none>"
+	}
+
+	return fmt.Sprintf("%s (%s)", state, o.Duration.Round(time.Second))
+}
+
+// StateHistoryError is returned by WaitForStateContextWithProgress when waiting fails.
+// It wraps the waiter error and records the states observed while waiting.
+// TimedOut and SetLastError see through it to the waiter error.
+type StateHistoryError struct {
+	Err     error
+	History []StateObservation
+}
+
+func (e *StateHistoryError) Error() string {
+	if len(e.History) == 0 {
+		return e.Err.Error()
+	}
+
+	history := make([]string, len(e.History))
+	for i, v := range e.History {
+		history[i] = v.String()
+	}
+
+	return fmt.Sprintf("%s (state history: %s)", e.Err, strings.Join(history, ", "))
+}
+
+func (e *StateHistoryError) Unwrap() error {
+	return e.Err
+}
+
+// progressReporter logs the state observed by a waiter.
+// A line is logged whenever the state changes and at most once per interval while it is unchanged.
+type progressReporter struct {
+	description string
+	interval    time.Duration
+	timeout     time.Duration
+
+	history    []StateObservation
+	lastChange time.Time
+	lastReport time.Time
+	mutex      sync.Mutex
+	now        func() time.Time
+	start      time.Time
+}
+
+func newProgressReporter(description string, timeout time.Duration) *progressReporter {
+	now := time.Now()
+
+	return &progressReporter{
+		description: description,
+		interval:    ProgressInterval,
+		timeout:     timeout,
+		lastChange:  now,
+		now:         time.Now,
+		start:       now,
+	}
+}
+
+// observe records the current state and logs progress if due.
+func (p *progressReporter) observe(state string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	now := p.now()
+	changed := len(p.history) == 0 || p.history[len(p.history)-1].State != state
+
+	if changed {
+		p.history = append(p.history, StateObservation{State: state})
+		p.lastChange = now
+
+		if len(p.history) > progressMaxHistory {
+			p.history = p.history[len(p.history)-progressMaxHistory:]
+		}
+	}
+
+	p.history[len(p.history)-1].Duration = now.Sub(p.lastChange)
+
+	if !changed && now.Sub(p.lastReport) < p.interval {
+		return
+	}
+
+	p.lastReport = now
+
+	elapsed := now.Sub(p.start)
+	remaining := p.timeout - elapsed
+
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	level := "INFO"
+
+	if p.timeout > 0 && float64(remaining) < float64(p.timeout)*progressWarnFraction {
+		level = "WARN"
+	}
+
+	log.Printf("[%s] Waiting for %s: state=%q elapsed=%s remaining=%s", level, p.description, state, elapsed.Round(time.Second), remaining.Round(time.Second))
+}
+
+// stateHistory returns a copy of the observed states.
+func (p *progressReporter) stateHistory() []StateObservation {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if len(p.history) > 0 {
+		p.history[len(p.history)-1].Duration = p.now().Sub(p.lastChange)
+	}
+
+	return append([]StateObservation{}, p.history...)
+}
+
+// WaitForStateContextWithProgress waits for the state change described by `stateConf`,
+// logging the current state, elapsed time and remaining timeout while waiting.
+// `description` identifies the resource in log lines, e.g. "RDS Cluster (example) create".
+// If waiting fails, the returned error is a *StateHistoryError wrapping the waiter error.
+func WaitForStateContextWithProgress(ctx context.Context, stateConf *resource.StateChangeConf, description string) (interface{}, error) {
+	reporter := newProgressReporter(description, stateConf.Timeout)
+	refresh := stateConf.Refresh
+
+	conf := *stateConf
+	conf.Refresh = func() (interface{}, string, error) {
+		output, state, err := refresh()
+
+		if err == nil {
+			reporter.observe(state)
+		}
+
+		return output, state, err
+	}
+
+	output, err := conf.WaitForStateContext(ctx)
+
+	if err != nil {
+		return output, &StateHistoryError{
+			Err:     err,
+			History: reporter.stateHistory(),
+		}
+	}
+
+	return output, nil
+}
+
+// WaitForStateWithProgress waits for the state change described by `stateConf`,
+// logging the current state, elapsed time and remaining timeout while waiting.
+func WaitForStateWithProgress(stateConf *resource.StateChangeConf, description string) (interface{}, error) {
+	return WaitForStateContextWithProgress(context.Background(), stateConf, description)
+}
@@ -0,0 +1,127 @@
+package tfresource_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestWaitForStateContextWithProgress(t *testing.T) {
+	var buf bytes.Buffer
+	writer := log.Writer()
+	log.SetOutput(&buf)
+	defer log.SetOutput(writer)
+
+	states := []string{"creating", "creating", "backing-up", "available"}
+	i := 0
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"creating", "backing-up"},
+		Target:  []string{"available"},
+		Refresh: func() (interface{}, string, error) {
+			state := states[i]
+			i++
+
+			return state, state, nil
+		},
+		Timeout:      1 * time.Minute,
+		PollInterval: 1 * time.Millisecond,
+	}
+
+	output, err := tfresource.WaitForStateContextWithProgress(context.Background(), stateConf, "Test Resource (example) create")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := output.(string), "available"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	logs := buf.String()
+
+	// State changes are always reported; unchanged states within the interval are not.
+	for _, state := range []string{"creating", "backing-up", "available"} {
+		if got, expected := strings.Count(logs, `state="`+state+`"`), 1; got != expected {
+			t.Errorf("state %s reported %d times, expected %d:\n%s", state, got, expected, logs)
+		}
+	}
+
+	if !strings.Contains(logs, "[INFO] Waiting for Test Resource (example) create") {
+		t.Errorf("expected progress line, got:\n%s", logs)
+	}
+}
+
+func TestWaitForStateContextWithProgressError(t *testing.T) {
+	var buf bytes.Buffer
+	writer := log.Writer()
+	log.SetOutput(&buf)
+	defer log.SetOutput(writer)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"creating"},
+		Target:  []string{"available"},
+		Refresh: func() (interface{}, string, error) {
+			return "failed", "failed", nil
+		},
+		Timeout:      1 * time.Minute,
+		PollInterval: 1 * time.Millisecond,
+	}
+
+	_, err := tfresource.WaitForStateContextWithProgress(context.Background(), stateConf, "Test Resource (example) create")
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	var historyErr *tfresource.StateHistoryError
+	if !errors.As(err, &historyErr) {
+		t.Fatalf("expected StateHistoryError, got %T", err)
+	}
+
+	if got, expected := len(historyErr.History), 1; got != expected {
+		t.Fatalf("got %d observations, expected %d", got, expected)
+	}
+
+	if got, expected := historyErr.History[0].State, "failed"; got != expected {
+		t.Errorf("got state %s, expected %s", got, expected)
+	}
+
+	var unexpectedStateErr *resource.UnexpectedStateError
+	if !errors.As(err, &unexpectedStateErr) {
+		t.Errorf("expected wrapped UnexpectedStateError, got %s", err)
+	}
+
+	if !strings.Contains(err.Error(), "state history: failed (") {
+		t.Errorf("expected state history in error, got %s", err)
+	}
+}
+
+func TestWaitForStateContextWithProgressTimeout(t *testing.T) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"creating"},
+		Target:  []string{"available"},
+		Refresh: func() (interface{}, string, error) {
+			return "creating", "creating", nil
+		},
+		Timeout:      50 * time.Millisecond,
+		PollInterval: 1 * time.Millisecond,
+	}
+
+	_, err := tfresource.WaitForStateContextWithProgress(context.Background(), stateConf, "Test Resource (example) create")
+
+	if !tfresource.TimedOut(err) {
+		t.Fatalf("expected timeout error, got %s", err)
+	}
+
+	if !strings.Contains(err.Error(), "state history: creating (") {
+		t.Errorf("expected state history in error, got %s", err)
+	}
+}
@@ -0,0 +1,160 @@
+package verify
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// arnResourceType describes the resource part of the ARNs of a service's resource type.
+type arnResourceType struct {
+	// global is true if the ARNs have no region, e.g. arn:aws:iam::123456789012:role/example.
+	global bool
+	// resource matches the resource part of the ARNs.
+	resource *regexp.Regexp
+}
+
+// arnResourceTypes is the table of ARN formats, keyed by service and resource type.
+// See https://docs.aws.amazon.com/service-authorization/latest/reference/reference_policies_actions-resources-contextkeys.html.
+var arnResourceTypes = map[string]map[string]arnResourceType{
+	"acm": {
+		"certificate": {resource: regexp.MustCompile(`^certificate/.+$`)},
+	},
+	"dynamodb": {
+		"stream": {resource: regexp.MustCompile(`^table/[^/]+/stream/.+$`)},
+		"table":  {resource: regexp.MustCompile(`^table/[^/]+$`)},
+	},
+	"elasticloadbalancing": {
+		"listener":     {resource: regexp.MustCompile(`^listener/(app|net|gwy)/[^/]+/[0-9a-f]+/[0-9a-f]+$`)},
+		"loadbalancer": {resource: regexp.MustCompile(`^loadbalancer/((app|net|gwy)/)?[^/]+(/[0-9a-f]+)?$`)},
+		"targetgroup":  {resource: regexp.MustCompile(`^targetgroup/[^/]+/[0-9a-f]+$`)},
+	},
+	"events": {
+		"event-bus": {resource: regexp.MustCompile(`^event-bus/.+$`)},
+		"rule":      {resource: regexp.MustCompile(`^rule/.+$`)},
+	},
+	"firehose": {
+		"deliverystream": {resource: regexp.MustCompile(`^deliverystream/.+$`)},
+	},
+	"iam": {
+		"group":              {global: true, resource: regexp.MustCompile(`^group/.+$`)},
+		"instance-profile":   {global: true, resource: regexp.MustCompile(`^instance-profile/.+$`)},
+		"oidc-provider":      {global: true, resource: regexp.MustCompile(`^oidc-provider/.+$`)},
+		"policy":             {global: true, resource: regexp.MustCompile(`^policy/.+$`)},
+		"role":               {global: true, resource: regexp.MustCompile(`^role/.+$`)},
+		"saml-provider":      {global: true, resource: regexp.MustCompile(`^saml-provider/.+$`)},
+		"server-certificate": {global: true, resource: regexp.MustCompile(`^server-certificate/.+$`)},
+		"user":               {global: true, resource: regexp.MustCompile(`^user/.+$`)},
+	},
+	"kinesis": {
+		"stream": {resource: regexp.MustCompile(`^stream/.+$`)},
+	},
+	"kms": {
+		"alias": {resource: regexp.MustCompile(`^alias/.+$`)},
+		"key":   {resource: regexp.MustCompile(`^key/.+$`)},
+	},
+	"lambda": {
+		"function": {resource: regexp.MustCompile(`^function:[^:]+(:[^:]+)?$`)},
+		"layer":    {resource: regexp.MustCompile(`^layer:[^:]+(:\d+)?$`)},
+	},
+	"logs": {
+		"log-group": {resource: regexp.MustCompile(`^log-group:[^:]+(:\*)?$`)},
+	},
+	"s3": {
+		"bucket": {global: true, resource: regexp.MustCompile(`^[^/]+$`)},
+		"object": {global: true, resource: regexp.MustCompile(`^[^/]+/.+$`)},
+	},
+	"secretsmanager": {
+		"secret": {resource: regexp.MustCompile(`^secret:.+$`)},
+	},
+	"sns": {
+		"topic": {resource: regexp.MustCompile(`^[^:/]+$`)},
+	},
+	"sqs": {
+		"queue": {resource: regexp.MustCompile(`^[^:/]+$`)},
+	},
+	"states": {
+		"activity":     {resource: regexp.MustCompile(`^activity:.+$`)},
+		"stateMachine": {resource: regexp.MustCompile(`^stateMachine:.+$`)},
+	},
+}
+
+// ValidARNForService returns a SchemaValidateFunc which tests if the provided value
+// is of type string and is a valid ARN of one of the service's resource types, in any partition.
+// It panics if the service or a resource type is not in the table of ARN formats.
+func ValidARNForService(service string, resourceTypes ...string) schema.SchemaValidateFunc {
+	if len(resourceTypes) == 0 {
+		panic(fmt.Sprintf("no resource types for ARN service %q", service))
+	}
+
+	for _, resourceType := range resourceTypes {
+		if _, ok := arnResourceTypes[service][resourceType]; !ok {
+			panic(fmt.Sprintf("unknown ARN resource type %q for service %q", resourceType, service))
+		}
+	}
+
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return ws, errors
+		}
+
+		if value == "" {
+			return ws, errors
+		}
+
+		ws, errors = ValidARN(v, k)
+
+		if len(errors) > 0 {
+			return ws, errors
+		}
+
+		if err := validateARNForService(value, service, resourceTypes); err != nil {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %w", k, value, err))
+		}
+
+		return ws, errors
+	}
+}
+
+func validateARNForService(value, service string, resourceTypes []string) error {
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil {
+		return err
+	}
+
+	if parsedARN.Service != service {
+		return fmt.Errorf("expected service %s, got %s", service, parsedARN.Service)
+	}
+
+	for _, resourceType := range resourceTypes {
+		t := arnResourceTypes[service][resourceType]
+
+		if !t.resource.MatchString(parsedARN.Resource) {
+			continue
+		}
+
+		if t.global && parsedARN.Region != "" {
+			return fmt.Errorf("unexpected region value for %s %s", service, resourceType)
+		}
+
+		if !t.global && parsedARN.Region == "" {
+			return fmt.Errorf("missing region value for %s %s", service, resourceType)
+		}
+
+		return nil
+	}
+
+	sorted := make([]string, len(resourceTypes))
+	copy(sorted, resourceTypes)
+	sort.Strings(sorted)
+
+	return fmt.Errorf("expected %s resource type %s", service, strings.Join(sorted, " or "))
+}
@@ -0,0 +1,138 @@
+package verify
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestValidARNForService(t *testing.T) {
+	testCases := []struct {
+		service       string
+		resourceTypes []string
+		val           interface{}
+		expectedErr   *regexp.Regexp
+	}{
+		{
+			service:       "iam",
+			resourceTypes: []string{"role"},
+			val:           "",
+		},
+		{
+			service:       "iam",
+			resourceTypes: []string{"role"},
+			val:           "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+		},
+		{
+			service:       "iam",
+			resourceTypes: []string{"role"},
+			val:           "arn:aws-us-gov:iam::123456789012:role/service-role/example", //lintignore:AWSAT005
+		},
+		{
+			service:       "iam",
+			resourceTypes: []string{"role"},
+			val:           "arn:aws:s3:::example", //lintignore:AWSAT005
+			expectedErr:   regexp.MustCompile(`expected service iam, got s3`),
+		},
+		{
+			service:       "iam",
+			resourceTypes: []string{"role"},
+			val:           "arn:aws:iam::123456789012:user/example", //lintignore:AWSAT005
+			expectedErr:   regexp.MustCompile(`expected iam resource type role`),
+		},
+		{
+			service:       "iam",
+			resourceTypes: []string{"role"},
+			val:           "arn:aws:iam:us-west-2:123456789012:role/example", //lintignore:AWSAT003,AWSAT005
+			expectedErr:   regexp.MustCompile(`unexpected region value for iam role`),
+		},
+		{
+			service:       "iam",
+			resourceTypes: []string{"role"},
+			val:           "role/example",
+			expectedErr:   regexp.MustCompile(`is an invalid ARN`),
+		},
+		{
+			service:       "kms",
+			resourceTypes: []string{"key", "alias"},
+			val:           "arn:aws-cn:kms:cn-north-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			service:       "kms",
+			resourceTypes: []string{"key", "alias"},
+			val:           "arn:aws:kms:us-west-2:123456789012:alias/example", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			service:       "kms",
+			resourceTypes: []string{"key", "alias"},
+			val:           "arn:aws:kms:us-west-2:123456789012:grant/example", //lintignore:AWSAT003,AWSAT005
+			expectedErr:   regexp.MustCompile(`expected kms resource type alias or key`),
+		},
+		{
+			service:       "kms",
+			resourceTypes: []string{"key"},
+			val:           "arn:aws:kms::123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", //lintignore:AWSAT005
+			expectedErr:   regexp.MustCompile(`missing region value for kms key`),
+		},
+		{
+			service:       "sns",
+			resourceTypes: []string{"topic"},
+			val:           "arn:aws:sns:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			service:       "lambda",
+			resourceTypes: []string{"function"},
+			val:           "arn:aws:lambda:us-west-2:123456789012:function:example:live", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			service:       "s3",
+			resourceTypes: []string{"bucket"},
+			val:           "arn:aws:s3:::example/key", //lintignore:AWSAT005
+			expectedErr:   regexp.MustCompile(`expected s3 resource type bucket`),
+		},
+		{
+			service:       "s3",
+			resourceTypes: []string{"bucket"},
+			val:           1,
+			expectedErr:   regexp.MustCompile(`expected type of "test_property" to be string`),
+		},
+	}
+
+	for _, tc := range testCases {
+		_, errors := ValidARNForService(tc.service, tc.resourceTypes...)(tc.val, "test_property")
+
+		if tc.expectedErr == nil {
+			if len(errors) > 0 {
+				t.Fatalf("expected no errors for %v, got %q", tc.val, errors)
+			}
+
+			continue
+		}
+
+		if len(errors) == 0 {
+			t.Fatalf("expected error for %v, got none", tc.val)
+		}
+
+		var matched bool
+
+		for _, err := range errors {
+			if tc.expectedErr.MatchString(err.Error()) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			t.Fatalf("expected error matching %s for %v, got %q", tc.expectedErr, tc.val, errors)
+		}
+	}
+}
+
+func TestValidARNForServiceUnknownResourceType(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+
+	ValidARNForService("iam", "bucket")
+}
@@ -17,7 +17,8 @@ import (
 // returns an error if unsuccessful or if the resource tags are identical
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
-// will be indistinguishable when returned from an AWS API.
+// will be indistinguishable when returned from an AWS API, or if the
+// merged tags do not comply with the provider-level tag policy.
 func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -30,6 +31,13 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
+	// Tag values are only checked against the tag policy once they are known.
+	if diff.NewValueKnown("tags") {
+		if err := meta.(*conns.AWSClient).TagPolicy.Validate(allTags); err != nil {
+			return fmt.Errorf(`"tags_all" do not comply with the "tag_policy" configuration block of the provider: %w`, err)
+		}
+	}
+
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
 	// otherwise we mark the attribute as "Computed" only when their is a known diff (excluding an empty map)
@@ -0,0 +1,375 @@
+package verify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	IAMPolicyTypeGroupInline = "group_inline"
+	IAMPolicyTypeManaged     = "managed"
+	IAMPolicyTypeResource    = "resource"
+	IAMPolicyTypeRoleInline  = "role_inline"
+	IAMPolicyTypeSCP         = "scp"
+	IAMPolicyTypeUserInline  = "user_inline"
+)
+
+func IAMPolicyType_Values() []string {
+	return []string{
+		IAMPolicyTypeGroupInline,
+		IAMPolicyTypeManaged,
+		IAMPolicyTypeResource,
+		IAMPolicyTypeRoleInline,
+		IAMPolicyTypeSCP,
+		IAMPolicyTypeUserInline,
+	}
+}
+
+// iamPolicySizeLimits are the maximum policy sizes, in characters excluding whitespace, by policy type.
+// Resource policy limits vary by service; the limit is that of the largest common one, S3 bucket policies.
+var iamPolicySizeLimits = map[string]int{
+	IAMPolicyTypeGroupInline: 5120,
+	IAMPolicyTypeManaged:     6144,
+	IAMPolicyTypeResource:    20480,
+	IAMPolicyTypeRoleInline:  10240,
+	IAMPolicyTypeSCP:         5120,
+	IAMPolicyTypeUserInline:  2048,
+}
+
+const (
+	IAMPolicyLintCategoryInvalid    = "invalid"
+	IAMPolicyLintCategoryPermissive = "permissive"
+	IAMPolicyLintCategorySize       = "size"
+	// IAMPolicyLintCategoryUnrecognized findings are elements the provider does not recognize but that AWS may accept,
+	// e.g. actions of services added after the provider's release.
+	IAMPolicyLintCategoryUnrecognized = "unrecognized"
+)
+
+// IAMPolicyLintFinding is a problem found in an IAM policy document.
+type IAMPolicyLintFinding struct {
+	Category string
+	// Statement identifies the statement by Sid or, if it has none, by index. It is empty for findings about the whole document.
+	Statement string
+	Message   string
+}
+
+func (f IAMPolicyLintFinding) String() string {
+	if f.Statement == "" {
+		return fmt.Sprintf("%s: %s", f.Category, f.Message)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", f.Category, f.Statement, f.Message)
+}
+
+var iamPolicyConditionOperators = map[string]bool{
+	"ArnEquals":                 true,
+	"ArnLike":                   true,
+	"ArnNotEquals":              true,
+	"ArnNotLike":                true,
+	"BinaryEquals":              true,
+	"Bool":                      true,
+	"DateEquals":                true,
+	"DateGreaterThan":           true,
+	"DateGreaterThanEquals":     true,
+	"DateLessThan":              true,
+	"DateLessThanEquals":        true,
+	"DateNotEquals":             true,
+	"IpAddress":                 true,
+	"NotIpAddress":              true,
+	"Null":                      true,
+	"NumericEquals":             true,
+	"NumericGreaterThan":        true,
+	"NumericGreaterThanEquals":  true,
+	"NumericLessThan":           true,
+	"NumericLessThanEquals":     true,
+	"NumericNotEquals":          true,
+	"StringEquals":              true,
+	"StringEqualsIgnoreCase":    true,
+	"StringLike":                true,
+	"StringNotEquals":           true,
+	"StringNotEqualsIgnoreCase": true,
+	"StringNotLike":             true,
+}
+
+var iamPolicyActionRegexp = regexp.MustCompile(`^([a-zA-Z0-9-]+):[a-zA-Z0-9*?]+$`)
+var iamPolicyVariableRegexp = regexp.MustCompile(`\$\{[^}]*\}`)
+
+// iamPolicyExtraServicePrefixes are action service prefixes that are not AWS SDK service names or provider service HCL keys.
+var iamPolicyExtraServicePrefixes = []string{
+	"aws-marketplace",
+	"aws-portal",
+	"ec2messages",
+	"ecr-public",
+	"execute-api",
+	"kafka-cluster",
+	"lex",
+	"mobiletargeting",
+	"neptune-db",
+	"rds-db",
+	"s3-object-lambda",
+	"ssmmessages",
+	"sso-directory",
+	"tag",
+	"trustedadvisor",
+}
+
+// iamPolicyExecuteAPIResourceRegexp matches the API Gateway resource policy shorthand for the API's own resources,
+// e.g. "execute-api:/*/GET/pets".
+var iamPolicyExecuteAPIResourceRegexp = regexp.MustCompile(`^execute-api:/`)
+
+var (
+	iamPolicyServicePrefixes     map[string]bool
+	iamPolicyServicePrefixesOnce sync.Once
+)
+
+// isIAMPolicyServicePrefix returns whether the action service prefix is known.
+// Service prefixes are mostly AWS SDK service names, e.g. "elasticloadbalancing", or provider service HCL keys, e.g. "cloudwatch".
+func isIAMPolicyServicePrefix(prefix string) bool {
+	iamPolicyServicePrefixesOnce.Do(func() {
+		iamPolicyServicePrefixes = make(map[string]bool)
+
+		for _, v := range append(append(conns.ServiceNames(), conns.HCLKeys()...), iamPolicyExtraServicePrefixes...) {
+			iamPolicyServicePrefixes[strings.ToLower(v)] = true
+		}
+	})
+
+	return iamPolicyServicePrefixes[strings.ToLower(prefix)]
+}
+
+// LintIAMPolicyJSON returns the problems found in the IAM policy document: invalid elements, elements not recognized by the provider,
+// overly permissive statements and, if the policy type is specified, the policy exceeding the size limit for the type.
+func LintIAMPolicyJSON(policy, policyType string) ([]IAMPolicyLintFinding, error) {
+	var doc struct {
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, fmt.Errorf("error parsing IAM policy document: %w", err)
+	}
+
+	statements, err := iamPolicyStatements(doc.Statement)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing IAM policy document Statement: %w", err)
+	}
+
+	var findings []IAMPolicyLintFinding
+
+	if limit, ok := iamPolicySizeLimits[policyType]; ok {
+		var b bytes.Buffer
+
+		if err := json.Compact(&b, []byte(policy)); err != nil {
+			return nil, fmt.Errorf("error parsing IAM policy document: %w", err)
+		}
+
+		if size := b.Len(); size > limit {
+			findings = append(findings, IAMPolicyLintFinding{
+				Category: IAMPolicyLintCategorySize,
+				Message:  fmt.Sprintf("policy size (%d characters) exceeds the %s policy limit (%d characters)", size, policyType, limit),
+			})
+		}
+	}
+
+	sids := make(map[string]bool)
+
+	for i, statement := range statements {
+		id := fmt.Sprintf("statement %d", i)
+		sid, _ := statement["Sid"].(string)
+
+		if sid != "" {
+			id = fmt.Sprintf("statement %q", sid)
+
+			if sids[sid] {
+				findings = append(findings, IAMPolicyLintFinding{Category: IAMPolicyLintCategoryInvalid, Statement: id, Message: "duplicate Sid"})
+			}
+
+			sids[sid] = true
+		}
+
+		for _, message := range lintIAMPolicyStatement(statement, policyType) {
+			findings = append(findings, IAMPolicyLintFinding{Category: IAMPolicyLintCategoryInvalid, Statement: id, Message: message})
+		}
+
+		for _, message := range lintIAMPolicyStatementUnrecognized(statement) {
+			findings = append(findings, IAMPolicyLintFinding{Category: IAMPolicyLintCategoryUnrecognized, Statement: id, Message: message})
+		}
+
+		for _, message := range lintIAMPolicyStatementPermissions(statement) {
+			findings = append(findings, IAMPolicyLintFinding{Category: IAMPolicyLintCategoryPermissive, Statement: id, Message: message})
+		}
+	}
+
+	return findings, nil
+}
+
+// lintIAMPolicyStatement returns messages describing the statement's invalid elements.
+func lintIAMPolicyStatement(statement map[string]interface{}, policyType string) []string {
+	var messages []string
+
+	if effect := statement["Effect"]; effect != "Allow" && effect != "Deny" {
+		messages = append(messages, fmt.Sprintf("invalid Effect (%v)", effect))
+	}
+
+	actions, hasActions := statement["Action"]
+	notActions, hasNotActions := statement["NotAction"]
+
+	if !hasActions && !hasNotActions {
+		messages = append(messages, "missing Action or NotAction")
+	}
+
+	for _, action := range append(iamPolicyElementStrings(actions), iamPolicyElementStrings(notActions)...) {
+		if action == "*" {
+			continue
+		}
+
+		if !iamPolicyActionRegexp.MatchString(action) {
+			messages = append(messages, fmt.Sprintf("invalid action (%s), expected <service prefix>:<action>", action))
+		}
+	}
+
+	if policyType == IAMPolicyTypeResource {
+		if _, ok := statement["Principal"]; !ok {
+			if _, ok := statement["NotPrincipal"]; !ok {
+				messages = append(messages, "missing Principal or NotPrincipal in resource policy")
+			}
+		}
+	}
+
+	if conditions, ok := statement["Condition"].(map[string]interface{}); ok {
+		for operator := range conditions {
+			if !validIAMPolicyConditionOperator(operator) {
+				messages = append(messages, fmt.Sprintf("invalid condition operator (%s)", operator))
+			}
+		}
+	}
+
+	return messages
+}
+
+// lintIAMPolicyStatementUnrecognized returns messages describing the statement's elements not recognized by the provider:
+// actions with an unknown service prefix and resources that are not ARNs.
+func lintIAMPolicyStatementUnrecognized(statement map[string]interface{}) []string {
+	var messages []string
+
+	for _, action := range append(iamPolicyElementStrings(statement["Action"]), iamPolicyElementStrings(statement["NotAction"])...) {
+		if m := iamPolicyActionRegexp.FindStringSubmatch(action); m != nil && !isIAMPolicyServicePrefix(m[1]) {
+			messages = append(messages, fmt.Sprintf("unknown service prefix (%s) in action (%s)", m[1], action))
+		}
+	}
+
+	for _, resource := range append(iamPolicyElementStrings(statement["Resource"]), iamPolicyElementStrings(statement["NotResource"])...) {
+		if resource == "*" || iamPolicyExecuteAPIResourceRegexp.MatchString(resource) {
+			continue
+		}
+
+		// Policy variables may contain colons, e.g. ${aws:username}.
+		if parts := strings.SplitN(iamPolicyVariableRegexp.ReplaceAllString(resource, "x"), ":", 6); len(parts) != 6 || parts[0] != "arn" {
+			messages = append(messages, fmt.Sprintf("malformed resource ARN (%s)", resource))
+		}
+	}
+
+	return messages
+}
+
+// lintIAMPolicyStatementPermissions returns messages describing why the statement is overly permissive.
+func lintIAMPolicyStatementPermissions(statement map[string]interface{}) []string {
+	if statement["Effect"] != "Allow" {
+		return nil
+	}
+
+	if conditions, ok := statement["Condition"].(map[string]interface{}); ok && len(conditions) > 0 {
+		return nil
+	}
+
+	var messages []string
+
+	_, hasNotActions := statement["NotAction"]
+	_, hasNotResources := statement["NotResource"]
+	allActions := hasNotActions || iamPolicyElementContains(statement["Action"], "*")
+	allResources := hasNotResources || iamPolicyElementContains(statement["Resource"], "*")
+
+	if allActions && allResources {
+		messages = append(messages, "allows all actions on all resources")
+	}
+
+	switch principal := statement["Principal"].(type) {
+	case string:
+		if principal == "*" {
+			messages = append(messages, "allows any principal")
+		}
+	case map[string]interface{}:
+		if iamPolicyElementContains(principal["AWS"], "*") {
+			messages = append(messages, "allows any AWS principal")
+		}
+	}
+
+	return messages
+}
+
+// iamPolicyStatements returns the statements of a Statement element, which is a statement or a list of statements.
+func iamPolicyStatements(raw json.RawMessage) ([]map[string]interface{}, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var statements []map[string]interface{}
+
+	if err := json.Unmarshal(raw, &statements); err == nil {
+		return statements, nil
+	}
+
+	var statement map[string]interface{}
+
+	if err := json.Unmarshal(raw, &statement); err != nil {
+		return nil, err
+	}
+
+	return []map[string]interface{}{statement}, nil
+}
+
+func validIAMPolicyConditionOperator(operator string) bool {
+	operator = strings.TrimPrefix(operator, "ForAllValues:")
+	operator = strings.TrimPrefix(operator, "ForAnyValue:")
+
+	if operator != "Null" {
+		operator = strings.TrimSuffix(operator, "IfExists")
+	}
+
+	return iamPolicyConditionOperators[operator]
+}
+
+func iamPolicyElementContains(v interface{}, s string) bool {
+	for _, e := range iamPolicyElementStrings(v) {
+		if e == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// iamPolicyElementStrings returns the string values of a policy element, which is a string or a list of strings.
+func iamPolicyElementStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var values []string
+
+		for _, e := range v {
+			if e, ok := e.(string); ok {
+				values = append(values, e)
+			}
+		}
+
+		return values
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,160 @@
+package verify
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestLintIAMPolicyJSON(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Policy     string
+		PolicyType string
+		Expected   []string
+		ExpectErr  bool
+	}{
+		{
+			Name:      "invalid JSON",
+			Policy:    `{"Statement":`,
+			ExpectErr: true,
+		},
+		{
+			Name:   "no statements",
+			Policy: `{}`,
+		},
+		{
+			Name: "valid",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "ReadHome",
+      "Effect": "Allow",
+      "Action": ["s3:GetObject", "s3:List*"],
+      "Resource": "arn:aws:s3:::example/home/${aws:username}/*",
+      "Condition": {
+        "ForAnyValue:StringLikeIfExists": {"aws:TagKeys": "team*"},
+        "Null": {"aws:SourceVpc": "false"}
+      }
+    },
+    {
+      "Effect": "Deny",
+      "NotAction": "iam:*",
+      "NotResource": "*"
+    }
+  ]
+}`,
+		},
+		{
+			Name:   "single statement",
+			Policy: `{"Statement": {"Effect": "Allow", "Action": "ec2:DescribeInstances", "Resource": "*"}}`,
+		},
+		{
+			Name: "invalid elements",
+			Policy: `{
+  "Statement": [
+    {"Sid": "A", "Effect": "allow", "Action": "s3GetObject", "Resource": "example-bucket"},
+    {"Sid": "A", "Effect": "Deny", "Resource": "arn:aws:sns:us-west-2:123456789012"},
+    {"Effect": "Deny", "Action": "s3:*", "Resource": "*", "Condition": {"StringEqualsIgnoreCaseIfExist": {"aws:username": "x"}}}
+  ]
+}`,
+			Expected: []string{
+				`invalid: statement "A": invalid Effect (allow)`,
+				`invalid: statement "A": invalid action (s3GetObject), expected <service prefix>:<action>`,
+				`unrecognized: statement "A": malformed resource ARN (example-bucket)`,
+				`invalid: statement "A": duplicate Sid`,
+				`invalid: statement "A": missing Action or NotAction`,
+				`unrecognized: statement "A": malformed resource ARN (arn:aws:sns:us-west-2:123456789012)`,
+				`invalid: statement 2: invalid condition operator (StringEqualsIgnoreCaseIfExist)`,
+			},
+		},
+		{
+			Name: "service prefixes",
+			Policy: `{
+  "Statement": [
+    {"Sid": "Known", "Effect": "Allow", "Action": ["cloudwatch:PutMetricData", "ecr-public:GetAuthorizationToken", "elasticloadbalancing:Describe*", "kafka-cluster:Connect", "logs:CreateLogStream", "neptune-db:connect", "SES:SendEmail", "trustedadvisor:Describe*"], "Resource": "*"},
+    {"Sid": "ExecuteAPI", "Effect": "Allow", "Principal": "*", "Action": "execute-api:Invoke", "Resource": "execute-api:/*", "Condition": {"IpAddress": {"aws:SourceIp": "10.0.0.0/8"}}},
+    {"Sid": "Unknown", "Effect": "Allow", "Action": "s4:GetObject", "Resource": "*"}
+  ]
+}`,
+			Expected: []string{
+				`unrecognized: statement "Unknown": unknown service prefix (s4) in action (s4:GetObject)`,
+			},
+		},
+		{
+			Name: "permissive",
+			Policy: `{
+  "Statement": [
+    {"Sid": "Admin", "Effect": "Allow", "Action": "*", "Resource": "*"},
+    {"Sid": "AllButIAM", "Effect": "Allow", "NotAction": "iam:*", "Resource": "*"},
+    {"Sid": "Public", "Effect": "Allow", "Principal": {"AWS": ["*"]}, "Action": "s3:GetObject", "Resource": "arn:aws:s3:::example/*"},
+    {"Sid": "Anonymous", "Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::example/*"},
+    {"Sid": "Conditional", "Effect": "Allow", "Principal": "*", "Action": "*", "Resource": "*", "Condition": {"StringEquals": {"aws:PrincipalOrgID": "o-123"}}},
+    {"Sid": "Deny", "Effect": "Deny", "Principal": "*", "Action": "*", "Resource": "*"}
+  ]
+}`,
+			Expected: []string{
+				`permissive: statement "Admin": allows all actions on all resources`,
+				`permissive: statement "AllButIAM": allows all actions on all resources`,
+				`permissive: statement "Public": allows any AWS principal`,
+				`permissive: statement "Anonymous": allows any principal`,
+			},
+		},
+		{
+			Name:       "resource policy without principal",
+			Policy:     `{"Statement": [{"Effect": "Deny", "Action": "s3:*", "Resource": "*"}]}`,
+			PolicyType: IAMPolicyTypeResource,
+			Expected: []string{
+				`invalid: statement 0: missing Principal or NotPrincipal in resource policy`,
+			},
+		},
+		{
+			Name:       "size",
+			Policy:     fmt.Sprintf(`{"Statement": [{"Effect": "Deny", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::%s"}]}`, strings.Repeat("a", 2048)),
+			PolicyType: IAMPolicyTypeUserInline,
+			Expected: []string{
+				`size: policy size (2132 characters) exceeds the user_inline policy limit (2048 characters)`,
+			},
+		},
+		{
+			Name:       "size within limit",
+			Policy:     fmt.Sprintf(`{"Statement": [{"Effect": "Deny", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::%s"}]}`, strings.Repeat("a", 2048)),
+			PolicyType: IAMPolicyTypeManaged,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			findings, err := LintIAMPolicyJSON(testCase.Policy, testCase.PolicyType)
+
+			if testCase.ExpectErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+
+			for _, finding := range findings {
+				got = append(got, finding.String())
+			}
+
+			if len(got) != len(testCase.Expected) {
+				t.Fatalf("expected findings %q, got %q", testCase.Expected, got)
+			}
+
+			for i := range got {
+				if got[i] != testCase.Expected[i] {
+					t.Errorf("expected finding %d %q, got %q", i, testCase.Expected[i], got[i])
+				}
+			}
+		})
+	}
+}
@@ -106,6 +106,20 @@ func ValidIAMPolicyJSON(v interface{}, k string) (ws []string, errors []error) {
 	}
 	if _, err := structure.NormalizeJsonString(v); err != nil {
 		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+		return
+	}
+
+	// Invalid statements are reported as warnings as AWS is the final arbiter of policy grammar.
+	// Elements the provider does not recognize, e.g. actions of newer services, are not reported as AWS may accept them.
+	// Use the aws_iam_policy_document data source's lint block to report other findings or to fail on findings.
+	findings, err := LintIAMPolicyJSON(value, "")
+	if err != nil {
+		return
+	}
+	for _, finding := range findings {
+		if finding.Category == IAMPolicyLintCategoryInvalid {
+			ws = append(ws, fmt.Sprintf("%q contains an invalid IAM policy statement: %s", k, finding))
+		}
 	}
 	return
 }
@@ -344,6 +344,48 @@ func TestValidIAMPolicyJSONString(t *testing.T) {
 			t.Fatalf("Expected %q not to trigger a validation error.", tc.Value)
 		}
 	}
+
+	warningCases := []struct {
+		Value     string
+		WarnCount int
+	}{
+		{
+			Value:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			WarnCount: 0,
+		},
+		{
+			// Overly permissive statements are not reported.
+			Value:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			WarnCount: 0,
+		},
+		{
+			Value:     `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"GetObject","Resource":"*","Condition":{"StringEqualz":{"aws:username":"x"}}}}`,
+			WarnCount: 2,
+		},
+		{
+			// Elements not recognized by the provider are not reported.
+			Value:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["kafka-cluster:Connect","neptune-db:connect","ecr-public:GetAuthorizationToken","trustedadvisor:Describe*","s4:GetObject"],"Resource":"*"}]}`,
+			WarnCount: 0,
+		},
+		{
+			Value:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"execute-api:Invoke","Resource":"execute-api:/*"}]}`,
+			WarnCount: 0,
+		},
+		{
+			Value:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"example-bucket"}]}`,
+			WarnCount: 0,
+		},
+	}
+
+	for _, tc := range warningCases {
+		ws, errors := ValidIAMPolicyJSON(tc.Value, "json")
+		if len(errors) != 0 {
+			t.Fatalf("Expected %q not to trigger a validation error, got: %s", tc.Value, errors)
+		}
+		if len(ws) != tc.WarnCount {
+			t.Fatalf("Expected %q to trigger %d validation warnings, got: %s", tc.Value, tc.WarnCount, ws)
+		}
+	}
 }
 
 func TestValidStringIsJSONOrYAML(t *testing.T) {
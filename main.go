@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -15,20 +16,28 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := &plugin.ServeOpts{ProviderFunc: provider.Provider}
+	if err := serve(debugMode); err != nil {
+		log.Fatal(err.Error())
+	}
+}
 
-	if debugMode {
-		err := plugin.Debug(context.Background(), "registry.terraform.io/hashicorp/aws", opts)
+// serve serves the provider until Terraform stops it and then writes the API metrics summaries
+// and closes the request trace files.
+func serve(debugMode bool) error {
+	opts := &plugin.ServeOpts{ProviderFunc: provider.Provider}
 
-		if err != nil {
-			log.Fatal(err.Error())
-		}
+	// Serve and Debug return when Terraform stops the provider.
+	defer conns.WriteAPIMetrics()
+	defer conns.CloseRequestTraceFiles()
 
-		return
+	if debugMode {
+		return plugin.Debug(context.Background(), "registry.terraform.io/hashicorp/aws", opts)
 	}
 
 	logFlags := log.Flags()
 	logFlags = logFlags &^ (log.Ldate | log.Ltime)
 	log.SetFlags(logFlags)
 	plugin.Serve(opts)
+
+	return nil
 }
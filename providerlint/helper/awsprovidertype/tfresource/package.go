@@ -0,0 +1,22 @@
+package tfresource
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+)
+
+const (
+	PackageName = `tfresource`
+	PackagePath = `github.com/hashicorp/terraform-provider-aws/internal/tfresource`
+
+	// PackagePathSuffix is used to match the package, as internal packages can
+	// only be imported from within the provider or analyzer testdata.
+	PackagePathSuffix = `internal/tfresource`
+)
+
+// IsFunc returns if the function call is in the package
+func IsFunc(e ast.Expr, info *types.Info, funcName string) bool {
+	return astutils.IsPackageFunc(e, info, PackagePathSuffix, funcName)
+}
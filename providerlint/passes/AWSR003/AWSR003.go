@@ -0,0 +1,105 @@
+package AWSR003
+
+import (
+	"go/ast"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/awsprovidertype/tfresource"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for tfresource.NotFound() removing resources from state without d.IsNewResource()
+
+The AWSR003 analyzer reports when an if statement conditional on a
+tfresource.NotFound() call removes the resource from the Terraform state via
+(schema.ResourceData).SetId(""), but the conditional does not also check
+!d.IsNewResource(). Eventually consistent APIs can return not found errors
+immediately after creation, which should be returned as errors rather than
+silently removing the new resource from state.
+`
+
+const analyzerName = "AWSR003"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.IfStmt)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		ifStmt := n.(*ast.IfStmt)
+
+		if commentIgnorer.ShouldIgnore(analyzerName, ifStmt) {
+			return
+		}
+
+		if !containsCallExpr(ifStmt.Cond, func(callExpr *ast.CallExpr) bool {
+			return tfresource.IsFunc(callExpr.Fun, pass.TypesInfo, tfresource.FuncNameNotFound)
+		}) {
+			return
+		}
+
+		if containsCallExpr(ifStmt.Cond, func(callExpr *ast.CallExpr) bool {
+			return schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "IsNewResource")
+		}) {
+			return
+		}
+
+		if !containsCallExpr(ifStmt.Body, func(callExpr *ast.CallExpr) bool {
+			if !schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "SetId") || len(callExpr.Args) != 1 {
+				return false
+			}
+
+			id := astutils.ExprStringValue(callExpr.Args[0])
+
+			return id != nil && *id == ""
+		}) {
+			return
+		}
+
+		pass.Reportf(ifStmt.Cond.Pos(), "%s: prefer !d.IsNewResource() check before removing resource from state", analyzerName)
+	})
+
+	return nil, nil
+}
+
+// containsCallExpr returns true if the node contains a matching call, excluding calls within function literals.
+func containsCallExpr(node ast.Node, match func(*ast.CallExpr) bool) bool {
+	var found bool
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.CallExpr:
+			if match(n) {
+				found = true
+				return false
+			}
+		}
+
+		return true
+	})
+
+	return found
+}
@@ -0,0 +1,5 @@
+package tfresource
+
+func NotFound(err error) bool {
+	return err != nil
+}
@@ -0,0 +1,45 @@
+package a
+
+import (
+	"log"
+
+	"a/internal/tfresource"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func f(d *schema.ResourceData, err error) error {
+	/* Passing cases */
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if tfresource.NotFound(err) {
+		d.SetId("test")
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR003
+	if tfresource.NotFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	/* Failing cases */
+
+	if tfresource.NotFound(err) { // want "prefer !d.IsNewResource\\(\\) check before removing resource from state"
+		log.Printf("[WARN] Resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return err
+}
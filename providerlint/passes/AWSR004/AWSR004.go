@@ -0,0 +1,113 @@
+package AWSR004
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourcedatasetcallexpr"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for d.Set() of nested blocks without error checking
+
+The AWSR004 analyzer reports when the error returned by a
+(schema.ResourceData).Set() call is ignored and the value is a slice or array
+of non-primitive elements, such as the []interface{} or
+[]map[string]interface{} of a nested block. Unlike primitive values, nested
+block values that do not match the attribute schema cause an error and are
+not saved into the Terraform state.
+`
+
+const analyzerName = "AWSR004"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+		resourcedatasetcallexpr.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	callExprs := pass.ResultOf[resourcedatasetcallexpr.Analyzer].([]*ast.CallExpr)
+
+	setCallExprs := make(map[*ast.CallExpr]bool, len(callExprs))
+
+	for _, callExpr := range callExprs {
+		setCallExprs[callExpr] = true
+	}
+
+	nodeFilter := []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.ExprStmt)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		var callExpr *ast.CallExpr
+
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+				return
+			}
+
+			if ident, ok := n.Lhs[0].(*ast.Ident); !ok || ident.Name != "_" {
+				return
+			}
+
+			callExpr, _ = n.Rhs[0].(*ast.CallExpr)
+		case *ast.ExprStmt:
+			callExpr, _ = n.X.(*ast.CallExpr)
+		}
+
+		if callExpr == nil || !setCallExprs[callExpr] || len(callExpr.Args) < 2 {
+			return
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, n) {
+			return
+		}
+
+		if !isNestedBlockType(pass.TypesInfo.TypeOf(callExpr.Args[1])) {
+			return
+		}
+
+		pass.Reportf(callExpr.Pos(), "%s: prefer checking d.Set() error for nested blocks", analyzerName)
+	})
+
+	return nil, nil
+}
+
+// isNestedBlockType returns true if the type is a slice or array of non-primitive elements.
+func isNestedBlockType(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	var elem types.Type
+
+	switch t := t.Underlying().(type) {
+	case *types.Array:
+		elem = t.Elem()
+	case *types.Slice:
+		elem = t.Elem()
+	default:
+		return false
+	}
+
+	if pointer, ok := elem.Underlying().(*types.Pointer); ok {
+		elem = pointer.Elem()
+	}
+
+	_, ok := elem.Underlying().(*types.Basic)
+
+	return !ok
+}
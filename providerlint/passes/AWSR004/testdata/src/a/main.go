@@ -0,0 +1,42 @@
+package a
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func f(d *schema.ResourceData) error {
+	/* Passing cases */
+
+	d.Set("name", "test")
+
+	d.Set("security_group_ids", []string{"test"})
+
+	d.Set("subnet_ids", []*string{})
+
+	d.Set("tags", map[string]string{"key": "value"})
+
+	if err := d.Set("configuration", flattenConfiguration()); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR004
+	d.Set("configuration", flattenConfiguration())
+
+	d.Set("configuration", flattenConfiguration()) //lintignore:AWSR004
+
+	/* Failing cases */
+
+	d.Set("configuration", flattenConfiguration()) // want "prefer checking d.Set\\(\\) error for nested blocks"
+
+	_ = d.Set("configuration", []map[string]interface{}{}) // want "prefer checking d.Set\\(\\) error for nested blocks"
+
+	return nil
+}
+
+func flattenConfiguration() []interface{} {
+	return []interface{}{map[string]interface{}{"name": "test"}}
+}
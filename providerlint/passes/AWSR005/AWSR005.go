@@ -0,0 +1,73 @@
+package AWSR005
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/resource"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for resource.StateChangeConf without Timeout
+
+The AWSR005 analyzer reports when a resource.StateChangeConf composite literal
+does not declare the Timeout field. Without a timeout, waiting for the target
+state times out almost immediately, rather than after the resource or default
+timeout.
+`
+
+const analyzerName = "AWSR005"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.CompositeLit)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		compositeLit := n.(*ast.CompositeLit)
+
+		if !isTypeStateChangeConf(pass.TypesInfo.TypeOf(compositeLit)) {
+			return
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, compositeLit) {
+			return
+		}
+
+		if astutils.CompositeLitContainsAnyField(compositeLit, "Timeout") {
+			return
+		}
+
+		pass.Reportf(compositeLit.Pos(), "%s: missing Timeout", analyzerName)
+	})
+
+	return nil, nil
+}
+
+func isTypeStateChangeConf(t types.Type) bool {
+	switch t := t.(type) {
+	case *types.Named:
+		return resource.IsNamedType(t, "StateChangeConf")
+	case *types.Pointer:
+		return isTypeStateChangeConf(t.Elem())
+	default:
+		return false
+	}
+}
@@ -0,0 +1,50 @@
+package a
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func f(refresh resource.StateRefreshFunc, timeout time.Duration) {
+	/* Passing cases */
+
+	_ = &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"available"},
+		Refresh: refresh,
+		Timeout: timeout,
+	}
+
+	_ = resource.StateChangeConf{
+		Pending:    []string{"pending"},
+		Target:     []string{"available"},
+		Refresh:    refresh,
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR005
+	_ = &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"available"},
+		Refresh: refresh,
+	}
+
+	/* Failing cases */
+
+	_ = &resource.StateChangeConf{ // want "missing Timeout"
+		Pending: []string{"pending"},
+		Target:  []string{"available"},
+		Refresh: refresh,
+	}
+
+	_ = resource.StateChangeConf{ // want "missing Timeout"
+		Pending:    []string{"pending"},
+		Target:     []string{"available"},
+		Refresh:    refresh,
+		MinTimeout: 10 * time.Second,
+	}
+}
@@ -0,0 +1,129 @@
+package AWSR006
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/analysisutils"
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for fmt.Errorf() formatting errors without %w
+
+The AWSR006 analyzer reports when a fmt.Errorf() call formats an error
+argument with the %s or %v verb and does not already use the %w verb. The %w
+verb wraps the error, so callers can inspect the underlying AWS error, e.g.
+via errors.As() or the tfawserr package.
+`
+
+const analyzerName = "AWSR006"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		fmtErrorfCallExprAnalyzer,
+	},
+	Run: run,
+}
+
+var fmtErrorfCallExprAnalyzer = analysisutils.StdlibFunctionCallExprAnalyzer(
+	"fmterrorfcallexpr",
+	"fmt",
+	"Errorf",
+)
+
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	callExprs := pass.ResultOf[fmtErrorfCallExprAnalyzer].([]*ast.CallExpr)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+
+	for _, callExpr := range callExprs {
+		if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+			continue
+		}
+
+		if len(callExpr.Args) < 2 {
+			continue
+		}
+
+		formatString := astutils.ExprStringValue(callExpr.Args[0])
+
+		if formatString == nil {
+			continue
+		}
+
+		verbs, ok := formatVerbs(*formatString)
+
+		if !ok {
+			continue
+		}
+
+		for _, verb := range verbs {
+			if verb == 'w' {
+				ok = false
+				break
+			}
+		}
+
+		if !ok {
+			continue
+		}
+
+		for i, verb := range verbs {
+			if verb != 's' && verb != 'v' {
+				continue
+			}
+
+			if i+1 >= len(callExpr.Args) {
+				break
+			}
+
+			arg := callExpr.Args[i+1]
+			t := pass.TypesInfo.TypeOf(arg)
+
+			if t == nil || !types.Implements(t, errorType) {
+				continue
+			}
+
+			pass.Reportf(arg.Pos(), "%s: prefer %%w verb for wrapping errors", analyzerName)
+
+			// Only one %w verb is allowed per call.
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+// formatVerbs returns the verbs of a format string, in argument order.
+// Format strings with explicit argument indexes or * widths and precisions are not supported.
+func formatVerbs(format string) ([]byte, bool) {
+	var verbs []byte
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+
+		for i++; i < len(format); i++ {
+			switch c := format[i]; {
+			case c == '[' || c == '*':
+				return nil, false
+			case c == '+' || c == '-' || c == '#' || c == ' ' || c == '.' || (c >= '0' && c <= '9'):
+				continue
+			case c == '%':
+			default:
+				verbs = append(verbs, c)
+			}
+
+			break
+		}
+	}
+
+	return verbs, true
+}
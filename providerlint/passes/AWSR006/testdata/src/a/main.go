@@ -0,0 +1,36 @@
+package a
+
+import (
+	"errors"
+	"fmt"
+)
+
+func f() {
+	err := errors.New("test")
+	id := "test"
+
+	/* Passing cases */
+
+	_ = fmt.Errorf("error reading resource (%s): %w", id, err)
+
+	_ = fmt.Errorf("error reading resource (%s): %s", id, "test")
+
+	_ = fmt.Errorf("error reading resource (%s): %w, previous error: %s", id, err, err)
+
+	_ = fmt.Errorf("100%% error reading resource (%s): %d", id, 1)
+
+	_ = fmt.Errorf("error reading resource (%[1]s): %[2]s", id, err)
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR006
+	_ = fmt.Errorf("error reading resource (%s): %s", id, err)
+
+	_ = fmt.Errorf("error reading resource (%s): %s", id, err) //lintignore:AWSR006
+
+	/* Failing cases */
+
+	_ = fmt.Errorf("error reading resource (%s): %s", id, err) // want "prefer %w verb for wrapping errors"
+
+	_ = fmt.Errorf("error reading resource (%-10s): %v", id, err) // want "prefer %w verb for wrapping errors"
+}